@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of an Account. The account identifier and admin
+// name are those a ProviderConfig of the new account takes as its
+// snowflakeAccount and username.
+const (
+	ConnectionDetailAccountIdentifier = "account_identifier"
+	ConnectionDetailAccountLocator    = "account_locator"
+	ConnectionDetailAccountURL        = "account_url"
+	ConnectionDetailAccountLocatorURL = "account_locator_url"
+	ConnectionDetailAdminName         = "admin_name"
+)
+
+// AccountParameters are the configurable fields of an Account. The admin
+// user is only set when the account is created.
+type AccountParameters struct {
+	// name of the account, unique in the organization
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// name of the initial ACCOUNTADMIN user of the account
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="adminName is immutable"
+	AdminName string `json:"adminName"`
+
+	// RSA public key the admin user authenticates with, without the PEM
+	// header and footer
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="adminRsaPublicKey is immutable"
+	AdminRSAPublicKey string `json:"adminRsaPublicKey"`
+
+	// email address of the admin user
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="email is immutable"
+	Email string `json:"email"`
+
+	// edition of the account
+	// +kubebuilder:validation:Enum=STANDARD;ENTERPRISE;BUSINESS_CRITICAL
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="edition is immutable"
+	Edition string `json:"edition"`
+
+	// Snowflake region of the account, e.g. AWS_EU_CENTRAL_1. Defaults to
+	// the region of the account creating it.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// region group of the account, e.g. PUBLIC
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="regionGroup is immutable"
+	// +optional
+	RegionGroup *string `json:"regionGroup,omitempty"`
+
+	// days a dropped account can be restored before it is deleted
+	// +kubebuilder:validation:Minimum=3
+	// +kubebuilder:default=3
+	// +optional
+	GracePeriodInDays int `json:"gracePeriodInDays,omitempty"`
+
+	// comment of the account
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// AccountObservation are the observable fields of an Account.
+type AccountObservation struct {
+	// name of the organization of the account
+	OrganizationName string `json:"organizationName,omitempty"`
+
+	// locator of the account in its region
+	AccountLocator string `json:"accountLocator,omitempty"`
+
+	// URL of the account, by organization and account name
+	AccountURL string `json:"accountUrl,omitempty"`
+
+	// URL of the account, by account locator
+	AccountLocatorURL string `json:"accountLocatorUrl,omitempty"`
+
+	// edition of the account
+	Edition string `json:"edition,omitempty"`
+
+	// Snowflake region of the account
+	Region string `json:"region,omitempty"`
+
+	// region group of the account
+	RegionGroup string `json:"regionGroup,omitempty"`
+
+	// comment of the account
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the account
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A AccountSpec defines the desired state of a Account.
+type AccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountParameters `json:"forProvider"`
+}
+
+// A AccountStatus represents the observed state of a Account.
+type AccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Account is a Snowflake account of the organization. Managing accounts
+// requires a ProviderConfig whose user has the ORGADMIN role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATOR",type="string",JSONPath=".status.atProvider.accountLocator"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.accountUrl",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountList contains a list of Account
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Account `json:"items"`
+}
+
+// Account type metadata.
+var (
+	AccountKind             = reflect.TypeOf(Account{}).Name()
+	AccountGroupKind        = schema.GroupKind{Group: Group, Kind: AccountKind}.String()
+	AccountKindAPIVersion   = AccountKind + "." + SchemeGroupVersion.String()
+	AccountGroupVersionKind = SchemeGroupVersion.WithKind(AccountKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Account{}, &AccountList{})
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Desired states of an Alert.
+const (
+	AlertStateStarted   = "Started"
+	AlertStateSuspended = "Suspended"
+)
+
+// AlertParameters are the configurable fields of an Alert. An alert runs
+// either on a warehouse or serverless.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type AlertParameters struct {
+	// name of the alert
+	Name string `json:"name"`
+
+	// database the alert is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the alert is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// warehouse evaluating the condition and running the action
+	// +optional
+	Warehouse *string `json:"warehouse,omitempty"`
+
+	// schedule on which the condition is evaluated
+	Schedule AlertSchedule `json:"schedule"`
+
+	// query whose rows trigger the action, e.g.
+	// SELECT 1 FROM orders WHERE amount < 0
+	Condition string `json:"condition"`
+
+	// statement run when the condition returns rows, e.g. a call to
+	// SYSTEM$SEND_EMAIL
+	Action string `json:"action"`
+
+	// comment of the alert
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// whether the alert is resumed and evaluated on schedule, or is
+	// suspended
+	// +kubebuilder:validation:Enum=Started;Suspended
+	// +kubebuilder:default=Started
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// AlertSchedule is either an interval in minutes or a cron expression.
+// +kubebuilder:validation:XValidation:rule="has(self.minutes) != has(self.cron)",message="exactly one of minutes and cron must be set"
+type AlertSchedule struct {
+	// interval between evaluations in minutes
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Minutes *int `json:"minutes,omitempty"`
+
+	// cron expression followed by a time zone, e.g. 0 9 * * MON-FRI UTC
+	// +optional
+	Cron *string `json:"cron,omitempty"`
+}
+
+// AlertObservation are the observable fields of an Alert.
+type AlertObservation struct {
+	// state of the alert, started or suspended
+	State string `json:"state,omitempty"`
+
+	// schedule of the alert as reported by Snowflake
+	Schedule string `json:"schedule,omitempty"`
+
+	// warehouse running the alert, empty for serverless alerts
+	Warehouse string `json:"warehouse,omitempty"`
+
+	// condition query of the alert
+	Condition string `json:"condition,omitempty"`
+
+	// action statement of the alert
+	Action string `json:"action,omitempty"`
+
+	// scheduled time of the last evaluation of the alert
+	LastExecutedOn string `json:"lastExecutedOn,omitempty"`
+
+	// outcome of the last evaluation, e.g. TRIGGERED, CONDITION_FALSE or
+	// ACTION_FAILED
+	LastExecutionState string `json:"lastExecutionState,omitempty"`
+
+	// comment of the alert
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the alert
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the alert
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A AlertSpec defines the desired state of a Alert.
+type AlertSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AlertParameters `json:"forProvider"`
+}
+
+// A AlertStatus represents the observed state of a Alert.
+type AlertStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AlertObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Alert evaluates a condition query on a schedule and runs an action when
+// it returns rows.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="LAST-EXECUTION",type="string",JSONPath=".status.atProvider.lastExecutionState"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".status.atProvider.schedule",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Alert struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertSpec   `json:"spec"`
+	Status AlertStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertList contains a list of Alert
+type AlertList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Alert `json:"items"`
+}
+
+// Alert type metadata.
+var (
+	AlertKind             = reflect.TypeOf(Alert{}).Name()
+	AlertGroupKind        = schema.GroupKind{Group: Group, Kind: AlertKind}.String()
+	AlertKindAPIVersion   = AlertKind + "." + SchemeGroupVersion.String()
+	AlertGroupVersionKind = SchemeGroupVersion.WithKind(AlertKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Alert{}, &AlertList{})
+}
@@ -23,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
 // DatabaseParameters are the configurable fields of a Database.
@@ -31,6 +33,32 @@ type DatabaseParameters struct {
 
 	// name of the database
 	Name string `json:"name"`
+
+	// inbound share the database is created from, as
+	// organization.account.share. The database is then read-only.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="fromShare is immutable"
+	// +optional
+	FromShare *string `json:"fromShare,omitempty"`
+
+	// tag values set on the database, keyed by the fully qualified name of
+	// the tag, e.g. GOVERNANCE.PUBLIC.COST_CENTER
+	// +kubebuilder:validation:MaxProperties=50
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^(\"([^\"]|\"\")+\"|[A-Za-z_][A-Za-z0-9_$]*)([.](\"([^\"]|\"\")+\"|[A-Za-z_][A-Za-z0-9_$]*)){2}$'))",message="tags must be keyed by fully qualified tag names"
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// refuses to drop the database while true. It must be set to false
+	// before the resource can be deleted.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// how the database is dropped on delete. RESTRICT fails while other
+	// databases reference its objects through foreign keys, CASCADE drops
+	// those references too.
+	// +kubebuilder:validation:Enum=RESTRICT;CASCADE
+	// +kubebuilder:default=RESTRICT
+	// +optional
+	DropBehavior string `json:"dropBehavior,omitempty"`
 }
 
 // DatabaseObservation are the observable fields of a Database.
@@ -39,6 +67,10 @@ type DatabaseObservation struct {
 
 	// name of database
 	Name string `json:"name"`
+
+	// tag values set on the database, for the tags of the spec and those
+	// removed from it
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // A DatabaseSpec defines the desired state of a Database.
@@ -90,3 +122,15 @@ var (
 func init() {
 	SchemeBuilder.Register(&Database{}, &DatabaseList{})
 }
+
+// DatabaseName returns the Snowflake name of a referenced Database, for use
+// when resolving references to it from other resources.
+func DatabaseName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Database)
+		if !ok {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
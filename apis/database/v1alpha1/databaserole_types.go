@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DatabaseRoleGrant grants privileges on an object of the database to the
+// database role.
+type DatabaseRoleGrant struct {
+	// privileges granted, e.g. USAGE, SELECT or INSERT
+	// +kubebuilder:validation:MinItems=1
+	Privileges []string `json:"privileges"`
+
+	// kind of the object, e.g. DATABASE, SCHEMA, TABLE or VIEW
+	// +kubebuilder:validation:Enum=DATABASE;SCHEMA;TABLE;EXTERNAL_TABLE;DYNAMIC_TABLE;ICEBERG_TABLE;VIEW;MATERIALIZED_VIEW;STAGE;FILE_FORMAT;SEQUENCE;STREAM;TASK;PIPE;ALERT;TAG;MASKING_POLICY;ROW_ACCESS_POLICY;SECRET
+	ObjectType string `json:"objectType"`
+
+	// fully qualified name of the object, e.g. db.schema.table, or the name
+	// of the database for DATABASE
+	ObjectName string `json:"objectName"`
+}
+
+// DatabaseRoleParameters are the configurable fields of a DatabaseRole.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type DatabaseRoleParameters struct {
+	// name of the database role
+	Name string `json:"name"`
+
+	// database the role is created in
+	// +crossplane:generate:reference:type=Database
+	// +crossplane:generate:reference:extractor=DatabaseName()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="database is immutable"
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// privileges granted to the role on objects of its database
+	// +optional
+	Grants []DatabaseRoleGrant `json:"grants,omitempty"`
+
+	// account roles the database role is granted to
+	// +optional
+	GrantedToRoles []string `json:"grantedToRoles,omitempty"`
+
+	// comment of the database role
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// DatabaseRoleObservation are the observable fields of a DatabaseRole.
+type DatabaseRoleObservation struct {
+	// privileges granted to the role, as PRIVILEGE ON TYPE NAME
+	Grants []string `json:"grants,omitempty"`
+
+	// account roles the database role is granted to
+	GrantedToRoles []string `json:"grantedToRoles,omitempty"`
+
+	// comment of the database role
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the database role
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the database role
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A DatabaseRoleSpec defines the desired state of a DatabaseRole.
+type DatabaseRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatabaseRoleParameters `json:"forProvider"`
+}
+
+// A DatabaseRoleStatus represents the observed state of a DatabaseRole.
+type DatabaseRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatabaseRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DatabaseRole is a role scoped to a database, packaging access to its
+// objects for account roles and shares.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type DatabaseRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseRoleSpec   `json:"spec"`
+	Status DatabaseRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseRoleList contains a list of DatabaseRole
+type DatabaseRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatabaseRole `json:"items"`
+}
+
+// DatabaseRole type metadata.
+var (
+	DatabaseRoleKind             = reflect.TypeOf(DatabaseRole{}).Name()
+	DatabaseRoleGroupKind        = schema.GroupKind{Group: Group, Kind: DatabaseRoleKind}.String()
+	DatabaseRoleKindAPIVersion   = DatabaseRoleKind + "." + SchemeGroupVersion.String()
+	DatabaseRoleGroupVersionKind = SchemeGroupVersion.WithKind(DatabaseRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DatabaseRole{}, &DatabaseRoleList{})
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Connection secret keys of an ExternalVolume. They hold the identities
+// Snowflake uses to access the first storage location of the volume, which
+// have to be trusted by the cloud provider, e.g. in the trust policy of the
+// AWS role.
+const (
+	ConnectionDetailStorageAWSIAMUserARN     = "storage_aws_iam_user_arn"
+	ConnectionDetailStorageAWSExternalID     = "storage_aws_external_id"
+	ConnectionDetailStorageGCPServiceAccount = "storage_gcp_service_account"
+	ConnectionDetailAzureConsentURL          = "azure_consent_url"
+	ConnectionDetailAzureMultiTenantAppName  = "azure_multi_tenant_app_name"
+)
+
+// ExternalVolumeParameters are the configurable fields of an ExternalVolume.
+type ExternalVolumeParameters struct {
+	// name of the external volume
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// storage locations of the volume. Snowflake uses the first one that is
+	// reachable, and fails over to the others.
+	// +kubebuilder:validation:MinItems=1
+	StorageLocations []StorageLocation `json:"storageLocations"`
+
+	// whether Iceberg tables managed by Snowflake may write to the volume
+	// +optional
+	AllowWrites *bool `json:"allowWrites,omitempty"`
+
+	// comment of the external volume
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// A StorageLocation is a bucket or container of an external volume. A
+// location whose properties change is replaced through a temporary location
+// named after it with the suffix _REPLACING.
+// +kubebuilder:validation:XValidation:rule="!self.storageProvider.startsWith('S3') || has(self.storageAwsRoleArn)",message="storageAwsRoleArn is required for S3 storage"
+// +kubebuilder:validation:XValidation:rule="self.storageProvider != 'AZURE' || has(self.azureTenantId)",message="azureTenantId is required for Azure storage"
+type StorageLocation struct {
+	// name of the storage location, unique in the volume
+	Name string `json:"name"`
+
+	// cloud storage service
+	// +kubebuilder:validation:Enum=S3;S3GOV;GCS;AZURE
+	StorageProvider string `json:"storageProvider"`
+
+	// url of the location, e.g. s3://bucket/path/, gcs://bucket/path/ or
+	// azure://account.blob.core.windows.net/container/path/
+	StorageBaseURL string `json:"storageBaseUrl"`
+
+	// ARN of the AWS role Snowflake assumes to access S3
+	// +optional
+	StorageAWSRoleARN *string `json:"storageAwsRoleArn,omitempty"`
+
+	// external ID Snowflake passes when assuming the role. Snowflake
+	// generates one when none is given.
+	// +optional
+	StorageAWSExternalID *string `json:"storageAwsExternalId,omitempty"`
+
+	// ID of the Azure Active Directory tenant of the storage account
+	// +optional
+	AzureTenantID *string `json:"azureTenantId,omitempty"`
+
+	// encryption of the files written to the location
+	// +optional
+	Encryption *StorageLocationEncryption `json:"encryption,omitempty"`
+}
+
+// StorageLocationEncryption configures the encryption of the files of a
+// storage location.
+type StorageLocationEncryption struct {
+	// encryption type
+	// +kubebuilder:validation:Enum=AWS_SSE_S3;AWS_SSE_KMS;GCS_SSE_KMS;NONE
+	Type string `json:"type"`
+
+	// ID of the KMS key encrypting the files
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+}
+
+// ExternalVolumeObservation are the observable fields of an ExternalVolume.
+type ExternalVolumeObservation struct {
+	// storage locations of the volume
+	StorageLocations []StorageLocationObservation `json:"storageLocations,omitempty"`
+
+	// name of the storage location in use
+	Active string `json:"active,omitempty"`
+
+	// whether Iceberg tables managed by Snowflake may write to the volume
+	AllowWrites bool `json:"allowWrites,omitempty"`
+
+	// comment of the external volume
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the external volume
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the external volume
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// StorageLocationObservation is the observed state of a storage location.
+type StorageLocationObservation struct {
+	// name of the storage location
+	Name string `json:"name"`
+
+	// cloud storage service
+	StorageProvider string `json:"storageProvider,omitempty"`
+
+	// url of the location
+	StorageBaseURL string `json:"storageBaseUrl,omitempty"`
+
+	// ARN of the AWS role Snowflake assumes
+	StorageAWSRoleARN string `json:"storageAwsRoleArn,omitempty"`
+
+	// ARN of the AWS IAM user Snowflake assumes the role with
+	StorageAWSIAMUserARN string `json:"storageAwsIamUserArn,omitempty"`
+
+	// external ID Snowflake passes when assuming the role
+	StorageAWSExternalID string `json:"storageAwsExternalId,omitempty"`
+
+	// Google service account Snowflake accesses GCS with
+	StorageGCPServiceAccount string `json:"storageGcpServiceAccount,omitempty"`
+
+	// ID of the Azure Active Directory tenant
+	AzureTenantID string `json:"azureTenantId,omitempty"`
+
+	// URL to grant Snowflake access to the Azure storage account
+	AzureConsentURL string `json:"azureConsentUrl,omitempty"`
+
+	// name of the Snowflake application in Azure
+	AzureMultiTenantAppName string `json:"azureMultiTenantAppName,omitempty"`
+
+	// encryption type of the files
+	EncryptionType string `json:"encryptionType,omitempty"`
+
+	// ID of the KMS key encrypting the files
+	EncryptionKMSKeyID string `json:"encryptionKmsKeyId,omitempty"`
+}
+
+// A ExternalVolumeSpec defines the desired state of a ExternalVolume.
+type ExternalVolumeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ExternalVolumeParameters `json:"forProvider"`
+}
+
+// A ExternalVolumeStatus represents the observed state of a ExternalVolume.
+type ExternalVolumeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ExternalVolumeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ExternalVolume is the cloud storage Iceberg tables keep their data and
+// metadata files in.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACTIVE",type="string",JSONPath=".status.atProvider.active"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ExternalVolume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalVolumeSpec   `json:"spec"`
+	Status ExternalVolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalVolumeList contains a list of ExternalVolume
+type ExternalVolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalVolume `json:"items"`
+}
+
+// ExternalVolume type metadata.
+var (
+	ExternalVolumeKind             = reflect.TypeOf(ExternalVolume{}).Name()
+	ExternalVolumeGroupKind        = schema.GroupKind{Group: Group, Kind: ExternalVolumeKind}.String()
+	ExternalVolumeKindAPIVersion   = ExternalVolumeKind + "." + SchemeGroupVersion.String()
+	ExternalVolumeGroupVersionKind = SchemeGroupVersion.WithKind(ExternalVolumeKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalVolume{}, &ExternalVolumeList{})
+}
+
+// ExternalVolumeName returns the name of a referenced ExternalVolume.
+func ExternalVolumeName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*ExternalVolume)
+		if !ok {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
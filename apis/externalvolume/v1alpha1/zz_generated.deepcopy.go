@@ -0,0 +1,240 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolume) DeepCopyInto(out *ExternalVolume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolume.
+func (in *ExternalVolume) DeepCopy() *ExternalVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalVolume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeList) DeepCopyInto(out *ExternalVolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeList.
+func (in *ExternalVolumeList) DeepCopy() *ExternalVolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalVolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeObservation) DeepCopyInto(out *ExternalVolumeObservation) {
+	*out = *in
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]StorageLocationObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeObservation.
+func (in *ExternalVolumeObservation) DeepCopy() *ExternalVolumeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeParameters) DeepCopyInto(out *ExternalVolumeParameters) {
+	*out = *in
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]StorageLocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowWrites != nil {
+		in, out := &in.AllowWrites, &out.AllowWrites
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeParameters.
+func (in *ExternalVolumeParameters) DeepCopy() *ExternalVolumeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeSpec) DeepCopyInto(out *ExternalVolumeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeSpec.
+func (in *ExternalVolumeSpec) DeepCopy() *ExternalVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalVolumeStatus) DeepCopyInto(out *ExternalVolumeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVolumeStatus.
+func (in *ExternalVolumeStatus) DeepCopy() *ExternalVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLocation) DeepCopyInto(out *StorageLocation) {
+	*out = *in
+	if in.StorageAWSRoleARN != nil {
+		in, out := &in.StorageAWSRoleARN, &out.StorageAWSRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAWSExternalID != nil {
+		in, out := &in.StorageAWSExternalID, &out.StorageAWSExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(StorageLocationEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLocation.
+func (in *StorageLocation) DeepCopy() *StorageLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLocationEncryption) DeepCopyInto(out *StorageLocationEncryption) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLocationEncryption.
+func (in *StorageLocationEncryption) DeepCopy() *StorageLocationEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLocationEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageLocationObservation) DeepCopyInto(out *StorageLocationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageLocationObservation.
+func (in *StorageLocationObservation) DeepCopy() *StorageLocationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageLocationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
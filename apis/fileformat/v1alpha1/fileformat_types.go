@@ -0,0 +1,381 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// FileFormatParameters are the configurable fields of a FileFormat. Exactly
+// the options block matching type may be set.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.csv) || self.type == 'CSV'",message="csv options require type CSV"
+// +kubebuilder:validation:XValidation:rule="!has(self.json) || self.type == 'JSON'",message="json options require type JSON"
+// +kubebuilder:validation:XValidation:rule="!has(self.avro) || self.type == 'AVRO'",message="avro options require type AVRO"
+// +kubebuilder:validation:XValidation:rule="!has(self.orc) || self.type == 'ORC'",message="orc options require type ORC"
+// +kubebuilder:validation:XValidation:rule="!has(self.parquet) || self.type == 'PARQUET'",message="parquet options require type PARQUET"
+// +kubebuilder:validation:XValidation:rule="!has(self.xml) || self.type == 'XML'",message="xml options require type XML"
+type FileFormatParameters struct {
+	// name of the file format
+	Name string `json:"name"`
+
+	// database the file format is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the file format is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// format type, cannot be changed once created
+	// +kubebuilder:validation:Enum=CSV;JSON;AVRO;ORC;PARQUET;XML
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// options of the CSV type
+	// +optional
+	CSV *CSVOptions `json:"csv,omitempty"`
+
+	// options of the JSON type
+	// +optional
+	JSON *JSONOptions `json:"json,omitempty"`
+
+	// options of the AVRO type
+	// +optional
+	Avro *AvroOptions `json:"avro,omitempty"`
+
+	// options of the ORC type
+	// +optional
+	ORC *ORCOptions `json:"orc,omitempty"`
+
+	// options of the PARQUET type
+	// +optional
+	Parquet *ParquetOptions `json:"parquet,omitempty"`
+
+	// options of the XML type
+	// +optional
+	XML *XMLOptions `json:"xml,omitempty"`
+
+	// comment of the file format
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// CSVOptions are the options of a CSV file format.
+// +kubebuilder:validation:XValidation:rule="!has(self.parseHeader) || !self.parseHeader || !has(self.skipHeader)",message="parseHeader and skipHeader are mutually exclusive"
+type CSVOptions struct {
+	// +kubebuilder:validation:Enum=AUTO;GZIP;BZ2;BROTLI;ZSTD;DEFLATE;RAW_DEFLATE;NONE
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// +optional
+	RecordDelimiter *string `json:"recordDelimiter,omitempty"`
+
+	// +optional
+	FieldDelimiter *string `json:"fieldDelimiter,omitempty"`
+
+	// +optional
+	FileExtension *string `json:"fileExtension,omitempty"`
+
+	// +optional
+	ParseHeader *bool `json:"parseHeader,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SkipHeader *int `json:"skipHeader,omitempty"`
+
+	// +optional
+	SkipBlankLines *bool `json:"skipBlankLines,omitempty"`
+
+	// +optional
+	DateFormat *string `json:"dateFormat,omitempty"`
+
+	// +optional
+	TimeFormat *string `json:"timeFormat,omitempty"`
+
+	// +optional
+	TimestampFormat *string `json:"timestampFormat,omitempty"`
+
+	// +kubebuilder:validation:Enum=HEX;BASE64;UTF8
+	// +optional
+	BinaryFormat *string `json:"binaryFormat,omitempty"`
+
+	// +optional
+	Escape *string `json:"escape,omitempty"`
+
+	// +optional
+	EscapeUnenclosedField *string `json:"escapeUnenclosedField,omitempty"`
+
+	// +optional
+	TrimSpace *bool `json:"trimSpace,omitempty"`
+
+	// +optional
+	FieldOptionallyEnclosedBy *string `json:"fieldOptionallyEnclosedBy,omitempty"`
+
+	// +optional
+	NullIf []string `json:"nullIf,omitempty"`
+
+	// +optional
+	ErrorOnColumnCountMismatch *bool `json:"errorOnColumnCountMismatch,omitempty"`
+
+	// +optional
+	ReplaceInvalidCharacters *bool `json:"replaceInvalidCharacters,omitempty"`
+
+	// +optional
+	EmptyFieldAsNull *bool `json:"emptyFieldAsNull,omitempty"`
+
+	// +optional
+	SkipByteOrderMark *bool `json:"skipByteOrderMark,omitempty"`
+
+	// +optional
+	Encoding *string `json:"encoding,omitempty"`
+}
+
+// JSONOptions are the options of a JSON file format.
+// +kubebuilder:validation:XValidation:rule="!(has(self.replaceInvalidCharacters) && self.replaceInvalidCharacters && has(self.ignoreUtf8Errors) && self.ignoreUtf8Errors)",message="replaceInvalidCharacters and ignoreUtf8Errors are mutually exclusive"
+type JSONOptions struct {
+	// +kubebuilder:validation:Enum=AUTO;GZIP;BZ2;BROTLI;ZSTD;DEFLATE;RAW_DEFLATE;NONE
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// +optional
+	DateFormat *string `json:"dateFormat,omitempty"`
+
+	// +optional
+	TimeFormat *string `json:"timeFormat,omitempty"`
+
+	// +optional
+	TimestampFormat *string `json:"timestampFormat,omitempty"`
+
+	// +kubebuilder:validation:Enum=HEX;BASE64;UTF8
+	// +optional
+	BinaryFormat *string `json:"binaryFormat,omitempty"`
+
+	// +optional
+	TrimSpace *bool `json:"trimSpace,omitempty"`
+
+	// +optional
+	NullIf []string `json:"nullIf,omitempty"`
+
+	// +optional
+	FileExtension *string `json:"fileExtension,omitempty"`
+
+	// +optional
+	EnableOctal *bool `json:"enableOctal,omitempty"`
+
+	// +optional
+	AllowDuplicate *bool `json:"allowDuplicate,omitempty"`
+
+	// +optional
+	StripOuterArray *bool `json:"stripOuterArray,omitempty"`
+
+	// +optional
+	StripNullValues *bool `json:"stripNullValues,omitempty"`
+
+	// +optional
+	ReplaceInvalidCharacters *bool `json:"replaceInvalidCharacters,omitempty"`
+
+	// +optional
+	IgnoreUTF8Errors *bool `json:"ignoreUtf8Errors,omitempty"`
+
+	// +optional
+	SkipByteOrderMark *bool `json:"skipByteOrderMark,omitempty"`
+}
+
+// AvroOptions are the options of an AVRO file format.
+type AvroOptions struct {
+	// +kubebuilder:validation:Enum=AUTO;GZIP;BZ2;BROTLI;ZSTD;DEFLATE;RAW_DEFLATE;NONE
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// +optional
+	TrimSpace *bool `json:"trimSpace,omitempty"`
+
+	// +optional
+	ReplaceInvalidCharacters *bool `json:"replaceInvalidCharacters,omitempty"`
+
+	// +optional
+	NullIf []string `json:"nullIf,omitempty"`
+}
+
+// ORCOptions are the options of an ORC file format.
+type ORCOptions struct {
+	// +optional
+	TrimSpace *bool `json:"trimSpace,omitempty"`
+
+	// +optional
+	ReplaceInvalidCharacters *bool `json:"replaceInvalidCharacters,omitempty"`
+
+	// +optional
+	NullIf []string `json:"nullIf,omitempty"`
+}
+
+// ParquetOptions are the options of a PARQUET file format.
+type ParquetOptions struct {
+	// +kubebuilder:validation:Enum=AUTO;LZO;SNAPPY;NONE
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// +optional
+	BinaryAsText *bool `json:"binaryAsText,omitempty"`
+
+	// +optional
+	UseLogicalType *bool `json:"useLogicalType,omitempty"`
+
+	// +optional
+	UseVectorizedScanner *bool `json:"useVectorizedScanner,omitempty"`
+
+	// +optional
+	TrimSpace *bool `json:"trimSpace,omitempty"`
+
+	// +optional
+	ReplaceInvalidCharacters *bool `json:"replaceInvalidCharacters,omitempty"`
+
+	// +optional
+	NullIf []string `json:"nullIf,omitempty"`
+}
+
+// XMLOptions are the options of an XML file format.
+// +kubebuilder:validation:XValidation:rule="!(has(self.replaceInvalidCharacters) && self.replaceInvalidCharacters && has(self.ignoreUtf8Errors) && self.ignoreUtf8Errors)",message="replaceInvalidCharacters and ignoreUtf8Errors are mutually exclusive"
+type XMLOptions struct {
+	// +kubebuilder:validation:Enum=AUTO;GZIP;BZ2;BROTLI;ZSTD;DEFLATE;RAW_DEFLATE;NONE
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+
+	// +optional
+	IgnoreUTF8Errors *bool `json:"ignoreUtf8Errors,omitempty"`
+
+	// +optional
+	PreserveSpace *bool `json:"preserveSpace,omitempty"`
+
+	// +optional
+	StripOuterElement *bool `json:"stripOuterElement,omitempty"`
+
+	// +optional
+	DisableSnowflakeData *bool `json:"disableSnowflakeData,omitempty"`
+
+	// +optional
+	DisableAutoConvert *bool `json:"disableAutoConvert,omitempty"`
+
+	// +optional
+	ReplaceInvalidCharacters *bool `json:"replaceInvalidCharacters,omitempty"`
+
+	// +optional
+	SkipByteOrderMark *bool `json:"skipByteOrderMark,omitempty"`
+}
+
+// FileFormatObservation are the observable fields of a FileFormat.
+type FileFormatObservation struct {
+	// format type
+	Type string `json:"type,omitempty"`
+
+	// comment of the file format
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the file format
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the file format
+	CreatedOn string `json:"createdOn,omitempty"`
+
+	// names of the options that differ from their Snowflake defaults. Those
+	// the spec does not set are reset to their defaults.
+	NonDefaultOptions []string `json:"nonDefaultOptions,omitempty"`
+}
+
+// A FileFormatSpec defines the desired state of a FileFormat.
+type FileFormatSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FileFormatParameters `json:"forProvider"`
+}
+
+// A FileFormatStatus represents the observed state of a FileFormat.
+type FileFormatStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FileFormatObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FileFormat is a named Snowflake file format.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type FileFormat struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FileFormatSpec   `json:"spec"`
+	Status FileFormatStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FileFormatList contains a list of FileFormat
+type FileFormatList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FileFormat `json:"items"`
+}
+
+// FileFormat type metadata.
+var (
+	FileFormatKind             = reflect.TypeOf(FileFormat{}).Name()
+	FileFormatGroupKind        = schema.GroupKind{Group: Group, Kind: FileFormatKind}.String()
+	FileFormatKindAPIVersion   = FileFormatKind + "." + SchemeGroupVersion.String()
+	FileFormatGroupVersionKind = SchemeGroupVersion.WithKind(FileFormatKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FileFormat{}, &FileFormatList{})
+}
+
+// FileFormatName returns the fully qualified name of a referenced FileFormat,
+// for use when resolving references to it from other resources.
+func FileFormatName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*FileFormat)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
@@ -0,0 +1,579 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvroOptions) DeepCopyInto(out *AvroOptions) {
+	*out = *in
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrimSpace != nil {
+		in, out := &in.TrimSpace, &out.TrimSpace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplaceInvalidCharacters != nil {
+		in, out := &in.ReplaceInvalidCharacters, &out.ReplaceInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NullIf != nil {
+		in, out := &in.NullIf, &out.NullIf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvroOptions.
+func (in *AvroOptions) DeepCopy() *AvroOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AvroOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSVOptions) DeepCopyInto(out *CSVOptions) {
+	*out = *in
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
+	if in.RecordDelimiter != nil {
+		in, out := &in.RecordDelimiter, &out.RecordDelimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.FieldDelimiter != nil {
+		in, out := &in.FieldDelimiter, &out.FieldDelimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.FileExtension != nil {
+		in, out := &in.FileExtension, &out.FileExtension
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParseHeader != nil {
+		in, out := &in.ParseHeader, &out.ParseHeader
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipHeader != nil {
+		in, out := &in.SkipHeader, &out.SkipHeader
+		*out = new(int)
+		**out = **in
+	}
+	if in.SkipBlankLines != nil {
+		in, out := &in.SkipBlankLines, &out.SkipBlankLines
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DateFormat != nil {
+		in, out := &in.DateFormat, &out.DateFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeFormat != nil {
+		in, out := &in.TimeFormat, &out.TimeFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampFormat != nil {
+		in, out := &in.TimestampFormat, &out.TimestampFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.BinaryFormat != nil {
+		in, out := &in.BinaryFormat, &out.BinaryFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Escape != nil {
+		in, out := &in.Escape, &out.Escape
+		*out = new(string)
+		**out = **in
+	}
+	if in.EscapeUnenclosedField != nil {
+		in, out := &in.EscapeUnenclosedField, &out.EscapeUnenclosedField
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrimSpace != nil {
+		in, out := &in.TrimSpace, &out.TrimSpace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FieldOptionallyEnclosedBy != nil {
+		in, out := &in.FieldOptionallyEnclosedBy, &out.FieldOptionallyEnclosedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NullIf != nil {
+		in, out := &in.NullIf, &out.NullIf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ErrorOnColumnCountMismatch != nil {
+		in, out := &in.ErrorOnColumnCountMismatch, &out.ErrorOnColumnCountMismatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplaceInvalidCharacters != nil {
+		in, out := &in.ReplaceInvalidCharacters, &out.ReplaceInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmptyFieldAsNull != nil {
+		in, out := &in.EmptyFieldAsNull, &out.EmptyFieldAsNull
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipByteOrderMark != nil {
+		in, out := &in.SkipByteOrderMark, &out.SkipByteOrderMark
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSVOptions.
+func (in *CSVOptions) DeepCopy() *CSVOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CSVOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileFormat) DeepCopyInto(out *FileFormat) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileFormat.
+func (in *FileFormat) DeepCopy() *FileFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(FileFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FileFormat) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileFormatList) DeepCopyInto(out *FileFormatList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FileFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileFormatList.
+func (in *FileFormatList) DeepCopy() *FileFormatList {
+	if in == nil {
+		return nil
+	}
+	out := new(FileFormatList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FileFormatList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileFormatObservation) DeepCopyInto(out *FileFormatObservation) {
+	*out = *in
+	if in.NonDefaultOptions != nil {
+		in, out := &in.NonDefaultOptions, &out.NonDefaultOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileFormatObservation.
+func (in *FileFormatObservation) DeepCopy() *FileFormatObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FileFormatObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileFormatParameters) DeepCopyInto(out *FileFormatParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(CSVOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JSON != nil {
+		in, out := &in.JSON, &out.JSON
+		*out = new(JSONOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Avro != nil {
+		in, out := &in.Avro, &out.Avro
+		*out = new(AvroOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ORC != nil {
+		in, out := &in.ORC, &out.ORC
+		*out = new(ORCOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parquet != nil {
+		in, out := &in.Parquet, &out.Parquet
+		*out = new(ParquetOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.XML != nil {
+		in, out := &in.XML, &out.XML
+		*out = new(XMLOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileFormatParameters.
+func (in *FileFormatParameters) DeepCopy() *FileFormatParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FileFormatParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileFormatSpec) DeepCopyInto(out *FileFormatSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileFormatSpec.
+func (in *FileFormatSpec) DeepCopy() *FileFormatSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FileFormatSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileFormatStatus) DeepCopyInto(out *FileFormatStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileFormatStatus.
+func (in *FileFormatStatus) DeepCopy() *FileFormatStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FileFormatStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONOptions) DeepCopyInto(out *JSONOptions) {
+	*out = *in
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
+	if in.DateFormat != nil {
+		in, out := &in.DateFormat, &out.DateFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeFormat != nil {
+		in, out := &in.TimeFormat, &out.TimeFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimestampFormat != nil {
+		in, out := &in.TimestampFormat, &out.TimestampFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.BinaryFormat != nil {
+		in, out := &in.BinaryFormat, &out.BinaryFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrimSpace != nil {
+		in, out := &in.TrimSpace, &out.TrimSpace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NullIf != nil {
+		in, out := &in.NullIf, &out.NullIf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileExtension != nil {
+		in, out := &in.FileExtension, &out.FileExtension
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableOctal != nil {
+		in, out := &in.EnableOctal, &out.EnableOctal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowDuplicate != nil {
+		in, out := &in.AllowDuplicate, &out.AllowDuplicate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StripOuterArray != nil {
+		in, out := &in.StripOuterArray, &out.StripOuterArray
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StripNullValues != nil {
+		in, out := &in.StripNullValues, &out.StripNullValues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplaceInvalidCharacters != nil {
+		in, out := &in.ReplaceInvalidCharacters, &out.ReplaceInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreUTF8Errors != nil {
+		in, out := &in.IgnoreUTF8Errors, &out.IgnoreUTF8Errors
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipByteOrderMark != nil {
+		in, out := &in.SkipByteOrderMark, &out.SkipByteOrderMark
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONOptions.
+func (in *JSONOptions) DeepCopy() *JSONOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ORCOptions) DeepCopyInto(out *ORCOptions) {
+	*out = *in
+	if in.TrimSpace != nil {
+		in, out := &in.TrimSpace, &out.TrimSpace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplaceInvalidCharacters != nil {
+		in, out := &in.ReplaceInvalidCharacters, &out.ReplaceInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NullIf != nil {
+		in, out := &in.NullIf, &out.NullIf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ORCOptions.
+func (in *ORCOptions) DeepCopy() *ORCOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ORCOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParquetOptions) DeepCopyInto(out *ParquetOptions) {
+	*out = *in
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
+	if in.BinaryAsText != nil {
+		in, out := &in.BinaryAsText, &out.BinaryAsText
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseLogicalType != nil {
+		in, out := &in.UseLogicalType, &out.UseLogicalType
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseVectorizedScanner != nil {
+		in, out := &in.UseVectorizedScanner, &out.UseVectorizedScanner
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrimSpace != nil {
+		in, out := &in.TrimSpace, &out.TrimSpace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplaceInvalidCharacters != nil {
+		in, out := &in.ReplaceInvalidCharacters, &out.ReplaceInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NullIf != nil {
+		in, out := &in.NullIf, &out.NullIf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParquetOptions.
+func (in *ParquetOptions) DeepCopy() *ParquetOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ParquetOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XMLOptions) DeepCopyInto(out *XMLOptions) {
+	*out = *in
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreUTF8Errors != nil {
+		in, out := &in.IgnoreUTF8Errors, &out.IgnoreUTF8Errors
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreserveSpace != nil {
+		in, out := &in.PreserveSpace, &out.PreserveSpace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StripOuterElement != nil {
+		in, out := &in.StripOuterElement, &out.StripOuterElement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableSnowflakeData != nil {
+		in, out := &in.DisableSnowflakeData, &out.DisableSnowflakeData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableAutoConvert != nil {
+		in, out := &in.DisableAutoConvert, &out.DisableAutoConvert
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplaceInvalidCharacters != nil {
+		in, out := &in.ReplaceInvalidCharacters, &out.ReplaceInvalidCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipByteOrderMark != nil {
+		in, out := &in.SkipByteOrderMark, &out.SkipByteOrderMark
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XMLOptions.
+func (in *XMLOptions) DeepCopy() *XMLOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(XMLOptions)
+	in.DeepCopyInto(out)
+	return out
+}
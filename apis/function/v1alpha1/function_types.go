@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A FunctionArgument is an argument in the signature of a function or
+// procedure.
+type FunctionArgument struct {
+	// name of the argument
+	Name string `json:"name"`
+
+	// SQL data type of the argument, e.g. VARCHAR
+	Type string `json:"type"`
+}
+
+// FunctionParameters are the configurable fields of a Function.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="(!has(self.language) || self.language in ['SQL', 'JAVASCRIPT']) ? has(self.body) : (has(self.handler) && has(self.runtimeVersion))",message="SQL and JavaScript functions need a body, Python and Java functions a handler and runtimeVersion"
+type FunctionParameters struct {
+	// name of the function
+	Name string `json:"name"`
+
+	// database the function is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the function is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// arguments of the function. Snowflake identifies functions by name and
+	// argument types, so changing the types replaces the function.
+	// +optional
+	Arguments []FunctionArgument `json:"arguments,omitempty"`
+
+	// SQL data type returned by the function, e.g. VARCHAR or
+	// TABLE (id NUMBER, name VARCHAR)
+	ReturnType string `json:"returnType"`
+
+	// language of the handler
+	// +kubebuilder:validation:Enum=SQL;JAVASCRIPT;PYTHON;JAVA
+	// +kubebuilder:default=SQL
+	// +optional
+	Language string `json:"language,omitempty"`
+
+	// version of the Python or Java runtime, e.g. 3.11
+	// +optional
+	RuntimeVersion *string `json:"runtimeVersion,omitempty"`
+
+	// Anaconda or Maven packages available to the handler, e.g. numpy or
+	// numpy==1.26.4
+	// +optional
+	Packages []string `json:"packages,omitempty"`
+
+	// staged files imported by the handler, e.g. @libs/handlers.py
+	// +optional
+	Imports []string `json:"imports,omitempty"`
+
+	// Python function or Java method handling calls, e.g. handlers.run
+	// +optional
+	Handler *string `json:"handler,omitempty"`
+
+	// code of the function: a SQL expression, or JavaScript, Python or Java
+	// source inlined instead of imported. It is compared with the body
+	// Snowflake reports with whitespace collapsed.
+	// +optional
+	Body *string `json:"body,omitempty"`
+}
+
+// FunctionObservation are the observable fields of a Function.
+type FunctionObservation struct {
+	// signature of the function, e.g. (A VARCHAR, B NUMBER)
+	Signature string `json:"signature,omitempty"`
+
+	// SQL data type returned by the function
+	ReturnType string `json:"returnType,omitempty"`
+
+	// language of the handler
+	Language string `json:"language,omitempty"`
+
+	// version of the Python or Java runtime
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+
+	// packages available to the handler
+	Packages []string `json:"packages,omitempty"`
+
+	// staged files imported by the handler
+	Imports []string `json:"imports,omitempty"`
+
+	// function or method handling calls
+	Handler string `json:"handler,omitempty"`
+
+	// code of the function
+	Body string `json:"body,omitempty"`
+}
+
+// A FunctionSpec defines the desired state of a Function.
+type FunctionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionParameters `json:"forProvider"`
+}
+
+// A FunctionStatus represents the observed state of a Function.
+type FunctionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Function is a user-defined function, identified by its name and argument
+// types. Its external name is the signature it was created with, e.g.
+// DB.PUBLIC.NORMALIZE(VARCHAR).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionList contains a list of Function
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Function `json:"items"`
+}
+
+// Function type metadata.
+var (
+	FunctionKind             = reflect.TypeOf(Function{}).Name()
+	FunctionGroupKind        = schema.GroupKind{Group: Group, Kind: FunctionKind}.String()
+	FunctionKindAPIVersion   = FunctionKind + "." + SchemeGroupVersion.String()
+	FunctionGroupVersionKind = SchemeGroupVersion.WithKind(FunctionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Function{}, &FunctionList{})
+}
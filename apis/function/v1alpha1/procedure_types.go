@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProcedureParameters are the configurable fields of a Procedure.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="(!has(self.language) || self.language in ['SQL', 'JAVASCRIPT']) ? has(self.body) : (has(self.handler) && has(self.runtimeVersion))",message="SQL and JavaScript procedures need a body, Python and Java procedures a handler and runtimeVersion"
+type ProcedureParameters struct {
+	// name of the procedure
+	Name string `json:"name"`
+
+	// database the procedure is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the procedure is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// arguments of the procedure. Snowflake identifies procedures by name and
+	// argument types, so changing the types replaces the procedure.
+	// +optional
+	Arguments []FunctionArgument `json:"arguments,omitempty"`
+
+	// SQL data type returned by the procedure, e.g. VARCHAR or
+	// TABLE (id NUMBER, name VARCHAR)
+	ReturnType string `json:"returnType"`
+
+	// language of the handler
+	// +kubebuilder:validation:Enum=SQL;JAVASCRIPT;PYTHON;JAVA
+	// +kubebuilder:default=SQL
+	// +optional
+	Language string `json:"language,omitempty"`
+
+	// version of the Python or Java runtime, e.g. 3.11
+	// +optional
+	RuntimeVersion *string `json:"runtimeVersion,omitempty"`
+
+	// Anaconda or Maven packages available to the handler, e.g. numpy or
+	// numpy==1.26.4
+	// +optional
+	Packages []string `json:"packages,omitempty"`
+
+	// staged files imported by the handler, e.g. @libs/handlers.py
+	// +optional
+	Imports []string `json:"imports,omitempty"`
+
+	// Python function or Java method handling calls, e.g. handlers.run
+	// +optional
+	Handler *string `json:"handler,omitempty"`
+
+	// code of the procedure: Snowflake Scripting, or JavaScript, Python or
+	// Java source inlined instead of imported. It is compared with the body
+	// Snowflake reports with whitespace collapsed.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// role whose privileges the procedure runs with
+	// +kubebuilder:validation:Enum=OWNER;CALLER
+	// +kubebuilder:default=OWNER
+	// +optional
+	ExecuteAs string `json:"executeAs,omitempty"`
+}
+
+// ProcedureObservation are the observable fields of a Procedure.
+type ProcedureObservation struct {
+	// signature of the procedure, e.g. (A VARCHAR, B NUMBER)
+	Signature string `json:"signature,omitempty"`
+
+	// SQL data type returned by the procedure
+	ReturnType string `json:"returnType,omitempty"`
+
+	// language of the handler
+	Language string `json:"language,omitempty"`
+
+	// version of the Python or Java runtime
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+
+	// packages available to the handler
+	Packages []string `json:"packages,omitempty"`
+
+	// staged files imported by the handler
+	Imports []string `json:"imports,omitempty"`
+
+	// function or method handling calls
+	Handler string `json:"handler,omitempty"`
+
+	// code of the procedure
+	Body string `json:"body,omitempty"`
+
+	// role whose privileges the procedure runs with
+	ExecuteAs string `json:"executeAs,omitempty"`
+}
+
+// A ProcedureSpec defines the desired state of a Procedure.
+type ProcedureSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProcedureParameters `json:"forProvider"`
+}
+
+// A ProcedureStatus represents the observed state of a Procedure.
+type ProcedureStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProcedureObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Procedure is a stored procedure, identified by its name and argument
+// types. Its external name is the signature it was created with, e.g.
+// DB.PUBLIC.LOAD_ORDERS(DATE).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Procedure struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProcedureSpec   `json:"spec"`
+	Status ProcedureStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProcedureList contains a list of Procedure
+type ProcedureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Procedure `json:"items"`
+}
+
+// Procedure type metadata.
+var (
+	ProcedureKind             = reflect.TypeOf(Procedure{}).Name()
+	ProcedureGroupKind        = schema.GroupKind{Group: Group, Kind: ProcedureKind}.String()
+	ProcedureKindAPIVersion   = ProcedureKind + "." + SchemeGroupVersion.String()
+	ProcedureGroupVersionKind = SchemeGroupVersion.WithKind(ProcedureKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Procedure{}, &ProcedureList{})
+}
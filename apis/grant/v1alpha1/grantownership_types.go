@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Objects of a schema or database whose ownership is transferred.
+const (
+	GrantOwnershipObjectsAll    = "All"
+	GrantOwnershipObjectsFuture = "Future"
+)
+
+// GrantOwnershipParameters are the configurable fields of a GrantOwnership.
+// Ownership is transferred either of a single object, given by objectName,
+// or of all or future objects of the schema or database given by inSchema or
+// inDatabase.
+// +kubebuilder:validation:XValidation:rule="has(self.objectName) != has(self.objects)",message="exactly one of objectName or objects is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.objects) || has(self.inDatabase) != has(self.inSchema)",message="exactly one of inDatabase or inSchema is required with objects"
+// +kubebuilder:validation:XValidation:rule="!has(self.objects) || self.objectType != 'DATABASE'",message="objects cannot be used with DATABASE"
+// +kubebuilder:validation:XValidation:rule="has(self.toRole) != has(self.toDatabaseRole)",message="exactly one of toRole or toDatabaseRole is required"
+type GrantOwnershipParameters struct {
+	// kind of the objects
+	// +kubebuilder:validation:Enum=DATABASE;SCHEMA;TABLE;EXTERNAL_TABLE;DYNAMIC_TABLE;ICEBERG_TABLE;VIEW;MATERIALIZED_VIEW;STAGE;FILE_FORMAT;SEQUENCE;STREAM;TASK;PIPE;ALERT;TAG;MASKING_POLICY;ROW_ACCESS_POLICY;SECRET
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectType is immutable"
+	ObjectType string `json:"objectType"`
+
+	// fully qualified name of the object, e.g. db.schema.table
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectName is immutable"
+	// +optional
+	ObjectName *string `json:"objectName,omitempty"`
+
+	// objects of the schema or database whose ownership is transferred. All
+	// transfers the existing objects, Future those created from now on.
+	// +kubebuilder:validation:Enum=All;Future
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objects is immutable"
+	// +optional
+	Objects *string `json:"objects,omitempty"`
+
+	// database holding the objects
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="inDatabase is immutable"
+	// +optional
+	InDatabase *string `json:"inDatabase,omitempty"`
+
+	// schema holding the objects, as db.schema
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="inSchema is immutable"
+	// +optional
+	InSchema *string `json:"inSchema,omitempty"`
+
+	// account role ownership is transferred to
+	// +optional
+	ToRole *string `json:"toRole,omitempty"`
+
+	// database role ownership is transferred to, as db.role
+	// +optional
+	ToDatabaseRole *string `json:"toDatabaseRole,omitempty"`
+
+	// what happens to the privileges granted on the objects. COPY keeps them,
+	// REVOKE revokes them. Not used for future objects.
+	// +kubebuilder:validation:Enum=COPY;REVOKE
+	// +kubebuilder:default=COPY
+	// +optional
+	CurrentGrants string `json:"currentGrants,omitempty"`
+
+	// account role ownership is returned to when the resource is deleted.
+	// Ownership is kept when unset. Ownership of future objects is always
+	// revoked on delete.
+	// +optional
+	RevertToRole *string `json:"revertToRole,omitempty"`
+}
+
+// GrantOwnershipObservation are the observable fields of a GrantOwnership.
+type GrantOwnershipObservation struct {
+	// role owning the object, or all the objects
+	Owner string `json:"owner,omitempty"`
+}
+
+// A GrantOwnershipSpec defines the desired state of a GrantOwnership.
+type GrantOwnershipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GrantOwnershipParameters `json:"forProvider"`
+}
+
+// A GrantOwnershipStatus represents the observed state of a GrantOwnership.
+type GrantOwnershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GrantOwnershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GrantOwnership transfers ownership of an object, or of all or future
+// objects of a schema or database, to a role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.objectType"
+// +kubebuilder:printcolumn:name="OWNER",type="string",JSONPath=".status.atProvider.owner"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type GrantOwnership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GrantOwnershipSpec   `json:"spec"`
+	Status GrantOwnershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GrantOwnershipList contains a list of GrantOwnership
+type GrantOwnershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GrantOwnership `json:"items"`
+}
+
+// GrantOwnership type metadata.
+var (
+	GrantOwnershipKind             = reflect.TypeOf(GrantOwnership{}).Name()
+	GrantOwnershipGroupKind        = schema.GroupKind{Group: Group, Kind: GrantOwnershipKind}.String()
+	GrantOwnershipKindAPIVersion   = GrantOwnershipKind + "." + SchemeGroupVersion.String()
+	GrantOwnershipGroupVersionKind = SchemeGroupVersion.WithKind(GrantOwnershipKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GrantOwnership{}, &GrantOwnershipList{})
+}
@@ -0,0 +1,183 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantOwnership) DeepCopyInto(out *GrantOwnership) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantOwnership.
+func (in *GrantOwnership) DeepCopy() *GrantOwnership {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantOwnership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrantOwnership) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantOwnershipList) DeepCopyInto(out *GrantOwnershipList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GrantOwnership, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantOwnershipList.
+func (in *GrantOwnershipList) DeepCopy() *GrantOwnershipList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantOwnershipList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrantOwnershipList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantOwnershipObservation) DeepCopyInto(out *GrantOwnershipObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantOwnershipObservation.
+func (in *GrantOwnershipObservation) DeepCopy() *GrantOwnershipObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantOwnershipObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantOwnershipParameters) DeepCopyInto(out *GrantOwnershipParameters) {
+	*out = *in
+	if in.ObjectName != nil {
+		in, out := &in.ObjectName, &out.ObjectName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = new(string)
+		**out = **in
+	}
+	if in.InDatabase != nil {
+		in, out := &in.InDatabase, &out.InDatabase
+		*out = new(string)
+		**out = **in
+	}
+	if in.InSchema != nil {
+		in, out := &in.InSchema, &out.InSchema
+		*out = new(string)
+		**out = **in
+	}
+	if in.ToRole != nil {
+		in, out := &in.ToRole, &out.ToRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.ToDatabaseRole != nil {
+		in, out := &in.ToDatabaseRole, &out.ToDatabaseRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.RevertToRole != nil {
+		in, out := &in.RevertToRole, &out.RevertToRole
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantOwnershipParameters.
+func (in *GrantOwnershipParameters) DeepCopy() *GrantOwnershipParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantOwnershipParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantOwnershipSpec) DeepCopyInto(out *GrantOwnershipSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantOwnershipSpec.
+func (in *GrantOwnershipSpec) DeepCopy() *GrantOwnershipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantOwnershipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantOwnershipStatus) DeepCopyInto(out *GrantOwnershipStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantOwnershipStatus.
+func (in *GrantOwnershipStatus) DeepCopy() *GrantOwnershipStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantOwnershipStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of an ApiIntegration holding the identities the
+// proxy service has to trust.
+const (
+	ConnectionDetailAPIAWSIAMUserARN     = "api_aws_iam_user_arn"
+	ConnectionDetailAPIAWSExternalID     = "api_aws_external_id"
+	ConnectionDetailAPIGCPServiceAccount = "api_gcp_service_account"
+)
+
+// ApiIntegrationParameters are the configurable fields of an ApiIntegration.
+// +kubebuilder:validation:XValidation:rule="!self.apiProvider.startsWith('aws_') || has(self.apiAwsRoleArn)",message="apiAwsRoleArn is required for Amazon API Gateway"
+// +kubebuilder:validation:XValidation:rule="!self.apiProvider.startsWith('azure_') || (has(self.azureTenantId) && has(self.azureAdApplicationId))",message="azureTenantId and azureAdApplicationId are required for Azure API Management"
+// +kubebuilder:validation:XValidation:rule="self.apiProvider != 'google_api_gateway' || has(self.googleAudience)",message="googleAudience is required for Google Cloud API Gateway"
+type ApiIntegrationParameters struct {
+	// name of the API integration
+	Name string `json:"name"`
+
+	// proxy service or Git provider the integration connects to
+	// +kubebuilder:validation:Enum=aws_api_gateway;aws_private_api_gateway;aws_gov_api_gateway;aws_gov_private_api_gateway;azure_api_management;azure_private_api_management;google_api_gateway;git_https_api
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="apiProvider is immutable"
+	APIProvider string `json:"apiProvider"`
+
+	// ARN of the AWS role Snowflake assumes to call the API Gateway
+	// +optional
+	APIAWSRoleARN *string `json:"apiAwsRoleArn,omitempty"`
+
+	// ID of the Azure Active Directory tenant
+	// +optional
+	AzureTenantID *string `json:"azureTenantId,omitempty"`
+
+	// application ID of the Azure AD application of the API Management
+	// service
+	// +optional
+	AzureADApplicationID *string `json:"azureAdApplicationId,omitempty"`
+
+	// audience claim of the Google Cloud API Gateway
+	// +optional
+	GoogleAudience *string `json:"googleAudience,omitempty"`
+
+	// APIKeySecretRef selects the secret key holding the API key sent to the
+	// proxy service
+	// +optional
+	APIKeySecretRef *xpv1.SecretKeySelector `json:"apiKeySecretRef,omitempty"`
+
+	// URL prefixes of the endpoints external functions may call
+	// +kubebuilder:validation:MinItems=1
+	APIAllowedPrefixes []string `json:"apiAllowedPrefixes"`
+
+	// URL prefixes of the endpoints external functions may not call
+	// +optional
+	APIBlockedPrefixes []string `json:"apiBlockedPrefixes,omitempty"`
+
+	// whether the integration can be used
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// comment of the API integration
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ApiIntegrationObservation are the observable fields of an ApiIntegration.
+type ApiIntegrationObservation struct {
+	// whether the integration can be used
+	Enabled bool `json:"enabled,omitempty"`
+
+	// proxy service or Git provider
+	APIProvider string `json:"apiProvider,omitempty"`
+
+	// ARN of the AWS role Snowflake assumes
+	APIAWSRoleARN string `json:"apiAwsRoleArn,omitempty"`
+
+	// ARN of the AWS IAM user Snowflake assumes the role with
+	APIAWSIAMUserARN string `json:"apiAwsIamUserArn,omitempty"`
+
+	// external ID Snowflake passes when assuming the role
+	APIAWSExternalID string `json:"apiAwsExternalId,omitempty"`
+
+	// Google service account Snowflake calls the API Gateway with
+	APIGCPServiceAccount string `json:"apiGcpServiceAccount,omitempty"`
+
+	// URL to grant Snowflake access to the Azure API Management service
+	AzureConsentURL string `json:"azureConsentUrl,omitempty"`
+
+	// name of the Snowflake application in Azure
+	AzureMultiTenantAppName string `json:"azureMultiTenantAppName,omitempty"`
+
+	// allowed URL prefixes
+	APIAllowedPrefixes []string `json:"apiAllowedPrefixes,omitempty"`
+
+	// blocked URL prefixes
+	APIBlockedPrefixes []string `json:"apiBlockedPrefixes,omitempty"`
+
+	// comment of the API integration
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the API integration
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A ApiIntegrationSpec defines the desired state of a ApiIntegration.
+type ApiIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApiIntegrationParameters `json:"forProvider"`
+}
+
+// A ApiIntegrationStatus represents the observed state of a ApiIntegration.
+type ApiIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApiIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApiIntegration connects external functions to a proxy service such as
+// Amazon API Gateway, or Snowflake to a Git provider.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ApiIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApiIntegrationSpec   `json:"spec"`
+	Status ApiIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApiIntegrationList contains a list of ApiIntegration
+type ApiIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApiIntegration `json:"items"`
+}
+
+// ApiIntegration type metadata.
+var (
+	ApiIntegrationKind             = reflect.TypeOf(ApiIntegration{}).Name()
+	ApiIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: ApiIntegrationKind}.String()
+	ApiIntegrationKindAPIVersion   = ApiIntegrationKind + "." + SchemeGroupVersion.String()
+	ApiIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(ApiIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ApiIntegration{}, &ApiIntegrationList{})
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Connection secret keys of a CatalogIntegration. They hold the identity
+// Snowflake uses to access an AWS Glue catalog, which has to be trusted in the
+// trust policy of the AWS role.
+const (
+	ConnectionDetailGlueAWSIAMUserARN = "glue_aws_iam_user_arn"
+	ConnectionDetailGlueAWSExternalID = "glue_aws_external_id"
+)
+
+// CatalogIntegrationParameters are the configurable fields of a
+// CatalogIntegration.
+// +kubebuilder:validation:XValidation:rule="(self.catalogSource == 'GLUE') == has(self.glue)",message="glue is required for, and only allowed with, GLUE catalogs"
+// +kubebuilder:validation:XValidation:rule="(self.catalogSource == 'POLARIS') == has(self.polaris)",message="polaris is required for, and only allowed with, POLARIS catalogs"
+type CatalogIntegrationParameters struct {
+	// name of the catalog integration
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// catalog the table metadata is read from
+	// +kubebuilder:validation:Enum=GLUE;OBJECT_STORE;POLARIS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogSource is immutable"
+	CatalogSource string `json:"catalogSource"`
+
+	// format of the tables in the catalog
+	// +kubebuilder:validation:Enum=ICEBERG;DELTA
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tableFormat is immutable"
+	// +kubebuilder:default=ICEBERG
+	// +optional
+	TableFormat string `json:"tableFormat,omitempty"`
+
+	// default namespace of the tables in an AWS Glue or Polaris catalog
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogNamespace is immutable"
+	// +optional
+	CatalogNamespace *string `json:"catalogNamespace,omitempty"`
+
+	// AWS Glue catalog settings
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="glue is immutable"
+	// +optional
+	Glue *GlueCatalog `json:"glue,omitempty"`
+
+	// Polaris catalog settings
+	// +optional
+	Polaris *PolarisCatalog `json:"polaris,omitempty"`
+
+	// whether Iceberg tables can use the integration
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// interval in seconds at which Snowflake polls the catalog for changes
+	// of auto-refresh tables
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	RefreshIntervalSeconds *int `json:"refreshIntervalSeconds,omitempty"`
+
+	// comment of the catalog integration
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// GlueCatalog configures access to an AWS Glue Data Catalog.
+type GlueCatalog struct {
+	// ARN of the AWS role Snowflake assumes to access Glue
+	AWSRoleARN string `json:"awsRoleArn"`
+
+	// ID of the AWS account of the catalog
+	CatalogID string `json:"catalogId"`
+
+	// AWS region of the catalog, defaulting to that of the Snowflake account
+	// +optional
+	Region *string `json:"region,omitempty"`
+}
+
+// PolarisCatalog configures access to a Polaris catalog through its Iceberg
+// REST API.
+type PolarisCatalog struct {
+	// URL of the REST API of the catalog
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogUri is immutable"
+	CatalogURI string `json:"catalogUri"`
+
+	// name of the catalog in Polaris
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogName is immutable"
+	CatalogName string `json:"catalogName"`
+
+	// ID of the OAuth client of the service connection
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="oauthClientId is immutable"
+	OAuthClientID string `json:"oauthClientId"`
+
+	// OAuthClientSecretSecretRef selects the secret key holding the secret
+	// of the OAuth client
+	OAuthClientSecretSecretRef xpv1.SecretKeySelector `json:"oauthClientSecretSecretRef"`
+
+	// scopes requested for the OAuth token
+	// +kubebuilder:default={"PRINCIPAL_ROLE:ALL"}
+	// +optional
+	OAuthAllowedScopes []string `json:"oauthAllowedScopes,omitempty"`
+}
+
+// CatalogIntegrationObservation are the observable fields of a
+// CatalogIntegration.
+type CatalogIntegrationObservation struct {
+	// whether Iceberg tables can use the integration
+	Enabled bool `json:"enabled,omitempty"`
+
+	// catalog the table metadata is read from
+	CatalogSource string `json:"catalogSource,omitempty"`
+
+	// format of the tables in the catalog
+	TableFormat string `json:"tableFormat,omitempty"`
+
+	// default namespace of the tables
+	CatalogNamespace string `json:"catalogNamespace,omitempty"`
+
+	// ARN of the AWS role Snowflake assumes to access Glue
+	GlueAWSRoleARN string `json:"glueAwsRoleArn,omitempty"`
+
+	// ARN of the AWS IAM user Snowflake assumes the role with
+	GlueAWSIAMUserARN string `json:"glueAwsIamUserArn,omitempty"`
+
+	// external ID Snowflake passes when assuming the role
+	GlueAWSExternalID string `json:"glueAwsExternalId,omitempty"`
+
+	// interval in seconds at which Snowflake polls the catalog
+	RefreshIntervalSeconds string `json:"refreshIntervalSeconds,omitempty"`
+
+	// comment of the catalog integration
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the catalog integration
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A CatalogIntegrationSpec defines the desired state of a CatalogIntegration.
+type CatalogIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CatalogIntegrationParameters `json:"forProvider"`
+}
+
+// A CatalogIntegrationStatus represents the observed state of a CatalogIntegration.
+type CatalogIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CatalogIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CatalogIntegration lets Iceberg tables read their metadata from an
+// external catalog.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SOURCE",type="string",JSONPath=".status.atProvider.catalogSource"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type CatalogIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CatalogIntegrationSpec   `json:"spec"`
+	Status CatalogIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CatalogIntegrationList contains a list of CatalogIntegration
+type CatalogIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CatalogIntegration `json:"items"`
+}
+
+// CatalogIntegration type metadata.
+var (
+	CatalogIntegrationKind             = reflect.TypeOf(CatalogIntegration{}).Name()
+	CatalogIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: CatalogIntegrationKind}.String()
+	CatalogIntegrationKindAPIVersion   = CatalogIntegrationKind + "." + SchemeGroupVersion.String()
+	CatalogIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(CatalogIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CatalogIntegration{}, &CatalogIntegrationList{})
+}
+
+// CatalogIntegrationName returns the name of a referenced CatalogIntegration.
+func CatalogIntegrationName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*CatalogIntegration)
+		if !ok {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ExternalAccessIntegrationParameters are the configurable fields of an
+// ExternalAccessIntegration.
+type ExternalAccessIntegrationParameters struct {
+	// name of the external access integration
+	Name string `json:"name"`
+
+	// fully qualified names of the EGRESS network rules naming the hosts
+	// functions and procedures may reach
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/network/v1alpha1.NetworkRule
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/network/v1alpha1.NetworkRuleName()
+	// +optional
+	AllowedNetworkRules []string `json:"allowedNetworkRules,omitempty"`
+
+	// AllowedNetworkRulesRefs references NetworkRules to populate
+	// allowedNetworkRules.
+	// +optional
+	AllowedNetworkRulesRefs []xpv1.Reference `json:"allowedNetworkRulesRefs,omitempty"`
+
+	// AllowedNetworkRulesSelector selects references to NetworkRules to
+	// populate allowedNetworkRules.
+	// +optional
+	AllowedNetworkRulesSelector *xpv1.Selector `json:"allowedNetworkRulesSelector,omitempty"`
+
+	// security integrations functions may use to authenticate, e.g. for
+	// OAuth
+	// +optional
+	AllowedAPIAuthenticationIntegrations []string `json:"allowedApiAuthenticationIntegrations,omitempty"`
+
+	// fully qualified names of the secrets functions may use
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1.Secret
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1.SecretName()
+	// +optional
+	AllowedAuthenticationSecrets []string `json:"allowedAuthenticationSecrets,omitempty"`
+
+	// AllowedAuthenticationSecretsRefs references Secrets to populate
+	// allowedAuthenticationSecrets.
+	// +optional
+	AllowedAuthenticationSecretsRefs []xpv1.Reference `json:"allowedAuthenticationSecretsRefs,omitempty"`
+
+	// AllowedAuthenticationSecretsSelector selects references to Secrets to
+	// populate allowedAuthenticationSecrets.
+	// +optional
+	AllowedAuthenticationSecretsSelector *xpv1.Selector `json:"allowedAuthenticationSecretsSelector,omitempty"`
+
+	// whether the integration can be used
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// comment of the external access integration
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ExternalAccessIntegrationObservation are the observable fields of an
+// ExternalAccessIntegration.
+type ExternalAccessIntegrationObservation struct {
+	// whether the integration can be used
+	Enabled bool `json:"enabled,omitempty"`
+
+	// allowed network rules
+	AllowedNetworkRules []string `json:"allowedNetworkRules,omitempty"`
+
+	// allowed security integrations
+	AllowedAPIAuthenticationIntegrations []string `json:"allowedApiAuthenticationIntegrations,omitempty"`
+
+	// allowed secrets
+	AllowedAuthenticationSecrets []string `json:"allowedAuthenticationSecrets,omitempty"`
+
+	// comment of the external access integration
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the external access integration
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A ExternalAccessIntegrationSpec defines the desired state of a ExternalAccessIntegration.
+type ExternalAccessIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ExternalAccessIntegrationParameters `json:"forProvider"`
+}
+
+// A ExternalAccessIntegrationStatus represents the observed state of a ExternalAccessIntegration.
+type ExternalAccessIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ExternalAccessIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ExternalAccessIntegration lets functions and procedures reach external
+// network locations and use secrets.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ExternalAccessIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalAccessIntegrationSpec   `json:"spec"`
+	Status ExternalAccessIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalAccessIntegrationList contains a list of ExternalAccessIntegration
+type ExternalAccessIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalAccessIntegration `json:"items"`
+}
+
+// ExternalAccessIntegration type metadata.
+var (
+	ExternalAccessIntegrationKind             = reflect.TypeOf(ExternalAccessIntegration{}).Name()
+	ExternalAccessIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: ExternalAccessIntegrationKind}.String()
+	ExternalAccessIntegrationKindAPIVersion   = ExternalAccessIntegrationKind + "." + SchemeGroupVersion.String()
+	ExternalAccessIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(ExternalAccessIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalAccessIntegration{}, &ExternalAccessIntegrationList{})
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of a NotificationIntegration holding the identities
+// the cloud provider has to trust.
+const (
+	ConnectionDetailSFAWSIAMUserARN         = "sf_aws_iam_user_arn"
+	ConnectionDetailSFAWSExternalID         = "sf_aws_external_id"
+	ConnectionDetailGCPPubsubServiceAccount = "gcp_pubsub_service_account"
+)
+
+// NotificationIntegrationParameters are the configurable fields of a
+// NotificationIntegration.
+// +kubebuilder:validation:XValidation:rule="self.type != 'QUEUE' || (has(self.direction) && has(self.notificationProvider))",message="direction and notificationProvider are required for QUEUE integrations"
+// +kubebuilder:validation:XValidation:rule="self.type != 'EMAIL' || has(self.allowedRecipients)",message="allowedRecipients is required for EMAIL integrations"
+// +kubebuilder:validation:XValidation:rule="self.type != 'WEBHOOK' || has(self.webhookUrl)",message="webhookUrl is required for WEBHOOK integrations"
+type NotificationIntegrationParameters struct {
+	// name of the notification integration
+	Name string `json:"name"`
+
+	// QUEUE integrations connect to a cloud message queue, EMAIL integrations
+	// send emails and WEBHOOK integrations call a webhook
+	// +kubebuilder:validation:Enum=QUEUE;EMAIL;WEBHOOK
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// INBOUND queues deliver cloud storage events to Snowflake, OUTBOUND
+	// queues receive error notifications from Snowflake
+	// +kubebuilder:validation:Enum=INBOUND;OUTBOUND
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="direction is immutable"
+	// +optional
+	Direction *string `json:"direction,omitempty"`
+
+	// message queue service
+	// +kubebuilder:validation:Enum=AWS_SNS;GCP_PUBSUB;AZURE_STORAGE_QUEUE;AZURE_EVENT_GRID
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="notificationProvider is immutable"
+	// +optional
+	NotificationProvider *string `json:"notificationProvider,omitempty"`
+
+	// ARN of the SNS topic notifications are published to
+	// +optional
+	AWSSNSTopicARN *string `json:"awsSnsTopicArn,omitempty"`
+
+	// ARN of the AWS role Snowflake assumes to publish to the topic
+	// +optional
+	AWSSNSRoleARN *string `json:"awsSnsRoleArn,omitempty"`
+
+	// Pub/Sub subscription Snowflake receives storage events from
+	// +optional
+	GCPPubsubSubscriptionName *string `json:"gcpPubsubSubscriptionName,omitempty"`
+
+	// Pub/Sub topic notifications are published to
+	// +optional
+	GCPPubsubTopicName *string `json:"gcpPubsubTopicName,omitempty"`
+
+	// URL of the Azure storage queue Snowflake receives storage events from
+	// +optional
+	AzureStorageQueuePrimaryURI *string `json:"azureStorageQueuePrimaryUri,omitempty"`
+
+	// endpoint of the Event Grid topic notifications are published to
+	// +optional
+	AzureEventGridTopicEndpoint *string `json:"azureEventGridTopicEndpoint,omitempty"`
+
+	// ID of the Azure Active Directory tenant
+	// +optional
+	AzureTenantID *string `json:"azureTenantId,omitempty"`
+
+	// email addresses notifications may be sent to
+	// +optional
+	AllowedRecipients []string `json:"allowedRecipients,omitempty"`
+
+	// URL of the webhook
+	// +optional
+	WebhookURL *string `json:"webhookUrl,omitempty"`
+
+	// fully qualified name of the secret substituted for
+	// SNOWFLAKE_WEBHOOK_SECRET in the url, body and headers of the webhook
+	// +optional
+	WebhookSecret *string `json:"webhookSecret,omitempty"`
+
+	// body of the webhook request, SNOWFLAKE_WEBHOOK_MESSAGE is replaced by
+	// the notification
+	// +optional
+	WebhookBodyTemplate *string `json:"webhookBodyTemplate,omitempty"`
+
+	// headers of the webhook request
+	// +optional
+	WebhookHeaders map[string]string `json:"webhookHeaders,omitempty"`
+
+	// whether the integration can be used
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// comment of the notification integration
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// NotificationIntegrationObservation are the observable fields of a
+// NotificationIntegration.
+type NotificationIntegrationObservation struct {
+	// whether the integration can be used
+	Enabled bool `json:"enabled,omitempty"`
+
+	// type of the integration
+	Type string `json:"type,omitempty"`
+
+	// direction of a queue integration
+	Direction string `json:"direction,omitempty"`
+
+	// message queue service
+	NotificationProvider string `json:"notificationProvider,omitempty"`
+
+	// ARN of the AWS IAM user Snowflake assumes the SNS role with
+	SFAWSIAMUserARN string `json:"sfAwsIamUserArn,omitempty"`
+
+	// external ID Snowflake passes when assuming the SNS role
+	SFAWSExternalID string `json:"sfAwsExternalId,omitempty"`
+
+	// Google service account Snowflake accesses Pub/Sub with
+	GCPPubsubServiceAccount string `json:"gcpPubsubServiceAccount,omitempty"`
+
+	// URL to grant Snowflake access to the Azure queue or topic
+	AzureConsentURL string `json:"azureConsentUrl,omitempty"`
+
+	// name of the Snowflake application in Azure
+	AzureMultiTenantAppName string `json:"azureMultiTenantAppName,omitempty"`
+
+	// properties of the integration as reported by DESC INTEGRATION
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// comment of the notification integration
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the notification integration
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A NotificationIntegrationSpec defines the desired state of a NotificationIntegration.
+type NotificationIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotificationIntegrationParameters `json:"forProvider"`
+}
+
+// A NotificationIntegrationStatus represents the observed state of a NotificationIntegration.
+type NotificationIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotificationIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NotificationIntegration connects Snowflake to a cloud message queue, to
+// email or to a webhook.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type NotificationIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationIntegrationSpec   `json:"spec"`
+	Status NotificationIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationIntegrationList contains a list of NotificationIntegration
+type NotificationIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationIntegration `json:"items"`
+}
+
+// NotificationIntegration type metadata.
+var (
+	NotificationIntegrationKind             = reflect.TypeOf(NotificationIntegration{}).Name()
+	NotificationIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: NotificationIntegrationKind}.String()
+	NotificationIntegrationKindAPIVersion   = NotificationIntegrationKind + "." + SchemeGroupVersion.String()
+	NotificationIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(NotificationIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NotificationIntegration{}, &NotificationIntegrationList{})
+}
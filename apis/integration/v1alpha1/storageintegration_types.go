@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Connection secret keys of a StorageIntegration. They hold the identities
+// Snowflake uses to access the storage, which have to be trusted by the
+// cloud provider, e.g. in the trust policy of the AWS role.
+const (
+	ConnectionDetailStorageAWSIAMUserARN     = "storage_aws_iam_user_arn"
+	ConnectionDetailStorageAWSExternalID     = "storage_aws_external_id"
+	ConnectionDetailStorageGCPServiceAccount = "storage_gcp_service_account"
+	ConnectionDetailAzureConsentURL          = "azure_consent_url"
+	ConnectionDetailAzureMultiTenantAppName  = "azure_multi_tenant_app_name"
+)
+
+// StorageIntegrationParameters are the configurable fields of a
+// StorageIntegration.
+// +kubebuilder:validation:XValidation:rule="!self.storageProvider.startsWith('S3') || has(self.storageAwsRoleArn)",message="storageAwsRoleArn is required for S3 storage"
+// +kubebuilder:validation:XValidation:rule="self.storageProvider != 'AZURE' || has(self.azureTenantId)",message="azureTenantId is required for Azure storage"
+type StorageIntegrationParameters struct {
+	// name of the storage integration
+	Name string `json:"name"`
+
+	// cloud storage service
+	// +kubebuilder:validation:Enum=S3;S3GOV;S3CHINA;GCS;AZURE
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="storageProvider is immutable"
+	StorageProvider string `json:"storageProvider"`
+
+	// whether stages can use the integration
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// storage locations stages may use, e.g. s3://bucket/path/, or * for
+	// any location
+	// +kubebuilder:validation:MinItems=1
+	StorageAllowedLocations []string `json:"storageAllowedLocations"`
+
+	// storage locations stages may not use
+	// +optional
+	StorageBlockedLocations []string `json:"storageBlockedLocations,omitempty"`
+
+	// ARN of the AWS role Snowflake assumes to access S3
+	// +optional
+	StorageAWSRoleARN *string `json:"storageAwsRoleArn,omitempty"`
+
+	// ACL granted to files unloaded to S3
+	// +kubebuilder:validation:Enum=bucket-owner-full-control
+	// +optional
+	StorageAWSObjectACL *string `json:"storageAwsObjectAcl,omitempty"`
+
+	// ID of the Azure Active Directory tenant of the storage accounts
+	// +optional
+	AzureTenantID *string `json:"azureTenantId,omitempty"`
+
+	// comment of the storage integration
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// StorageIntegrationObservation are the observable fields of a
+// StorageIntegration.
+type StorageIntegrationObservation struct {
+	// whether stages can use the integration
+	Enabled bool `json:"enabled,omitempty"`
+
+	// cloud storage service
+	StorageProvider string `json:"storageProvider,omitempty"`
+
+	// storage locations stages may use
+	StorageAllowedLocations []string `json:"storageAllowedLocations,omitempty"`
+
+	// storage locations stages may not use
+	StorageBlockedLocations []string `json:"storageBlockedLocations,omitempty"`
+
+	// ARN of the AWS role Snowflake assumes
+	StorageAWSRoleARN string `json:"storageAwsRoleArn,omitempty"`
+
+	// ACL granted to files unloaded to S3
+	StorageAWSObjectACL string `json:"storageAwsObjectAcl,omitempty"`
+
+	// ARN of the AWS IAM user Snowflake assumes the role with
+	StorageAWSIAMUserARN string `json:"storageAwsIamUserArn,omitempty"`
+
+	// external ID Snowflake passes when assuming the role
+	StorageAWSExternalID string `json:"storageAwsExternalId,omitempty"`
+
+	// Google service account Snowflake accesses GCS with
+	StorageGCPServiceAccount string `json:"storageGcpServiceAccount,omitempty"`
+
+	// ID of the Azure Active Directory tenant
+	AzureTenantID string `json:"azureTenantId,omitempty"`
+
+	// URL to grant Snowflake access to the Azure storage accounts
+	AzureConsentURL string `json:"azureConsentUrl,omitempty"`
+
+	// name of the Snowflake application in Azure
+	AzureMultiTenantAppName string `json:"azureMultiTenantAppName,omitempty"`
+
+	// comment of the storage integration
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the storage integration
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A StorageIntegrationSpec defines the desired state of a StorageIntegration.
+type StorageIntegrationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StorageIntegrationParameters `json:"forProvider"`
+}
+
+// A StorageIntegrationStatus represents the observed state of a
+// StorageIntegration.
+type StorageIntegrationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StorageIntegrationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StorageIntegration lets external stages access cloud storage through an
+// identity managed by Snowflake instead of credentials.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PROVIDER",type="string",JSONPath=".status.atProvider.storageProvider"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type StorageIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StorageIntegrationSpec   `json:"spec"`
+	Status StorageIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageIntegrationList contains a list of StorageIntegration
+type StorageIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageIntegration `json:"items"`
+}
+
+// StorageIntegration type metadata.
+var (
+	StorageIntegrationKind             = reflect.TypeOf(StorageIntegration{}).Name()
+	StorageIntegrationGroupKind        = schema.GroupKind{Group: Group, Kind: StorageIntegrationKind}.String()
+	StorageIntegrationKindAPIVersion   = StorageIntegrationKind + "." + SchemeGroupVersion.String()
+	StorageIntegrationGroupVersionKind = SchemeGroupVersion.WithKind(StorageIntegrationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StorageIntegration{}, &StorageIntegrationList{})
+}
+
+// StorageIntegrationName returns the name of a referenced StorageIntegration.
+func StorageIntegrationName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*StorageIntegration)
+		if !ok {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
@@ -0,0 +1,965 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegration) DeepCopyInto(out *ApiIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegration.
+func (in *ApiIntegration) DeepCopy() *ApiIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApiIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationList) DeepCopyInto(out *ApiIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApiIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationList.
+func (in *ApiIntegrationList) DeepCopy() *ApiIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApiIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationObservation) DeepCopyInto(out *ApiIntegrationObservation) {
+	*out = *in
+	if in.APIAllowedPrefixes != nil {
+		in, out := &in.APIAllowedPrefixes, &out.APIAllowedPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIBlockedPrefixes != nil {
+		in, out := &in.APIBlockedPrefixes, &out.APIBlockedPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationObservation.
+func (in *ApiIntegrationObservation) DeepCopy() *ApiIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationParameters) DeepCopyInto(out *ApiIntegrationParameters) {
+	*out = *in
+	if in.APIAWSRoleARN != nil {
+		in, out := &in.APIAWSRoleARN, &out.APIAWSRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureADApplicationID != nil {
+		in, out := &in.AzureADApplicationID, &out.AzureADApplicationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GoogleAudience != nil {
+		in, out := &in.GoogleAudience, &out.GoogleAudience
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.APIAllowedPrefixes != nil {
+		in, out := &in.APIAllowedPrefixes, &out.APIAllowedPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIBlockedPrefixes != nil {
+		in, out := &in.APIBlockedPrefixes, &out.APIBlockedPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationParameters.
+func (in *ApiIntegrationParameters) DeepCopy() *ApiIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationSpec) DeepCopyInto(out *ApiIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationSpec.
+func (in *ApiIntegrationSpec) DeepCopy() *ApiIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApiIntegrationStatus) DeepCopyInto(out *ApiIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApiIntegrationStatus.
+func (in *ApiIntegrationStatus) DeepCopy() *ApiIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApiIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIntegration) DeepCopyInto(out *CatalogIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIntegration.
+func (in *CatalogIntegration) DeepCopy() *CatalogIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CatalogIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIntegrationList) DeepCopyInto(out *CatalogIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CatalogIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIntegrationList.
+func (in *CatalogIntegrationList) DeepCopy() *CatalogIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CatalogIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIntegrationObservation) DeepCopyInto(out *CatalogIntegrationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIntegrationObservation.
+func (in *CatalogIntegrationObservation) DeepCopy() *CatalogIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIntegrationParameters) DeepCopyInto(out *CatalogIntegrationParameters) {
+	*out = *in
+	if in.CatalogNamespace != nil {
+		in, out := &in.CatalogNamespace, &out.CatalogNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Glue != nil {
+		in, out := &in.Glue, &out.Glue
+		*out = new(GlueCatalog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Polaris != nil {
+		in, out := &in.Polaris, &out.Polaris
+		*out = new(PolarisCatalog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshIntervalSeconds != nil {
+		in, out := &in.RefreshIntervalSeconds, &out.RefreshIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIntegrationParameters.
+func (in *CatalogIntegrationParameters) DeepCopy() *CatalogIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIntegrationSpec) DeepCopyInto(out *CatalogIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIntegrationSpec.
+func (in *CatalogIntegrationSpec) DeepCopy() *CatalogIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIntegrationStatus) DeepCopyInto(out *CatalogIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIntegrationStatus.
+func (in *CatalogIntegrationStatus) DeepCopy() *CatalogIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIntegration) DeepCopyInto(out *ExternalAccessIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIntegration.
+func (in *ExternalAccessIntegration) DeepCopy() *ExternalAccessIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalAccessIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIntegrationList) DeepCopyInto(out *ExternalAccessIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalAccessIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIntegrationList.
+func (in *ExternalAccessIntegrationList) DeepCopy() *ExternalAccessIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalAccessIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIntegrationObservation) DeepCopyInto(out *ExternalAccessIntegrationObservation) {
+	*out = *in
+	if in.AllowedNetworkRules != nil {
+		in, out := &in.AllowedNetworkRules, &out.AllowedNetworkRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAPIAuthenticationIntegrations != nil {
+		in, out := &in.AllowedAPIAuthenticationIntegrations, &out.AllowedAPIAuthenticationIntegrations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAuthenticationSecrets != nil {
+		in, out := &in.AllowedAuthenticationSecrets, &out.AllowedAuthenticationSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIntegrationObservation.
+func (in *ExternalAccessIntegrationObservation) DeepCopy() *ExternalAccessIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIntegrationParameters) DeepCopyInto(out *ExternalAccessIntegrationParameters) {
+	*out = *in
+	if in.AllowedNetworkRules != nil {
+		in, out := &in.AllowedNetworkRules, &out.AllowedNetworkRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNetworkRulesRefs != nil {
+		in, out := &in.AllowedNetworkRulesRefs, &out.AllowedNetworkRulesRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedNetworkRulesSelector != nil {
+		in, out := &in.AllowedNetworkRulesSelector, &out.AllowedNetworkRulesSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedAPIAuthenticationIntegrations != nil {
+		in, out := &in.AllowedAPIAuthenticationIntegrations, &out.AllowedAPIAuthenticationIntegrations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAuthenticationSecrets != nil {
+		in, out := &in.AllowedAuthenticationSecrets, &out.AllowedAuthenticationSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAuthenticationSecretsRefs != nil {
+		in, out := &in.AllowedAuthenticationSecretsRefs, &out.AllowedAuthenticationSecretsRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedAuthenticationSecretsSelector != nil {
+		in, out := &in.AllowedAuthenticationSecretsSelector, &out.AllowedAuthenticationSecretsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIntegrationParameters.
+func (in *ExternalAccessIntegrationParameters) DeepCopy() *ExternalAccessIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIntegrationSpec) DeepCopyInto(out *ExternalAccessIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIntegrationSpec.
+func (in *ExternalAccessIntegrationSpec) DeepCopy() *ExternalAccessIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAccessIntegrationStatus) DeepCopyInto(out *ExternalAccessIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessIntegrationStatus.
+func (in *ExternalAccessIntegrationStatus) DeepCopy() *ExternalAccessIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAccessIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlueCatalog) DeepCopyInto(out *GlueCatalog) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlueCatalog.
+func (in *GlueCatalog) DeepCopy() *GlueCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(GlueCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegration) DeepCopyInto(out *NotificationIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegration.
+func (in *NotificationIntegration) DeepCopy() *NotificationIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationList) DeepCopyInto(out *NotificationIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationList.
+func (in *NotificationIntegrationList) DeepCopy() *NotificationIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationObservation) DeepCopyInto(out *NotificationIntegrationObservation) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationObservation.
+func (in *NotificationIntegrationObservation) DeepCopy() *NotificationIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationParameters) DeepCopyInto(out *NotificationIntegrationParameters) {
+	*out = *in
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotificationProvider != nil {
+		in, out := &in.NotificationProvider, &out.NotificationProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.AWSSNSTopicARN != nil {
+		in, out := &in.AWSSNSTopicARN, &out.AWSSNSTopicARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AWSSNSRoleARN != nil {
+		in, out := &in.AWSSNSRoleARN, &out.AWSSNSRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubSubscriptionName != nil {
+		in, out := &in.GCPPubsubSubscriptionName, &out.GCPPubsubSubscriptionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCPPubsubTopicName != nil {
+		in, out := &in.GCPPubsubTopicName, &out.GCPPubsubTopicName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureStorageQueuePrimaryURI != nil {
+		in, out := &in.AzureStorageQueuePrimaryURI, &out.AzureStorageQueuePrimaryURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureEventGridTopicEndpoint != nil {
+		in, out := &in.AzureEventGridTopicEndpoint, &out.AzureEventGridTopicEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedRecipients != nil {
+		in, out := &in.AllowedRecipients, &out.AllowedRecipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WebhookURL != nil {
+		in, out := &in.WebhookURL, &out.WebhookURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebhookBodyTemplate != nil {
+		in, out := &in.WebhookBodyTemplate, &out.WebhookBodyTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebhookHeaders != nil {
+		in, out := &in.WebhookHeaders, &out.WebhookHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationParameters.
+func (in *NotificationIntegrationParameters) DeepCopy() *NotificationIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationSpec) DeepCopyInto(out *NotificationIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationSpec.
+func (in *NotificationIntegrationSpec) DeepCopy() *NotificationIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationIntegrationStatus) DeepCopyInto(out *NotificationIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationIntegrationStatus.
+func (in *NotificationIntegrationStatus) DeepCopy() *NotificationIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolarisCatalog) DeepCopyInto(out *PolarisCatalog) {
+	*out = *in
+	out.OAuthClientSecretSecretRef = in.OAuthClientSecretSecretRef
+	if in.OAuthAllowedScopes != nil {
+		in, out := &in.OAuthAllowedScopes, &out.OAuthAllowedScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolarisCatalog.
+func (in *PolarisCatalog) DeepCopy() *PolarisCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(PolarisCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegration) DeepCopyInto(out *StorageIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegration.
+func (in *StorageIntegration) DeepCopy() *StorageIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationList) DeepCopyInto(out *StorageIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StorageIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationList.
+func (in *StorageIntegrationList) DeepCopy() *StorageIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationObservation) DeepCopyInto(out *StorageIntegrationObservation) {
+	*out = *in
+	if in.StorageAllowedLocations != nil {
+		in, out := &in.StorageAllowedLocations, &out.StorageAllowedLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageBlockedLocations != nil {
+		in, out := &in.StorageBlockedLocations, &out.StorageBlockedLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationObservation.
+func (in *StorageIntegrationObservation) DeepCopy() *StorageIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationParameters) DeepCopyInto(out *StorageIntegrationParameters) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageAllowedLocations != nil {
+		in, out := &in.StorageAllowedLocations, &out.StorageAllowedLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageBlockedLocations != nil {
+		in, out := &in.StorageBlockedLocations, &out.StorageBlockedLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageAWSRoleARN != nil {
+		in, out := &in.StorageAWSRoleARN, &out.StorageAWSRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageAWSObjectACL != nil {
+		in, out := &in.StorageAWSObjectACL, &out.StorageAWSObjectACL
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureTenantID != nil {
+		in, out := &in.AzureTenantID, &out.AzureTenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationParameters.
+func (in *StorageIntegrationParameters) DeepCopy() *StorageIntegrationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationSpec) DeepCopyInto(out *StorageIntegrationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationSpec.
+func (in *StorageIntegrationSpec) DeepCopy() *StorageIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageIntegrationStatus) DeepCopyInto(out *StorageIntegrationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageIntegrationStatus.
+func (in *StorageIntegrationStatus) DeepCopy() *StorageIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	v1alpha11 "github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this ExternalAccessIntegration.
+func (mg *ExternalAccessIntegration) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.AllowedNetworkRules,
+		Extract:       v1alpha1.NetworkRuleName(),
+		References:    mg.Spec.ForProvider.AllowedNetworkRulesRefs,
+		Selector:      mg.Spec.ForProvider.AllowedNetworkRulesSelector,
+		To: reference.To{
+			List:    &v1alpha1.NetworkRuleList{},
+			Managed: &v1alpha1.NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.AllowedNetworkRules")
+	}
+	mg.Spec.ForProvider.AllowedNetworkRules = mrsp.ResolvedValues
+	mg.Spec.ForProvider.AllowedNetworkRulesRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.AllowedAuthenticationSecrets,
+		Extract:       v1alpha11.SecretName(),
+		References:    mg.Spec.ForProvider.AllowedAuthenticationSecretsRefs,
+		Selector:      mg.Spec.ForProvider.AllowedAuthenticationSecretsSelector,
+		To: reference.To{
+			List:    &v1alpha11.SecretList{},
+			Managed: &v1alpha11.Secret{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.AllowedAuthenticationSecrets")
+	}
+	mg.Spec.ForProvider.AllowedAuthenticationSecrets = mrsp.ResolvedValues
+	mg.Spec.ForProvider.AllowedAuthenticationSecretsRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// NetworkPolicyParameters are the configurable fields of a NetworkPolicy.
+// Lists that are not set are empty in Snowflake.
+type NetworkPolicyParameters struct {
+	// name of the network policy
+	Name string `json:"name"`
+
+	// IPv4 addresses or CIDR ranges allowed to connect
+	// +optional
+	AllowedIPList []string `json:"allowedIpList,omitempty"`
+
+	// IPv4 addresses or CIDR ranges blocked, taking precedence over
+	// allowedIpList
+	// +optional
+	BlockedIPList []string `json:"blockedIpList,omitempty"`
+
+	// fully qualified names of the network rules allowed to connect, e.g.
+	// rules of type AWSVPCEID allowing VPC endpoint IDs
+	// +crossplane:generate:reference:type=NetworkRule
+	// +crossplane:generate:reference:extractor=NetworkRuleName()
+	// +optional
+	AllowedNetworkRuleList []string `json:"allowedNetworkRuleList,omitempty"`
+
+	// AllowedNetworkRuleListRefs references NetworkRules to populate
+	// allowedNetworkRuleList.
+	// +optional
+	AllowedNetworkRuleListRefs []xpv1.Reference `json:"allowedNetworkRuleListRefs,omitempty"`
+
+	// AllowedNetworkRuleListSelector selects references to NetworkRules to
+	// populate allowedNetworkRuleList.
+	// +optional
+	AllowedNetworkRuleListSelector *xpv1.Selector `json:"allowedNetworkRuleListSelector,omitempty"`
+
+	// fully qualified names of the network rules blocked
+	// +crossplane:generate:reference:type=NetworkRule
+	// +crossplane:generate:reference:extractor=NetworkRuleName()
+	// +optional
+	BlockedNetworkRuleList []string `json:"blockedNetworkRuleList,omitempty"`
+
+	// BlockedNetworkRuleListRefs references NetworkRules to populate
+	// blockedNetworkRuleList.
+	// +optional
+	BlockedNetworkRuleListRefs []xpv1.Reference `json:"blockedNetworkRuleListRefs,omitempty"`
+
+	// BlockedNetworkRuleListSelector selects references to NetworkRules to
+	// populate blockedNetworkRuleList.
+	// +optional
+	BlockedNetworkRuleListSelector *xpv1.Selector `json:"blockedNetworkRuleListSelector,omitempty"`
+
+	// comment of the network policy
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// NetworkPolicyObservation are the observable fields of a NetworkPolicy.
+type NetworkPolicyObservation struct {
+	// allowed IPv4 addresses or ranges
+	AllowedIPList []string `json:"allowedIpList,omitempty"`
+
+	// blocked IPv4 addresses or ranges
+	BlockedIPList []string `json:"blockedIpList,omitempty"`
+
+	// fully qualified names of the allowed network rules
+	AllowedNetworkRuleList []string `json:"allowedNetworkRuleList,omitempty"`
+
+	// fully qualified names of the blocked network rules
+	BlockedNetworkRuleList []string `json:"blockedNetworkRuleList,omitempty"`
+
+	// comment of the network policy
+	Comment string `json:"comment,omitempty"`
+
+	// creation time of the network policy
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A NetworkPolicySpec defines the desired state of a NetworkPolicy.
+type NetworkPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkPolicyParameters `json:"forProvider"`
+}
+
+// A NetworkPolicyStatus represents the observed state of a NetworkPolicy.
+type NetworkPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkPolicy restricts the addresses users can connect from. It takes
+// effect once attached with a NetworkPolicyAttachment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type NetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkPolicySpec   `json:"spec"`
+	Status NetworkPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkPolicyList contains a list of NetworkPolicy
+type NetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPolicy `json:"items"`
+}
+
+// NetworkPolicy type metadata.
+var (
+	NetworkPolicyKind             = reflect.TypeOf(NetworkPolicy{}).Name()
+	NetworkPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkPolicyKind}.String()
+	NetworkPolicyKindAPIVersion   = NetworkPolicyKind + "." + SchemeGroupVersion.String()
+	NetworkPolicyGroupVersionKind = SchemeGroupVersion.WithKind(NetworkPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkPolicy{}, &NetworkPolicyList{})
+}
+
+// NetworkPolicyName returns the name of a referenced NetworkPolicy.
+func NetworkPolicyName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*NetworkPolicy)
+		if !ok {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
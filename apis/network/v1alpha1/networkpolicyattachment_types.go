@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AnnotationKeyAccountPolicySet records that a NetworkPolicyAttachment set the
+// network policy of the account. Only then does it unset the account policy
+// again.
+const AnnotationKeyAccountPolicySet = "snowflake.crossplane.io/account-policy-set"
+
+// NetworkPolicyAttachmentParameters are the configurable fields of a
+// NetworkPolicyAttachment.
+// +kubebuilder:validation:XValidation:rule="has(self.networkPolicy) || has(self.networkPolicyRef) || has(self.networkPolicySelector)",message="one of networkPolicy, networkPolicyRef or networkPolicySelector is required"
+// +kubebuilder:validation:XValidation:rule="(has(self.setForAccount) && self.setForAccount) || (has(self.users) && size(self.users) > 0)",message="the policy must be set for the account or at least one user"
+type NetworkPolicyAttachmentParameters struct {
+	// name of the network policy to attach
+	// +crossplane:generate:reference:type=NetworkPolicy
+	// +crossplane:generate:reference:extractor=NetworkPolicyName()
+	// +optional
+	NetworkPolicy string `json:"networkPolicy,omitempty"`
+
+	// NetworkPolicyRef references a NetworkPolicy to populate networkPolicy.
+	// +optional
+	NetworkPolicyRef *xpv1.Reference `json:"networkPolicyRef,omitempty"`
+
+	// NetworkPolicySelector selects a reference to a NetworkPolicy to
+	// populate networkPolicy.
+	// +optional
+	NetworkPolicySelector *xpv1.Selector `json:"networkPolicySelector,omitempty"`
+
+	// set the policy as the network policy of the account
+	// +optional
+	SetForAccount *bool `json:"setForAccount,omitempty"`
+
+	// names of the users the policy is set for, overriding the account policy
+	// +optional
+	Users []string `json:"users,omitempty"`
+}
+
+// NetworkPolicyAttachmentObservation are the observable fields of a
+// NetworkPolicyAttachment.
+type NetworkPolicyAttachmentObservation struct {
+	// whether the policy is the network policy of the account
+	Account bool `json:"account,omitempty"`
+
+	// users the policy is set for
+	Users []string `json:"users,omitempty"`
+
+	// whether this attachment set the policy for the account, as recorded
+	// by the account-policy-set annotation. Only then is the account policy
+	// unset once setForAccount is cleared or the attachment is deleted.
+	AccountSetByAttachment bool `json:"accountSetByAttachment,omitempty"`
+}
+
+// A NetworkPolicyAttachmentSpec defines the desired state of a
+// NetworkPolicyAttachment.
+type NetworkPolicyAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkPolicyAttachmentParameters `json:"forProvider"`
+}
+
+// A NetworkPolicyAttachmentStatus represents the observed state of a
+// NetworkPolicyAttachment.
+type NetworkPolicyAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkPolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkPolicyAttachment sets a NetworkPolicy for the account or for users.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="POLICY",type="string",JSONPath=".spec.forProvider.networkPolicy"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="boolean",JSONPath=".status.atProvider.account"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type NetworkPolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkPolicyAttachmentSpec   `json:"spec"`
+	Status NetworkPolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkPolicyAttachmentList contains a list of NetworkPolicyAttachment
+type NetworkPolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPolicyAttachment `json:"items"`
+}
+
+// NetworkPolicyAttachment type metadata.
+var (
+	NetworkPolicyAttachmentKind             = reflect.TypeOf(NetworkPolicyAttachment{}).Name()
+	NetworkPolicyAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkPolicyAttachmentKind}.String()
+	NetworkPolicyAttachmentKindAPIVersion   = NetworkPolicyAttachmentKind + "." + SchemeGroupVersion.String()
+	NetworkPolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(NetworkPolicyAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkPolicyAttachment{}, &NetworkPolicyAttachmentList{})
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// NetworkRuleParameters are the configurable fields of a NetworkRule.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type NetworkRuleParameters struct {
+	// name of the network rule
+	Name string `json:"name"`
+
+	// database the network rule is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the network rule is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// type of the identifiers in valueList: IP addresses or ranges, AWS VPC
+	// endpoint IDs, Azure private endpoint link IDs or host:port pairs
+	// +kubebuilder:validation:Enum=IPV4;AWSVPCEID;AZURELINKID;HOST_PORT;PRIVATE_HOST_PORT
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// INGRESS and INTERNAL_STAGE restrict incoming requests, EGRESS
+	// allows outgoing requests
+	// +kubebuilder:validation:Enum=INGRESS;INTERNAL_STAGE;EGRESS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="mode is immutable"
+	// +kubebuilder:default=INGRESS
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// identifiers matched by the rule
+	ValueList []string `json:"valueList"`
+
+	// comment of the network rule
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// NetworkRuleObservation are the observable fields of a NetworkRule.
+type NetworkRuleObservation struct {
+	// type of the network rule
+	Type string `json:"type,omitempty"`
+
+	// mode of the network rule
+	Mode string `json:"mode,omitempty"`
+
+	// identifiers matched by the rule
+	ValueList []string `json:"valueList,omitempty"`
+
+	// comment of the network rule
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the network rule
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the network rule
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A NetworkRuleSpec defines the desired state of a NetworkRule.
+type NetworkRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkRuleParameters `json:"forProvider"`
+}
+
+// A NetworkRuleStatus represents the observed state of a NetworkRule.
+type NetworkRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkRule groups network identifiers, to be allowed or blocked by a
+// NetworkPolicy or used by an external access integration.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".status.atProvider.mode"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type NetworkRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkRuleSpec   `json:"spec"`
+	Status NetworkRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkRuleList contains a list of NetworkRule
+type NetworkRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkRule `json:"items"`
+}
+
+// NetworkRule type metadata.
+var (
+	NetworkRuleKind             = reflect.TypeOf(NetworkRule{}).Name()
+	NetworkRuleGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkRuleKind}.String()
+	NetworkRuleKindAPIVersion   = NetworkRuleKind + "." + SchemeGroupVersion.String()
+	NetworkRuleGroupVersionKind = SchemeGroupVersion.WithKind(NetworkRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkRule{}, &NetworkRuleList{})
+}
+
+// NetworkRuleName returns the fully qualified name of a referenced
+// NetworkRule, for use when resolving references to it from other resources.
+func NetworkRuleName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*NetworkRule)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
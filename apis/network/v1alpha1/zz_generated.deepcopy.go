@@ -0,0 +1,514 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAttachment) DeepCopyInto(out *NetworkPolicyAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAttachment.
+func (in *NetworkPolicyAttachment) DeepCopy() *NetworkPolicyAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicyAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAttachmentList) DeepCopyInto(out *NetworkPolicyAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkPolicyAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAttachmentList.
+func (in *NetworkPolicyAttachmentList) DeepCopy() *NetworkPolicyAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicyAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAttachmentObservation) DeepCopyInto(out *NetworkPolicyAttachmentObservation) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAttachmentObservation.
+func (in *NetworkPolicyAttachmentObservation) DeepCopy() *NetworkPolicyAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAttachmentParameters) DeepCopyInto(out *NetworkPolicyAttachmentParameters) {
+	*out = *in
+	if in.NetworkPolicyRef != nil {
+		in, out := &in.NetworkPolicyRef, &out.NetworkPolicyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicySelector != nil {
+		in, out := &in.NetworkPolicySelector, &out.NetworkPolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SetForAccount != nil {
+		in, out := &in.SetForAccount, &out.SetForAccount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAttachmentParameters.
+func (in *NetworkPolicyAttachmentParameters) DeepCopy() *NetworkPolicyAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAttachmentSpec) DeepCopyInto(out *NetworkPolicyAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAttachmentSpec.
+func (in *NetworkPolicyAttachmentSpec) DeepCopy() *NetworkPolicyAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyAttachmentStatus) DeepCopyInto(out *NetworkPolicyAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyAttachmentStatus.
+func (in *NetworkPolicyAttachmentStatus) DeepCopy() *NetworkPolicyAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyList) DeepCopyInto(out *NetworkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyList.
+func (in *NetworkPolicyList) DeepCopy() *NetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyObservation) DeepCopyInto(out *NetworkPolicyObservation) {
+	*out = *in
+	if in.AllowedIPList != nil {
+		in, out := &in.AllowedIPList, &out.AllowedIPList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedIPList != nil {
+		in, out := &in.BlockedIPList, &out.BlockedIPList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNetworkRuleList != nil {
+		in, out := &in.AllowedNetworkRuleList, &out.AllowedNetworkRuleList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedNetworkRuleList != nil {
+		in, out := &in.BlockedNetworkRuleList, &out.BlockedNetworkRuleList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyObservation.
+func (in *NetworkPolicyObservation) DeepCopy() *NetworkPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyParameters) DeepCopyInto(out *NetworkPolicyParameters) {
+	*out = *in
+	if in.AllowedIPList != nil {
+		in, out := &in.AllowedIPList, &out.AllowedIPList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedIPList != nil {
+		in, out := &in.BlockedIPList, &out.BlockedIPList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNetworkRuleList != nil {
+		in, out := &in.AllowedNetworkRuleList, &out.AllowedNetworkRuleList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNetworkRuleListRefs != nil {
+		in, out := &in.AllowedNetworkRuleListRefs, &out.AllowedNetworkRuleListRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedNetworkRuleListSelector != nil {
+		in, out := &in.AllowedNetworkRuleListSelector, &out.AllowedNetworkRuleListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockedNetworkRuleList != nil {
+		in, out := &in.BlockedNetworkRuleList, &out.BlockedNetworkRuleList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedNetworkRuleListRefs != nil {
+		in, out := &in.BlockedNetworkRuleListRefs, &out.BlockedNetworkRuleListRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlockedNetworkRuleListSelector != nil {
+		in, out := &in.BlockedNetworkRuleListSelector, &out.BlockedNetworkRuleListSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyParameters.
+func (in *NetworkPolicyParameters) DeepCopy() *NetworkPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySpec.
+func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyStatus) DeepCopyInto(out *NetworkPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyStatus.
+func (in *NetworkPolicyStatus) DeepCopy() *NetworkPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRule) DeepCopyInto(out *NetworkRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRule.
+func (in *NetworkRule) DeepCopy() *NetworkRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRuleList) DeepCopyInto(out *NetworkRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRuleList.
+func (in *NetworkRuleList) DeepCopy() *NetworkRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRuleObservation) DeepCopyInto(out *NetworkRuleObservation) {
+	*out = *in
+	if in.ValueList != nil {
+		in, out := &in.ValueList, &out.ValueList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRuleObservation.
+func (in *NetworkRuleObservation) DeepCopy() *NetworkRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRuleParameters) DeepCopyInto(out *NetworkRuleParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValueList != nil {
+		in, out := &in.ValueList, &out.ValueList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRuleParameters.
+func (in *NetworkRuleParameters) DeepCopy() *NetworkRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRuleSpec) DeepCopyInto(out *NetworkRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRuleSpec.
+func (in *NetworkRuleSpec) DeepCopy() *NetworkRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkRuleStatus) DeepCopyInto(out *NetworkRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkRuleStatus.
+func (in *NetworkRuleStatus) DeepCopy() *NetworkRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this NetworkPolicy.
+func (mg *NetworkPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.AllowedNetworkRuleList,
+		Extract:       NetworkRuleName(),
+		References:    mg.Spec.ForProvider.AllowedNetworkRuleListRefs,
+		Selector:      mg.Spec.ForProvider.AllowedNetworkRuleListSelector,
+		To: reference.To{
+			List:    &NetworkRuleList{},
+			Managed: &NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.AllowedNetworkRuleList")
+	}
+	mg.Spec.ForProvider.AllowedNetworkRuleList = mrsp.ResolvedValues
+	mg.Spec.ForProvider.AllowedNetworkRuleListRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.BlockedNetworkRuleList,
+		Extract:       NetworkRuleName(),
+		References:    mg.Spec.ForProvider.BlockedNetworkRuleListRefs,
+		Selector:      mg.Spec.ForProvider.BlockedNetworkRuleListSelector,
+		To: reference.To{
+			List:    &NetworkRuleList{},
+			Managed: &NetworkRule{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.BlockedNetworkRuleList")
+	}
+	mg.Spec.ForProvider.BlockedNetworkRuleList = mrsp.ResolvedValues
+	mg.Spec.ForProvider.BlockedNetworkRuleListRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this NetworkPolicyAttachment.
+func (mg *NetworkPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.NetworkPolicy,
+		Extract:      NetworkPolicyName(),
+		Reference:    mg.Spec.ForProvider.NetworkPolicyRef,
+		Selector:     mg.Spec.ForProvider.NetworkPolicySelector,
+		To: reference.To{
+			List:    &NetworkPolicyList{},
+			Managed: &NetworkPolicy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.NetworkPolicy")
+	}
+	mg.Spec.ForProvider.NetworkPolicy = rsp.ResolvedValue
+	mg.Spec.ForProvider.NetworkPolicyRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this NetworkRule.
+func (mg *NetworkRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Database,
+		Extract:      v1alpha1.DatabaseName(),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = rsp.ResolvedValue
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AccountParameterParameters are the configurable fields of an
+// AccountParameter.
+type AccountParameterParameters struct {
+	// name of the parameter, e.g. STATEMENT_TIMEOUT_IN_SECONDS
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="key is immutable"
+	Key string `json:"key"`
+
+	// value of the parameter, e.g. 3600 or America/New_York
+	Value string `json:"value"`
+}
+
+// AccountParameterObservation are the observable fields of a AccountParameter.
+type AccountParameterObservation struct {
+	// value of the parameter
+	Value string `json:"value,omitempty"`
+
+	// level the value is set at, e.g. ACCOUNT or WAREHOUSE
+	Level string `json:"level,omitempty"`
+
+	// default value of the parameter
+	Default string `json:"default,omitempty"`
+
+	// description of the parameter
+	Description string `json:"description,omitempty"`
+}
+
+// A AccountParameterSpec defines the desired state of a AccountParameter.
+type AccountParameterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountParameterParameters `json:"forProvider"`
+}
+
+// A AccountParameterStatus represents the observed state of a AccountParameter.
+type AccountParameterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountParameterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountParameter sets a parameter at the account level, the default of
+// every object of the account. Setting it requires the ACCOUNTADMIN role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type AccountParameter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountParameterSpec   `json:"spec"`
+	Status AccountParameterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountParameterList contains a list of AccountParameter
+type AccountParameterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountParameter `json:"items"`
+}
+
+// AccountParameter type metadata.
+var (
+	AccountParameterKind             = reflect.TypeOf(AccountParameter{}).Name()
+	AccountParameterGroupKind        = schema.GroupKind{Group: Group, Kind: AccountParameterKind}.String()
+	AccountParameterKindAPIVersion   = AccountParameterKind + "." + SchemeGroupVersion.String()
+	AccountParameterGroupVersionKind = SchemeGroupVersion.WithKind(AccountParameterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountParameter{}, &AccountParameterList{})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ObjectParameterParameters are the configurable fields of an
+// ObjectParameter.
+type ObjectParameterParameters struct {
+	// kind of the object the parameter is set on
+	// +kubebuilder:validation:Enum=DATABASE;SCHEMA;WAREHOUSE;USER
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectType is immutable"
+	ObjectType string `json:"objectType"`
+
+	// name of the object, qualified with its database for schemas, e.g.
+	// SALES.PUBLIC
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectName is immutable"
+	ObjectName string `json:"objectName"`
+
+	// name of the parameter, e.g. DATA_RETENTION_TIME_IN_DAYS
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="key is immutable"
+	Key string `json:"key"`
+
+	// value of the parameter, e.g. 30
+	Value string `json:"value"`
+}
+
+// ObjectParameterObservation are the observable fields of a ObjectParameter.
+type ObjectParameterObservation struct {
+	// value of the parameter
+	Value string `json:"value,omitempty"`
+
+	// level the value is set at, e.g. ACCOUNT or WAREHOUSE
+	Level string `json:"level,omitempty"`
+
+	// default value of the parameter
+	Default string `json:"default,omitempty"`
+
+	// description of the parameter
+	Description string `json:"description,omitempty"`
+}
+
+// A ObjectParameterSpec defines the desired state of a ObjectParameter.
+type ObjectParameterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameterParameters `json:"forProvider"`
+}
+
+// A ObjectParameterStatus represents the observed state of a ObjectParameter.
+type ObjectParameterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectParameterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ObjectParameter sets a parameter on a database, schema, warehouse or
+// user, overriding the value it inherits from the account or its parent.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="OBJECT",type="string",JSONPath=".spec.forProvider.objectName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ObjectParameter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectParameterSpec   `json:"spec"`
+	Status ObjectParameterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectParameterList contains a list of ObjectParameter
+type ObjectParameterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectParameter `json:"items"`
+}
+
+// ObjectParameter type metadata.
+var (
+	ObjectParameterKind             = reflect.TypeOf(ObjectParameter{}).Name()
+	ObjectParameterGroupKind        = schema.GroupKind{Group: Group, Kind: ObjectParameterKind}.String()
+	ObjectParameterKindAPIVersion   = ObjectParameterKind + "." + SchemeGroupVersion.String()
+	ObjectParameterGroupVersionKind = SchemeGroupVersion.WithKind(ObjectParameterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ObjectParameter{}, &ObjectParameterList{})
+}
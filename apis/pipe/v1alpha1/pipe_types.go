@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AnnotationKeyRefresh triggers ALTER PIPE ... REFRESH whenever its value
+// changes, e.g. to the current timestamp. The pipe then queues files staged
+// within the last 7 days that were not loaded yet.
+const AnnotationKeyRefresh = "snowflake.crossplane.io/refresh"
+
+// ConnectionDetailNotificationChannel is the connection secret key holding the
+// ARN of the SQS queue that receives the event notifications of an auto-ingest
+// pipe.
+const ConnectionDetailNotificationChannel = "notification_channel"
+
+// PipeParameters are the configurable fields of a Pipe.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type PipeParameters struct {
+	// name of the pipe
+	Name string `json:"name"`
+
+	// database the pipe is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the pipe is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// COPY INTO statement loading the staged files. Changing it replaces the
+	// pipe, which resets its load history.
+	CopyStatement string `json:"copyStatement"`
+
+	// load files automatically from cloud storage event notifications
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="autoIngest is immutable"
+	// +optional
+	AutoIngest *bool `json:"autoIngest,omitempty"`
+
+	// ARN of the SNS topic for S3 event notifications fanned out through SNS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="awsSnsTopic is immutable"
+	// +optional
+	AWSSNSTopic *string `json:"awsSnsTopic,omitempty"`
+
+	// notification integration for auto-ingest on Google Cloud Storage or Azure
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="integration is immutable"
+	// +optional
+	Integration *string `json:"integration,omitempty"`
+
+	// notification integration receiving load error notifications
+	// +optional
+	ErrorIntegration *string `json:"errorIntegration,omitempty"`
+
+	// comment of the pipe
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// PipeObservation are the observable fields of a Pipe.
+type PipeObservation struct {
+	// ARN of the SQS queue receiving the event notifications of an
+	// auto-ingest pipe
+	NotificationChannel string `json:"notificationChannel,omitempty"`
+
+	// COPY INTO statement of the pipe
+	Definition string `json:"definition,omitempty"`
+
+	// notification integration receiving load error notifications
+	ErrorIntegration string `json:"errorIntegration,omitempty"`
+
+	// comment of the pipe
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the pipe
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the pipe
+	CreatedOn string `json:"createdOn,omitempty"`
+
+	// value of the refresh annotation the pipe was last refreshed for
+	LastRefresh string `json:"lastRefresh,omitempty"`
+}
+
+// A PipeSpec defines the desired state of a Pipe.
+type PipeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PipeParameters `json:"forProvider"`
+}
+
+// A PipeStatus represents the observed state of a Pipe.
+type PipeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PipeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Pipe is a Snowpipe continuously loading staged files.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NOTIFICATION-CHANNEL",type="string",JSONPath=".status.atProvider.notificationChannel",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Pipe struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PipeSpec   `json:"spec"`
+	Status PipeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PipeList contains a list of Pipe
+type PipeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Pipe `json:"items"`
+}
+
+// Pipe type metadata.
+var (
+	PipeKind             = reflect.TypeOf(Pipe{}).Name()
+	PipeGroupKind        = schema.GroupKind{Group: Group, Kind: PipeKind}.String()
+	PipeKindAPIVersion   = PipeKind + "." + SchemeGroupVersion.String()
+	PipeGroupVersionKind = SchemeGroupVersion.WithKind(PipeKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Pipe{}, &PipeList{})
+}
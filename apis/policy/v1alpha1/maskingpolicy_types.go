@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A PolicyArgument is an argument in the signature of a policy. The first
+// argument of a masking policy is the masked column.
+type PolicyArgument struct {
+	// name of the argument
+	Name string `json:"name"`
+
+	// SQL data type of the argument, e.g. VARCHAR
+	Type string `json:"type"`
+}
+
+// MaskingPolicyParameters are the configurable fields of a MaskingPolicy.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.exemptOtherPolicies) == has(oldSelf.exemptOtherPolicies)",message="exemptOtherPolicies cannot be added or removed"
+type MaskingPolicyParameters struct {
+	// name of the masking policy
+	Name string `json:"name"`
+
+	// database the masking policy is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the masking policy is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// arguments of the policy, starting with the masked column
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="signature is immutable"
+	Signature []PolicyArgument `json:"signature"`
+
+	// SQL data type returned by the policy, matching the type of the masked
+	// column
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="returnType is immutable"
+	ReturnType string `json:"returnType"`
+
+	// SQL expression computing the masked value. It is compared with the
+	// body Snowflake reports with whitespace collapsed.
+	Body string `json:"body"`
+
+	// let the policy see unmasked values of columns protected by other
+	// policies it references
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="exemptOtherPolicies is immutable"
+	// +optional
+	ExemptOtherPolicies *bool `json:"exemptOtherPolicies,omitempty"`
+
+	// comment of the masking policy
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// MaskingPolicyObservation are the observable fields of a MaskingPolicy.
+type MaskingPolicyObservation struct {
+	// signature of the policy, e.g. (VAL VARCHAR)
+	Signature string `json:"signature,omitempty"`
+
+	// SQL data type returned by the policy
+	ReturnType string `json:"returnType,omitempty"`
+
+	// SQL expression computing the masked value
+	Body string `json:"body,omitempty"`
+
+	// whether the policy sees unmasked values of other protected columns
+	ExemptOtherPolicies bool `json:"exemptOtherPolicies,omitempty"`
+
+	// comment of the masking policy
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the masking policy
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the masking policy
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A MaskingPolicySpec defines the desired state of a MaskingPolicy.
+type MaskingPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MaskingPolicyParameters `json:"forProvider"`
+}
+
+// A MaskingPolicyStatus represents the observed state of a MaskingPolicy.
+type MaskingPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MaskingPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MaskingPolicy masks the values of the table and view columns it is set on.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type MaskingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaskingPolicySpec   `json:"spec"`
+	Status MaskingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaskingPolicyList contains a list of MaskingPolicy
+type MaskingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaskingPolicy `json:"items"`
+}
+
+// MaskingPolicy type metadata.
+var (
+	MaskingPolicyKind             = reflect.TypeOf(MaskingPolicy{}).Name()
+	MaskingPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: MaskingPolicyKind}.String()
+	MaskingPolicyKindAPIVersion   = MaskingPolicyKind + "." + SchemeGroupVersion.String()
+	MaskingPolicyGroupVersionKind = SchemeGroupVersion.WithKind(MaskingPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MaskingPolicy{}, &MaskingPolicyList{})
+}
+
+// MaskingPolicyName returns the fully qualified name of a referenced
+// MaskingPolicy, for use when resolving references to it from other
+// resources.
+func MaskingPolicyName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*MaskingPolicy)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
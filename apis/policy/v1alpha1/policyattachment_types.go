@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicyAttachmentParameters are the configurable fields of a
+// PolicyAttachment. A masking policy is set on a column, a row access policy
+// is added to the whole table or view.
+// +kubebuilder:validation:XValidation:rule="[has(self.maskingPolicy) || has(self.maskingPolicyRef) || has(self.maskingPolicySelector), has(self.rowAccessPolicy) || has(self.rowAccessPolicyRef) || has(self.rowAccessPolicySelector)].filter(x, x).size() == 1",message="exactly one of a masking policy or a row access policy is required"
+// +kubebuilder:validation:XValidation:rule="!(has(self.maskingPolicy) || has(self.maskingPolicyRef) || has(self.maskingPolicySelector)) || has(self.column)",message="column is required for a masking policy"
+// +kubebuilder:validation:XValidation:rule="!(has(self.rowAccessPolicy) || has(self.rowAccessPolicyRef) || has(self.rowAccessPolicySelector)) || (!has(self.column) && has(self.columns) && size(self.columns) > 0)",message="columns and no column are required for a row access policy"
+// +kubebuilder:validation:XValidation:rule="has(self.column) == has(oldSelf.column)",message="the policy kind is immutable"
+type PolicyAttachmentParameters struct {
+	// fully qualified name of the masking policy to set
+	// +crossplane:generate:reference:type=MaskingPolicy
+	// +crossplane:generate:reference:extractor=MaskingPolicyName()
+	// +optional
+	MaskingPolicy string `json:"maskingPolicy,omitempty"`
+
+	// MaskingPolicyRef references a MaskingPolicy to populate maskingPolicy.
+	// +optional
+	MaskingPolicyRef *xpv1.Reference `json:"maskingPolicyRef,omitempty"`
+
+	// MaskingPolicySelector selects a reference to a MaskingPolicy to
+	// populate maskingPolicy.
+	// +optional
+	MaskingPolicySelector *xpv1.Selector `json:"maskingPolicySelector,omitempty"`
+
+	// fully qualified name of the row access policy to add
+	// +crossplane:generate:reference:type=RowAccessPolicy
+	// +crossplane:generate:reference:extractor=RowAccessPolicyName()
+	// +optional
+	RowAccessPolicy string `json:"rowAccessPolicy,omitempty"`
+
+	// RowAccessPolicyRef references a RowAccessPolicy to populate
+	// rowAccessPolicy.
+	// +optional
+	RowAccessPolicyRef *xpv1.Reference `json:"rowAccessPolicyRef,omitempty"`
+
+	// RowAccessPolicySelector selects a reference to a RowAccessPolicy to
+	// populate rowAccessPolicy.
+	// +optional
+	RowAccessPolicySelector *xpv1.Selector `json:"rowAccessPolicySelector,omitempty"`
+
+	// kind of the object the policy is attached to
+	// +kubebuilder:validation:Enum=TABLE;VIEW
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectType is immutable"
+	// +kubebuilder:default=TABLE
+	// +optional
+	ObjectType string `json:"objectType,omitempty"`
+
+	// fully qualified name of the table or view
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="object is immutable"
+	Object string `json:"object"`
+
+	// column a masking policy is set on
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="column is immutable"
+	// +optional
+	Column *string `json:"column,omitempty"`
+
+	// columns bound to the policy arguments: the columns a row access policy
+	// is added on, or the conditional columns passed to a masking policy
+	// after the masked column
+	// +optional
+	Columns []string `json:"columns,omitempty"`
+
+	// replace a masking policy already set on the column by someone else
+	// +optional
+	Force *bool `json:"force,omitempty"`
+}
+
+// PolicyAttachmentObservation are the observable fields of a
+// PolicyAttachment.
+type PolicyAttachmentObservation struct {
+	// fully qualified name of the policy attached to the object or column
+	Policy string `json:"policy,omitempty"`
+
+	// columns bound to the policy arguments
+	Columns []string `json:"columns,omitempty"`
+
+	// status of the policy reference, e.g. ACTIVE
+	Status string `json:"status,omitempty"`
+}
+
+// A PolicyAttachmentSpec defines the desired state of a PolicyAttachment.
+type PolicyAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyAttachmentParameters `json:"forProvider"`
+}
+
+// A PolicyAttachmentStatus represents the observed state of a PolicyAttachment.
+type PolicyAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicyAttachment sets a MaskingPolicy on a column or adds a
+// RowAccessPolicy to a table or view.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="OBJECT",type="string",JSONPath=".spec.forProvider.object"
+// +kubebuilder:printcolumn:name="POLICY",type="string",JSONPath=".status.atProvider.policy"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type PolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyAttachmentSpec   `json:"spec"`
+	Status PolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyAttachmentList contains a list of PolicyAttachment
+type PolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyAttachment `json:"items"`
+}
+
+// PolicyAttachment type metadata.
+var (
+	PolicyAttachmentKind             = reflect.TypeOf(PolicyAttachment{}).Name()
+	PolicyAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyAttachmentKind}.String()
+	PolicyAttachmentKindAPIVersion   = PolicyAttachmentKind + "." + SchemeGroupVersion.String()
+	PolicyAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(PolicyAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PolicyAttachment{}, &PolicyAttachmentList{})
+}
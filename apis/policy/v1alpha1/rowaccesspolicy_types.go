@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// RowAccessPolicyParameters are the configurable fields of a RowAccessPolicy.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type RowAccessPolicyParameters struct {
+	// name of the row access policy
+	Name string `json:"name"`
+
+	// database the row access policy is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the row access policy is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// arguments of the policy, bound to the columns of the table or view it
+	// is added to
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="signature is immutable"
+	Signature []PolicyArgument `json:"signature"`
+
+	// SQL expression returning whether a row is visible. It is compared with
+	// the body Snowflake reports with whitespace collapsed.
+	Body string `json:"body"`
+
+	// comment of the row access policy
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// RowAccessPolicyObservation are the observable fields of a RowAccessPolicy.
+type RowAccessPolicyObservation struct {
+	// signature of the policy, e.g. (REGION VARCHAR)
+	Signature string `json:"signature,omitempty"`
+
+	// SQL expression returning whether a row is visible
+	Body string `json:"body,omitempty"`
+
+	// comment of the row access policy
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the row access policy
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the row access policy
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A RowAccessPolicySpec defines the desired state of a RowAccessPolicy.
+type RowAccessPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RowAccessPolicyParameters `json:"forProvider"`
+}
+
+// A RowAccessPolicyStatus represents the observed state of a RowAccessPolicy.
+type RowAccessPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RowAccessPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RowAccessPolicy filters the rows of the tables and views it is added to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type RowAccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RowAccessPolicySpec   `json:"spec"`
+	Status RowAccessPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RowAccessPolicyList contains a list of RowAccessPolicy
+type RowAccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RowAccessPolicy `json:"items"`
+}
+
+// RowAccessPolicy type metadata.
+var (
+	RowAccessPolicyKind             = reflect.TypeOf(RowAccessPolicy{}).Name()
+	RowAccessPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: RowAccessPolicyKind}.String()
+	RowAccessPolicyKindAPIVersion   = RowAccessPolicyKind + "." + SchemeGroupVersion.String()
+	RowAccessPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RowAccessPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RowAccessPolicy{}, &RowAccessPolicyList{})
+}
+
+// RowAccessPolicyName returns the fully qualified name of a referenced
+// RowAccessPolicy, for use when resolving references to it from other
+// resources.
+func RowAccessPolicyName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*RowAccessPolicy)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FailoverGroupParameters are the configurable fields of a FailoverGroup.
+type FailoverGroupParameters struct {
+	// name of the failover group
+	Name string `json:"name"`
+
+	// types of the account objects replicated by the group
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=ACCOUNT_PARAMETERS;DATABASES;INTEGRATIONS;NETWORK_POLICIES;RESOURCE_MONITORS;ROLES;SHARES;USERS;WAREHOUSES
+	ObjectTypes []string `json:"objectTypes"`
+
+	// databases replicated by the group, when objectTypes holds DATABASES
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+
+	// DatabasesRefs references Databases to populate databases.
+	// +optional
+	DatabasesRefs []xpv1.Reference `json:"databasesRefs,omitempty"`
+
+	// DatabasesSelector selects references to Databases to populate
+	// databases.
+	// +optional
+	DatabasesSelector *xpv1.Selector `json:"databasesSelector,omitempty"`
+
+	// types of the integrations replicated by the group, when objectTypes
+	// holds INTEGRATIONS
+	// +kubebuilder:validation:items:Enum=API_INTEGRATIONS;EXTERNAL_ACCESS_INTEGRATIONS;NOTIFICATION_INTEGRATIONS;SECURITY_INTEGRATIONS;STORAGE_INTEGRATIONS
+	// +optional
+	AllowedIntegrationTypes []string `json:"allowedIntegrationTypes,omitempty"`
+
+	// accounts the group can be replicated to, as organization.account
+	// +kubebuilder:validation:MinItems=1
+	AllowedAccounts []string `json:"allowedAccounts"`
+
+	// schedule refreshing the secondary groups, e.g. 10 MINUTE or
+	// USING CRON 0 * * * * UTC
+	// +optional
+	ReplicationSchedule *string `json:"replicationSchedule,omitempty"`
+}
+
+// FailoverGroupObservation are the observable fields of a FailoverGroup.
+type FailoverGroupObservation struct {
+	// types of the replicated account objects
+	ObjectTypes []string `json:"objectTypes,omitempty"`
+
+	// replicated databases
+	Databases []string `json:"databases,omitempty"`
+
+	// types of the replicated integrations
+	AllowedIntegrationTypes []string `json:"allowedIntegrationTypes,omitempty"`
+
+	// accounts the group can be replicated to, other than this account
+	AllowedAccounts []string `json:"allowedAccounts,omitempty"`
+
+	// schedule refreshing the secondary groups
+	ReplicationSchedule string `json:"replicationSchedule,omitempty"`
+
+	// next scheduled refresh of the secondary groups
+	NextScheduledRefresh string `json:"nextScheduledRefresh,omitempty"`
+
+	// role owning the group
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the group
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A FailoverGroupSpec defines the desired state of a FailoverGroup.
+type FailoverGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FailoverGroupParameters `json:"forProvider"`
+}
+
+// A FailoverGroupStatus represents the observed state of a FailoverGroup.
+type FailoverGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FailoverGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FailoverGroup is a primary failover group, replicating databases and
+// account objects to other accounts of the organization, which can be
+// promoted to primary on failover.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type FailoverGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FailoverGroupSpec   `json:"spec"`
+	Status FailoverGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FailoverGroupList contains a list of FailoverGroup
+type FailoverGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FailoverGroup `json:"items"`
+}
+
+// FailoverGroup type metadata.
+var (
+	FailoverGroupKind             = reflect.TypeOf(FailoverGroup{}).Name()
+	FailoverGroupGroupKind        = schema.GroupKind{Group: Group, Kind: FailoverGroupKind}.String()
+	FailoverGroupKindAPIVersion   = FailoverGroupKind + "." + SchemeGroupVersion.String()
+	FailoverGroupGroupVersionKind = SchemeGroupVersion.WithKind(FailoverGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FailoverGroup{}, &FailoverGroupList{})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ReplicationGroupParameters are the configurable fields of a ReplicationGroup.
+type ReplicationGroupParameters struct {
+	// name of the replication group
+	Name string `json:"name"`
+
+	// types of the account objects replicated by the group
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=ACCOUNT_PARAMETERS;DATABASES;INTEGRATIONS;NETWORK_POLICIES;RESOURCE_MONITORS;ROLES;SHARES;USERS;WAREHOUSES
+	ObjectTypes []string `json:"objectTypes"`
+
+	// databases replicated by the group, when objectTypes holds DATABASES
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+
+	// DatabasesRefs references Databases to populate databases.
+	// +optional
+	DatabasesRefs []xpv1.Reference `json:"databasesRefs,omitempty"`
+
+	// DatabasesSelector selects references to Databases to populate
+	// databases.
+	// +optional
+	DatabasesSelector *xpv1.Selector `json:"databasesSelector,omitempty"`
+
+	// types of the integrations replicated by the group, when objectTypes
+	// holds INTEGRATIONS
+	// +kubebuilder:validation:items:Enum=API_INTEGRATIONS;EXTERNAL_ACCESS_INTEGRATIONS;NOTIFICATION_INTEGRATIONS;SECURITY_INTEGRATIONS;STORAGE_INTEGRATIONS
+	// +optional
+	AllowedIntegrationTypes []string `json:"allowedIntegrationTypes,omitempty"`
+
+	// accounts the group can be replicated to, as organization.account
+	// +kubebuilder:validation:MinItems=1
+	AllowedAccounts []string `json:"allowedAccounts"`
+
+	// schedule refreshing the secondary groups, e.g. 10 MINUTE or
+	// USING CRON 0 * * * * UTC
+	// +optional
+	ReplicationSchedule *string `json:"replicationSchedule,omitempty"`
+}
+
+// ReplicationGroupObservation are the observable fields of a ReplicationGroup.
+type ReplicationGroupObservation struct {
+	// types of the replicated account objects
+	ObjectTypes []string `json:"objectTypes,omitempty"`
+
+	// replicated databases
+	Databases []string `json:"databases,omitempty"`
+
+	// types of the replicated integrations
+	AllowedIntegrationTypes []string `json:"allowedIntegrationTypes,omitempty"`
+
+	// accounts the group can be replicated to, other than this account
+	AllowedAccounts []string `json:"allowedAccounts,omitempty"`
+
+	// schedule refreshing the secondary groups
+	ReplicationSchedule string `json:"replicationSchedule,omitempty"`
+
+	// next scheduled refresh of the secondary groups
+	NextScheduledRefresh string `json:"nextScheduledRefresh,omitempty"`
+
+	// role owning the group
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the group
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A ReplicationGroupSpec defines the desired state of a ReplicationGroup.
+type ReplicationGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReplicationGroupParameters `json:"forProvider"`
+}
+
+// A ReplicationGroupStatus represents the observed state of a ReplicationGroup.
+type ReplicationGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReplicationGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReplicationGroup is a primary replication group, replicating databases and
+// account objects to other accounts of the organization as read-only copies.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ReplicationGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationGroupSpec   `json:"spec"`
+	Status ReplicationGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationGroupList contains a list of ReplicationGroup
+type ReplicationGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationGroup `json:"items"`
+}
+
+// ReplicationGroup type metadata.
+var (
+	ReplicationGroupKind             = reflect.TypeOf(ReplicationGroup{}).Name()
+	ReplicationGroupGroupKind        = schema.GroupKind{Group: Group, Kind: ReplicationGroupKind}.String()
+	ReplicationGroupKindAPIVersion   = ReplicationGroupKind + "." + SchemeGroupVersion.String()
+	ReplicationGroupGroupVersionKind = SchemeGroupVersion.WithKind(ReplicationGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ReplicationGroup{}, &ReplicationGroupList{})
+}
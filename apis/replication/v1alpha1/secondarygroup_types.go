@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SecondaryGroupParameters are the configurable fields of a SecondaryGroup.
+type SecondaryGroupParameters struct {
+	// name of the group, the same as that of the primary group
+	Name string `json:"name"`
+
+	// kind of the primary group
+	// +kubebuilder:validation:Enum=REPLICATION;FAILOVER
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// +kubebuilder:default=REPLICATION
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// primary group replicated, as organization.account.group
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="primary is immutable"
+	Primary string `json:"primary"`
+
+	// whether scheduled refreshes of the group are suspended
+	// +optional
+	Suspended *bool `json:"suspended,omitempty"`
+}
+
+// SecondaryGroupObservation are the observable fields of a SecondaryGroup.
+type SecondaryGroupObservation struct {
+	// primary group replicated
+	Primary string `json:"primary,omitempty"`
+
+	// state of the scheduled refreshes, STARTED or SUSPENDED
+	SecondaryState string `json:"secondaryState,omitempty"`
+
+	// end time of the last completed refresh
+	LastRefreshedOn string `json:"lastRefreshedOn,omitempty"`
+
+	// seconds elapsed since the primary snapshot of the last completed
+	// refresh
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+
+	// next scheduled refresh of the group
+	NextScheduledRefresh string `json:"nextScheduledRefresh,omitempty"`
+
+	// role owning the group
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the group
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A SecondaryGroupSpec defines the desired state of a SecondaryGroup.
+type SecondaryGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecondaryGroupParameters `json:"forProvider"`
+}
+
+// A SecondaryGroupStatus represents the observed state of a SecondaryGroup.
+type SecondaryGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecondaryGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecondaryGroup is a secondary replication or failover group, replicating
+// a primary group of another account into this one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.secondaryState"
+// +kubebuilder:printcolumn:name="LAG",type="integer",JSONPath=".status.atProvider.lagSeconds"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type SecondaryGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecondaryGroupSpec   `json:"spec"`
+	Status SecondaryGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecondaryGroupList contains a list of SecondaryGroup
+type SecondaryGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecondaryGroup `json:"items"`
+}
+
+// SecondaryGroup type metadata.
+var (
+	SecondaryGroupKind             = reflect.TypeOf(SecondaryGroup{}).Name()
+	SecondaryGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SecondaryGroupKind}.String()
+	SecondaryGroupKindAPIVersion   = SecondaryGroupKind + "." + SchemeGroupVersion.String()
+	SecondaryGroupGroupVersionKind = SchemeGroupVersion.WithKind(SecondaryGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecondaryGroup{}, &SecondaryGroupList{})
+}
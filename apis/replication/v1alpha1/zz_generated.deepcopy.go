@@ -0,0 +1,519 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverGroup) DeepCopyInto(out *FailoverGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverGroup.
+func (in *FailoverGroup) DeepCopy() *FailoverGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FailoverGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverGroupList) DeepCopyInto(out *FailoverGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FailoverGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverGroupList.
+func (in *FailoverGroupList) DeepCopy() *FailoverGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FailoverGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverGroupObservation) DeepCopyInto(out *FailoverGroupObservation) {
+	*out = *in
+	if in.ObjectTypes != nil {
+		in, out := &in.ObjectTypes, &out.ObjectTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIntegrationTypes != nil {
+		in, out := &in.AllowedIntegrationTypes, &out.AllowedIntegrationTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAccounts != nil {
+		in, out := &in.AllowedAccounts, &out.AllowedAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverGroupObservation.
+func (in *FailoverGroupObservation) DeepCopy() *FailoverGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverGroupParameters) DeepCopyInto(out *FailoverGroupParameters) {
+	*out = *in
+	if in.ObjectTypes != nil {
+		in, out := &in.ObjectTypes, &out.ObjectTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DatabasesRefs != nil {
+		in, out := &in.DatabasesRefs, &out.DatabasesRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DatabasesSelector != nil {
+		in, out := &in.DatabasesSelector, &out.DatabasesSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedIntegrationTypes != nil {
+		in, out := &in.AllowedIntegrationTypes, &out.AllowedIntegrationTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAccounts != nil {
+		in, out := &in.AllowedAccounts, &out.AllowedAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicationSchedule != nil {
+		in, out := &in.ReplicationSchedule, &out.ReplicationSchedule
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverGroupParameters.
+func (in *FailoverGroupParameters) DeepCopy() *FailoverGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverGroupSpec) DeepCopyInto(out *FailoverGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverGroupSpec.
+func (in *FailoverGroupSpec) DeepCopy() *FailoverGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverGroupStatus) DeepCopyInto(out *FailoverGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverGroupStatus.
+func (in *FailoverGroupStatus) DeepCopy() *FailoverGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroup) DeepCopyInto(out *ReplicationGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroup.
+func (in *ReplicationGroup) DeepCopy() *ReplicationGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroupList) DeepCopyInto(out *ReplicationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupList.
+func (in *ReplicationGroupList) DeepCopy() *ReplicationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroupObservation) DeepCopyInto(out *ReplicationGroupObservation) {
+	*out = *in
+	if in.ObjectTypes != nil {
+		in, out := &in.ObjectTypes, &out.ObjectTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIntegrationTypes != nil {
+		in, out := &in.AllowedIntegrationTypes, &out.AllowedIntegrationTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAccounts != nil {
+		in, out := &in.AllowedAccounts, &out.AllowedAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupObservation.
+func (in *ReplicationGroupObservation) DeepCopy() *ReplicationGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroupParameters) DeepCopyInto(out *ReplicationGroupParameters) {
+	*out = *in
+	if in.ObjectTypes != nil {
+		in, out := &in.ObjectTypes, &out.ObjectTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DatabasesRefs != nil {
+		in, out := &in.DatabasesRefs, &out.DatabasesRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DatabasesSelector != nil {
+		in, out := &in.DatabasesSelector, &out.DatabasesSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedIntegrationTypes != nil {
+		in, out := &in.AllowedIntegrationTypes, &out.AllowedIntegrationTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAccounts != nil {
+		in, out := &in.AllowedAccounts, &out.AllowedAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicationSchedule != nil {
+		in, out := &in.ReplicationSchedule, &out.ReplicationSchedule
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupParameters.
+func (in *ReplicationGroupParameters) DeepCopy() *ReplicationGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupSpec.
+func (in *ReplicationGroupSpec) DeepCopy() *ReplicationGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroupStatus) DeepCopyInto(out *ReplicationGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupStatus.
+func (in *ReplicationGroupStatus) DeepCopy() *ReplicationGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryGroup) DeepCopyInto(out *SecondaryGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryGroup.
+func (in *SecondaryGroup) DeepCopy() *SecondaryGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecondaryGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryGroupList) DeepCopyInto(out *SecondaryGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecondaryGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryGroupList.
+func (in *SecondaryGroupList) DeepCopy() *SecondaryGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecondaryGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryGroupObservation) DeepCopyInto(out *SecondaryGroupObservation) {
+	*out = *in
+	if in.LagSeconds != nil {
+		in, out := &in.LagSeconds, &out.LagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryGroupObservation.
+func (in *SecondaryGroupObservation) DeepCopy() *SecondaryGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryGroupParameters) DeepCopyInto(out *SecondaryGroupParameters) {
+	*out = *in
+	if in.Suspended != nil {
+		in, out := &in.Suspended, &out.Suspended
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryGroupParameters.
+func (in *SecondaryGroupParameters) DeepCopy() *SecondaryGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryGroupSpec) DeepCopyInto(out *SecondaryGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryGroupSpec.
+func (in *SecondaryGroupSpec) DeepCopy() *SecondaryGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryGroupStatus) DeepCopyInto(out *SecondaryGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryGroupStatus.
+func (in *SecondaryGroupStatus) DeepCopy() *SecondaryGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
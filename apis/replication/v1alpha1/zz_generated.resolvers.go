@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this FailoverGroup.
+func (mg *FailoverGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Databases,
+		Extract:       v1alpha1.DatabaseName(),
+		References:    mg.Spec.ForProvider.DatabasesRefs,
+		Selector:      mg.Spec.ForProvider.DatabasesSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Databases")
+	}
+	mg.Spec.ForProvider.Databases = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DatabasesRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this ReplicationGroup.
+func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Databases,
+		Extract:       v1alpha1.DatabaseName(),
+		References:    mg.Spec.ForProvider.DatabasesRefs,
+		Selector:      mg.Spec.ForProvider.DatabasesSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Databases")
+	}
+	mg.Spec.ForProvider.Databases = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DatabasesRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ResourceMonitorParameters are the configurable fields of a ResourceMonitor.
+// +kubebuilder:validation:XValidation:rule="has(self.frequency) == has(self.startTimestamp)",message="frequency and startTimestamp must be set together"
+type ResourceMonitorParameters struct {
+	// name of the resource monitor
+	Name string `json:"name"`
+
+	// number of credits allowed per interval
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CreditQuota *int `json:"creditQuota,omitempty"`
+
+	// interval at which the used credits reset
+	// +kubebuilder:validation:Enum=DAILY;WEEKLY;MONTHLY;YEARLY;NEVER
+	// +optional
+	Frequency *string `json:"frequency,omitempty"`
+
+	// date and time the monitor starts, or IMMEDIATELY. It is only applied
+	// on creation and when the frequency changes, so that updates do not
+	// reset the interval.
+	// +optional
+	StartTimestamp *string `json:"startTimestamp,omitempty"`
+
+	// date and time the warehouses of the monitor are suspended
+	// +optional
+	EndTimestamp *string `json:"endTimestamp,omitempty"`
+
+	// users receiving the notifications of the monitor
+	// +optional
+	NotifyUsers []string `json:"notifyUsers,omitempty"`
+
+	// actions taken when a percentage of the credit quota is used. Snowflake
+	// allows up to five NOTIFY triggers and one trigger of each other action.
+	// +kubebuilder:validation:MaxItems=7
+	// +kubebuilder:validation:XValidation:rule="self.filter(t, t.action == 'SUSPEND').size() <= 1 && self.filter(t, t.action == 'SUSPEND_IMMEDIATE').size() <= 1",message="only one SUSPEND and one SUSPEND_IMMEDIATE trigger are allowed"
+	// +optional
+	Triggers []ResourceMonitorTrigger `json:"triggers,omitempty"`
+
+	// names of the warehouses the monitor is assigned to
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1.Warehouse
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1.WarehouseName()
+	// +crossplane:generate:reference:refFieldName=WarehouseRefs
+	// +crossplane:generate:reference:selectorFieldName=WarehouseSelector
+	// +optional
+	Warehouses []string `json:"warehouses,omitempty"`
+
+	// WarehouseRefs references Warehouses to populate warehouses.
+	// +optional
+	WarehouseRefs []xpv1.Reference `json:"warehouseRefs,omitempty"`
+
+	// WarehouseSelector selects references to Warehouses to populate
+	// warehouses.
+	// +optional
+	WarehouseSelector *xpv1.Selector `json:"warehouseSelector,omitempty"`
+}
+
+// ResourceMonitorTrigger is an action taken at a percentage of the quota.
+type ResourceMonitorTrigger struct {
+	// percentage of the credit quota, may exceed 100
+	// +kubebuilder:validation:Minimum=1
+	Threshold int `json:"threshold"`
+
+	// NOTIFY only sends notifications, SUSPEND lets running queries finish
+	// before suspending the warehouses and SUSPEND_IMMEDIATE cancels them
+	// +kubebuilder:validation:Enum=NOTIFY;SUSPEND;SUSPEND_IMMEDIATE
+	Action string `json:"action"`
+}
+
+// ResourceMonitorObservation are the observable fields of a ResourceMonitor.
+type ResourceMonitorObservation struct {
+	// credit quota of the current interval
+	CreditQuota string `json:"creditQuota,omitempty"`
+
+	// credits used in the current interval
+	UsedCredits string `json:"usedCredits,omitempty"`
+
+	// credits left in the current interval
+	RemainingCredits string `json:"remainingCredits,omitempty"`
+
+	// ACCOUNT or WAREHOUSE, empty while the monitor is not assigned
+	Level string `json:"level,omitempty"`
+
+	// interval at which the used credits reset
+	Frequency string `json:"frequency,omitempty"`
+
+	// start of the monitor
+	StartTime string `json:"startTime,omitempty"`
+
+	// end of the monitor
+	EndTime string `json:"endTime,omitempty"`
+
+	// thresholds of the NOTIFY triggers, e.g. 50%,75%
+	NotifyAt string `json:"notifyAt,omitempty"`
+
+	// threshold of the SUSPEND trigger
+	SuspendAt string `json:"suspendAt,omitempty"`
+
+	// threshold of the SUSPEND_IMMEDIATE trigger
+	SuspendImmediatelyAt string `json:"suspendImmediatelyAt,omitempty"`
+
+	// users receiving the notifications of the monitor
+	NotifyUsers []string `json:"notifyUsers,omitempty"`
+
+	// warehouses of the spec, or observed before, the monitor is assigned
+	// to. Assignments to other warehouses are not observed.
+	Warehouses []string `json:"warehouses,omitempty"`
+
+	// role owning the monitor
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the monitor
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A ResourceMonitorSpec defines the desired state of a ResourceMonitor.
+type ResourceMonitorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResourceMonitorParameters `json:"forProvider"`
+}
+
+// A ResourceMonitorStatus represents the observed state of a ResourceMonitor.
+type ResourceMonitorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourceMonitorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceMonitor tracks the credits used by warehouses and notifies or
+// suspends them at thresholds of a credit quota.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="QUOTA",type="string",JSONPath=".status.atProvider.creditQuota"
+// +kubebuilder:printcolumn:name="USED",type="string",JSONPath=".status.atProvider.usedCredits"
+// +kubebuilder:printcolumn:name="REMAINING",type="string",JSONPath=".status.atProvider.remainingCredits"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ResourceMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceMonitorSpec   `json:"spec"`
+	Status ResourceMonitorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceMonitorList contains a list of ResourceMonitor
+type ResourceMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceMonitor `json:"items"`
+}
+
+// ResourceMonitor type metadata.
+var (
+	ResourceMonitorKind             = reflect.TypeOf(ResourceMonitor{}).Name()
+	ResourceMonitorGroupKind        = schema.GroupKind{Group: Group, Kind: ResourceMonitorKind}.String()
+	ResourceMonitorKindAPIVersion   = ResourceMonitorKind + "." + SchemeGroupVersion.String()
+	ResourceMonitorGroupVersionKind = SchemeGroupVersion.WithKind(ResourceMonitorKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ResourceMonitor{}, &ResourceMonitorList{})
+}
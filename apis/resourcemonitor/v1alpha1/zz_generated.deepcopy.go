@@ -0,0 +1,221 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitor) DeepCopyInto(out *ResourceMonitor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitor.
+func (in *ResourceMonitor) DeepCopy() *ResourceMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceMonitor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorList) DeepCopyInto(out *ResourceMonitorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceMonitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorList.
+func (in *ResourceMonitorList) DeepCopy() *ResourceMonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceMonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorObservation) DeepCopyInto(out *ResourceMonitorObservation) {
+	*out = *in
+	if in.NotifyUsers != nil {
+		in, out := &in.NotifyUsers, &out.NotifyUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warehouses != nil {
+		in, out := &in.Warehouses, &out.Warehouses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorObservation.
+func (in *ResourceMonitorObservation) DeepCopy() *ResourceMonitorObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorParameters) DeepCopyInto(out *ResourceMonitorParameters) {
+	*out = *in
+	if in.CreditQuota != nil {
+		in, out := &in.CreditQuota, &out.CreditQuota
+		*out = new(int)
+		**out = **in
+	}
+	if in.Frequency != nil {
+		in, out := &in.Frequency, &out.Frequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	if in.NotifyUsers != nil {
+		in, out := &in.NotifyUsers, &out.NotifyUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]ResourceMonitorTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warehouses != nil {
+		in, out := &in.Warehouses, &out.Warehouses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WarehouseRefs != nil {
+		in, out := &in.WarehouseRefs, &out.WarehouseRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WarehouseSelector != nil {
+		in, out := &in.WarehouseSelector, &out.WarehouseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorParameters.
+func (in *ResourceMonitorParameters) DeepCopy() *ResourceMonitorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorSpec) DeepCopyInto(out *ResourceMonitorSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorSpec.
+func (in *ResourceMonitorSpec) DeepCopy() *ResourceMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorStatus) DeepCopyInto(out *ResourceMonitorStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorStatus.
+func (in *ResourceMonitorStatus) DeepCopy() *ResourceMonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMonitorTrigger) DeepCopyInto(out *ResourceMonitorTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMonitorTrigger.
+func (in *ResourceMonitorTrigger) DeepCopy() *ResourceMonitorTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMonitorTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
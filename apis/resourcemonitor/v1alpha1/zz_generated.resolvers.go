@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this ResourceMonitor.
+func (mg *ResourceMonitor) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Warehouses,
+		Extract:       v1alpha1.WarehouseName(),
+		References:    mg.Spec.ForProvider.WarehouseRefs,
+		Selector:      mg.Spec.ForProvider.WarehouseSelector,
+		To: reference.To{
+			List:    &v1alpha1.WarehouseList{},
+			Managed: &v1alpha1.Warehouse{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Warehouses")
+	}
+	mg.Spec.ForProvider.Warehouses = mrsp.ResolvedValues
+	mg.Spec.ForProvider.WarehouseRefs = mrsp.ResolvedReferences
+
+	return nil
+}
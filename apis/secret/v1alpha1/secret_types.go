@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Secret types.
+const (
+	SecretTypeGenericString = "GENERIC_STRING"
+	SecretTypePassword      = "PASSWORD"
+	SecretTypeOAuth2        = "OAUTH2"
+)
+
+// AnnotationKeyValuesVersion records the version of the values last set on a
+// Secret, as reported in its valuesVersion status field.
+const AnnotationKeyValuesVersion = "snowflake.crossplane.io/values-version"
+
+// SecretParameters are the configurable fields of a Secret. The secret values
+// are read from Kubernetes Secrets and set again whenever they change there.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="self.type != 'GENERIC_STRING' || has(self.secretStringSecretRef)",message="secretStringSecretRef is required for GENERIC_STRING secrets"
+// +kubebuilder:validation:XValidation:rule="self.type != 'PASSWORD' || (has(self.username) && has(self.passwordSecretRef))",message="username and passwordSecretRef are required for PASSWORD secrets"
+// +kubebuilder:validation:XValidation:rule="self.type != 'OAUTH2' || has(self.apiAuthentication)",message="apiAuthentication is required for OAUTH2 secrets"
+// +kubebuilder:validation:XValidation:rule="!(has(self.oauthScopes) && has(self.oauthRefreshTokenSecretRef))",message="oauthScopes and oauthRefreshTokenSecretRef are mutually exclusive"
+type SecretParameters struct {
+	// name of the secret
+	Name string `json:"name"`
+
+	// database the secret is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the secret is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// type of the secret
+	// +kubebuilder:validation:Enum=GENERIC_STRING;PASSWORD;OAUTH2
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// key of a Kubernetes Secret holding the value of a GENERIC_STRING
+	// secret
+	// +optional
+	SecretStringSecretRef *xpv1.SecretKeySelector `json:"secretStringSecretRef,omitempty"`
+
+	// user name of a PASSWORD secret
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// key of a Kubernetes Secret holding the password of a PASSWORD secret
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// security integration of an OAUTH2 secret
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="apiAuthentication is immutable"
+	// +optional
+	APIAuthentication *string `json:"apiAuthentication,omitempty"`
+
+	// scopes requested by an OAUTH2 secret using the client credentials flow
+	// +optional
+	OAuthScopes []string `json:"oauthScopes,omitempty"`
+
+	// key of a Kubernetes Secret holding the refresh token of an OAUTH2
+	// secret using the authorization code grant flow
+	// +optional
+	OAuthRefreshTokenSecretRef *xpv1.SecretKeySelector `json:"oauthRefreshTokenSecretRef,omitempty"`
+
+	// expiry time of the refresh token, e.g. 2025-01-31 12:00:00
+	// +optional
+	OAuthRefreshTokenExpiryTime *string `json:"oauthRefreshTokenExpiryTime,omitempty"`
+
+	// comment of the secret
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// SecretObservation are the observable fields of a Secret.
+type SecretObservation struct {
+	// type of the secret
+	Type string `json:"type,omitempty"`
+
+	// user name of a PASSWORD secret
+	Username string `json:"username,omitempty"`
+
+	// security integration of an OAUTH2 secret
+	APIAuthentication string `json:"apiAuthentication,omitempty"`
+
+	// scopes of an OAUTH2 secret
+	OAuthScopes []string `json:"oauthScopes,omitempty"`
+
+	// expiry time of the refresh token of an OAUTH2 secret
+	OAuthRefreshTokenExpiryTime string `json:"oauthRefreshTokenExpiryTime,omitempty"`
+
+	// comment of the secret
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the secret
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the secret
+	CreatedOn string `json:"createdOn,omitempty"`
+
+	// resource versions of the Kubernetes Secrets the values were last set
+	// from, and the refresh token expiry time set with them, as recorded by
+	// the values-version annotation
+	ValuesVersion string `json:"valuesVersion,omitempty"`
+}
+
+// A SecretSpec defines the desired state of a Secret.
+type SecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecretParameters `json:"forProvider"`
+}
+
+// A SecretStatus represents the observed state of a Secret.
+type SecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Secret is a schema object holding credentials for external access
+// integrations and functions.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Secret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretSpec   `json:"spec"`
+	Status SecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretList contains a list of Secret
+type SecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Secret `json:"items"`
+}
+
+// Secret type metadata.
+var (
+	SecretKind             = reflect.TypeOf(Secret{}).Name()
+	SecretGroupKind        = schema.GroupKind{Group: Group, Kind: SecretKind}.String()
+	SecretKindAPIVersion   = SecretKind + "." + SchemeGroupVersion.String()
+	SecretGroupVersionKind = SchemeGroupVersion.WithKind(SecretKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Secret{}, &SecretList{})
+}
+
+// SecretName returns the fully qualified name of a referenced Secret, for use
+// when resolving references to it from other resources.
+func SecretName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Secret)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
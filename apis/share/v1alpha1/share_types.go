@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ShareObject is a table or view of the shared database whose data is
+// shared.
+type ShareObject struct {
+	// kind of the object. Views must be secure views.
+	// +kubebuilder:validation:Enum=TABLE;EXTERNAL_TABLE;DYNAMIC_TABLE;ICEBERG_TABLE;VIEW;MATERIALIZED_VIEW
+	// +kubebuilder:default=TABLE
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// fully qualified name of the object, e.g. db.schema.table
+	Name string `json:"name"`
+}
+
+// ShareParameters are the configurable fields of a Share.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector) || !has(self.accounts) || size(self.accounts) == 0",message="a database must be shared before accounts can be added"
+type ShareParameters struct {
+	// name of the share
+	Name string `json:"name"`
+
+	// database whose usage is granted to the share. A share holds objects of
+	// a single database.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schemas of the database whose usage is granted to the share
+	// +optional
+	Schemas []string `json:"schemas,omitempty"`
+
+	// tables and views whose data is shared
+	// +optional
+	Objects []ShareObject `json:"objects,omitempty"`
+
+	// consumer accounts, as organization.account
+	// +optional
+	Accounts []string `json:"accounts,omitempty"`
+
+	// comment of the share
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ShareObservation are the observable fields of a Share.
+type ShareObservation struct {
+	// database shared
+	Database string `json:"database,omitempty"`
+
+	// privileges granted to the share, as PRIVILEGE ON TYPE NAME
+	Grants []string `json:"grants,omitempty"`
+
+	// consumer accounts of the share
+	Accounts []string `json:"accounts,omitempty"`
+
+	// comment of the share
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the share
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the share
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A ShareSpec defines the desired state of a Share.
+type ShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ShareParameters `json:"forProvider"`
+}
+
+// A ShareStatus represents the observed state of a Share.
+type ShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Share is an outbound share exposing the objects of a database to other
+// accounts.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Share struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ShareSpec   `json:"spec"`
+	Status ShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ShareList contains a list of Share
+type ShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Share `json:"items"`
+}
+
+// Share type metadata.
+var (
+	ShareKind             = reflect.TypeOf(Share{}).Name()
+	ShareGroupKind        = schema.GroupKind{Group: Group, Kind: ShareKind}.String()
+	ShareKindAPIVersion   = ShareKind + "." + SchemeGroupVersion.String()
+	ShareGroupVersionKind = SchemeGroupVersion.WithKind(ShareKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Share{}, &ShareList{})
+}
@@ -20,14 +20,56 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	accountv1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	alertv1alpha1 "github.com/allenkallz/provider-snowflake/apis/alert/v1alpha1"
 	databasev1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	externalvolumev1alpha1 "github.com/allenkallz/provider-snowflake/apis/externalvolume/v1alpha1"
+	fileformatv1alpha1 "github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1"
+	functionv1alpha1 "github.com/allenkallz/provider-snowflake/apis/function/v1alpha1"
+	grantv1alpha1 "github.com/allenkallz/provider-snowflake/apis/grant/v1alpha1"
+	integrationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	networkv1alpha1 "github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	parameterv1alpha1 "github.com/allenkallz/provider-snowflake/apis/parameter/v1alpha1"
+	pipev1alpha1 "github.com/allenkallz/provider-snowflake/apis/pipe/v1alpha1"
+	policyv1alpha1 "github.com/allenkallz/provider-snowflake/apis/policy/v1alpha1"
+	replicationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/replication/v1alpha1"
+	resourcemonitorv1alpha1 "github.com/allenkallz/provider-snowflake/apis/resourcemonitor/v1alpha1"
+	secretv1alpha1 "github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1"
+	sharev1alpha1 "github.com/allenkallz/provider-snowflake/apis/share/v1alpha1"
+	stagev1alpha1 "github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1"
+	streamv1alpha1 "github.com/allenkallz/provider-snowflake/apis/stream/v1alpha1"
+	tablev1alpha1 "github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+	tagv1alpha1 "github.com/allenkallz/provider-snowflake/apis/tag/v1alpha1"
+	taskv1alpha1 "github.com/allenkallz/provider-snowflake/apis/task/v1alpha1"
 	snowflakev1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	warehousev1alpha1 "github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
+		accountv1alpha1.SchemeBuilder.AddToScheme,
+		alertv1alpha1.SchemeBuilder.AddToScheme,
 		databasev1alpha1.SchemeBuilder.AddToScheme,
+		externalvolumev1alpha1.SchemeBuilder.AddToScheme,
+		fileformatv1alpha1.SchemeBuilder.AddToScheme,
+		functionv1alpha1.SchemeBuilder.AddToScheme,
+		grantv1alpha1.SchemeBuilder.AddToScheme,
+		integrationv1alpha1.SchemeBuilder.AddToScheme,
+		networkv1alpha1.SchemeBuilder.AddToScheme,
+		parameterv1alpha1.SchemeBuilder.AddToScheme,
+		pipev1alpha1.SchemeBuilder.AddToScheme,
+		policyv1alpha1.SchemeBuilder.AddToScheme,
+		replicationv1alpha1.SchemeBuilder.AddToScheme,
+		resourcemonitorv1alpha1.SchemeBuilder.AddToScheme,
+		secretv1alpha1.SchemeBuilder.AddToScheme,
+		sharev1alpha1.SchemeBuilder.AddToScheme,
+		stagev1alpha1.SchemeBuilder.AddToScheme,
+		streamv1alpha1.SchemeBuilder.AddToScheme,
+		tablev1alpha1.SchemeBuilder.AddToScheme,
+		tagv1alpha1.SchemeBuilder.AddToScheme,
+		taskv1alpha1.SchemeBuilder.AddToScheme,
+		warehousev1alpha1.SchemeBuilder.AddToScheme,
 		snowflakev1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
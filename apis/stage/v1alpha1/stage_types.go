@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// StageParameters are the configurable fields of a Stage.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="!(has(self.storageIntegration) || has(self.storageIntegrationRef) || has(self.storageIntegrationSelector)) || !has(self.credentialsSecretRef)",message="storageIntegration and credentialsSecretRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.url) || (!has(self.storageIntegration) && !has(self.storageIntegrationRef) && !has(self.storageIntegrationSelector) && !has(self.credentialsSecretRef))",message="storageIntegration and credentialsSecretRef require an external url"
+// +kubebuilder:validation:XValidation:rule="has(self.fileFormat) == has(oldSelf.fileFormat)",message="fileFormat cannot be added or removed"
+type StageParameters struct {
+	// name of the stage
+	Name string `json:"name"`
+
+	// database the stage is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the stage is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// url of the external location, e.g. s3://bucket/path/,
+	// gcs://bucket/path/ or azure://account.blob.core.windows.net/container/.
+	// An internal stage is created when no url is given.
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// storage integration used to access the external location
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.StorageIntegration
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.StorageIntegrationName()
+	// +optional
+	StorageIntegration *string `json:"storageIntegration,omitempty"`
+
+	// StorageIntegrationRef references a StorageIntegration to populate
+	// storageIntegration.
+	// +optional
+	StorageIntegrationRef *xpv1.Reference `json:"storageIntegrationRef,omitempty"`
+
+	// StorageIntegrationSelector selects a reference to a StorageIntegration
+	// to populate storageIntegration.
+	// +optional
+	StorageIntegrationSelector *xpv1.Selector `json:"storageIntegrationSelector,omitempty"`
+
+	// CredentialsSecretRef references a secret whose keys are passed as the
+	// stage credentials, e.g. AWS_KEY_ID and AWS_SECRET_KEY for S3 or
+	// AZURE_SAS_TOKEN for Azure.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// encryption of the staged files
+	// +optional
+	Encryption *StageEncryption `json:"encryption,omitempty"`
+
+	// directory table of the stage
+	// +optional
+	Directory *StageDirectory `json:"directory,omitempty"`
+
+	// default file format of the stage
+	// +optional
+	FileFormat *StageFileFormat `json:"fileFormat,omitempty"`
+
+	// comment of the stage
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// StageEncryption configures encryption of the staged files.
+type StageEncryption struct {
+	// encryption type
+	// +kubebuilder:validation:Enum=SNOWFLAKE_FULL;SNOWFLAKE_SSE;AWS_CSE;AWS_SSE_S3;AWS_SSE_KMS;GCS_SSE_KMS;AZURE_CSE;NONE
+	Type string `json:"type"`
+
+	// MasterKeySecretRef selects the client-side master key for the AWS_CSE
+	// and AZURE_CSE types.
+	// +optional
+	MasterKeySecretRef *xpv1.SecretKeySelector `json:"masterKeySecretRef,omitempty"`
+
+	// KMS key id for the AWS_SSE_KMS and GCS_SSE_KMS types
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+}
+
+// StageDirectory configures the directory table of a stage.
+// +kubebuilder:validation:XValidation:rule="has(self.autoRefresh) == has(oldSelf.autoRefresh) && (!has(self.autoRefresh) || self.autoRefresh == oldSelf.autoRefresh)",message="autoRefresh is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.refreshOnCreate) == has(oldSelf.refreshOnCreate) && (!has(self.refreshOnCreate) || self.refreshOnCreate == oldSelf.refreshOnCreate)",message="refreshOnCreate is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.notificationIntegration) == has(oldSelf.notificationIntegration) && (!has(self.notificationIntegration) || self.notificationIntegration == oldSelf.notificationIntegration)",message="notificationIntegration is immutable"
+type StageDirectory struct {
+	// enable the directory table
+	Enable bool `json:"enable"`
+
+	// refresh the directory table automatically from event notifications
+	// +optional
+	AutoRefresh *bool `json:"autoRefresh,omitempty"`
+
+	// refresh the directory table once when the stage is created
+	// +optional
+	RefreshOnCreate *bool `json:"refreshOnCreate,omitempty"`
+
+	// notification integration used for automatic refresh on Azure
+	// +optional
+	NotificationIntegration *string `json:"notificationIntegration,omitempty"`
+}
+
+// StageFileFormat is either a named file format or a format type with options.
+// +kubebuilder:validation:XValidation:rule="(has(self.formatName) || has(self.formatNameRef) || has(self.formatNameSelector)) != has(self.type)",message="exactly one of formatName and type must be set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.formatName) || (has(self.formatName) && self.formatName == oldSelf.formatName)",message="formatName is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.type) == has(oldSelf.type) && (!has(self.type) || self.type == oldSelf.type)",message="type is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.options) == has(oldSelf.options) && (!has(self.options) || self.options == oldSelf.options)",message="options is immutable"
+type StageFileFormat struct {
+	// fully qualified name of an existing file format
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1.FileFormat
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1.FileFormatName()
+	// +optional
+	FormatName *string `json:"formatName,omitempty"`
+
+	// FormatNameRef references a FileFormat to populate formatName.
+	// +optional
+	FormatNameRef *xpv1.Reference `json:"formatNameRef,omitempty"`
+
+	// FormatNameSelector selects a reference to a FileFormat to populate
+	// formatName.
+	// +optional
+	FormatNameSelector *xpv1.Selector `json:"formatNameSelector,omitempty"`
+
+	// format type
+	// +kubebuilder:validation:Enum=CSV;JSON;AVRO;ORC;PARQUET;XML
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// format type options, e.g. SKIP_HEADER: "1" or FIELD_DELIMITER: "|".
+	// String values are quoted; numbers, booleans, NONE, AUTO and the values
+	// of COMPRESSION and BINARY_FORMAT are passed bare.
+	// +kubebuilder:validation:MaxProperties=64
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[A-Za-z_][A-Za-z0-9_]*$'))",message="option names may only contain letters, digits and underscores"
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// StageObservation are the observable fields of a Stage.
+type StageObservation struct {
+	// stage type, INTERNAL or EXTERNAL
+	Type string `json:"type,omitempty"`
+
+	// url of the external location
+	URL string `json:"url,omitempty"`
+
+	// cloud provider of the external location
+	Cloud string `json:"cloud,omitempty"`
+
+	// region of the external location
+	Region string `json:"region,omitempty"`
+
+	// storage integration used by the stage
+	StorageIntegration string `json:"storageIntegration,omitempty"`
+
+	// whether the stage has credentials
+	HasCredentials bool `json:"hasCredentials,omitempty"`
+
+	// whether the stage has an encryption key
+	HasEncryptionKey bool `json:"hasEncryptionKey,omitempty"`
+
+	// whether the directory table is enabled
+	DirectoryEnabled bool `json:"directoryEnabled,omitempty"`
+
+	// comment of the stage
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the stage
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the stage
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A StageSpec defines the desired state of a Stage.
+type StageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StageParameters `json:"forProvider"`
+}
+
+// A StageStatus represents the observed state of a Stage.
+type StageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stage is an internal or external Snowflake stage.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Stage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StageSpec   `json:"spec"`
+	Status StageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StageList contains a list of Stage
+type StageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stage `json:"items"`
+}
+
+// Stage type metadata.
+var (
+	StageKind             = reflect.TypeOf(Stage{}).Name()
+	StageGroupKind        = schema.GroupKind{Group: Group, Kind: StageKind}.String()
+	StageKindAPIVersion   = StageKind + "." + SchemeGroupVersion.String()
+	StageGroupVersionKind = SchemeGroupVersion.WithKind(StageKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Stage{}, &StageList{})
+}
+
+// StageName returns the fully qualified name of a referenced Stage, for use
+// by the external tables reading from it.
+func StageName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Stage)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	v1alpha12 "github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1"
+	v1alpha11 "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this Stage.
+func (mg *Stage) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Database,
+		Extract:      v1alpha1.DatabaseName(),
+		Reference:    mg.Spec.ForProvider.DatabaseRef,
+		Selector:     mg.Spec.ForProvider.DatabaseSelector,
+		To: reference.To{
+			List:    &v1alpha1.DatabaseList{},
+			Managed: &v1alpha1.Database{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Database")
+	}
+	mg.Spec.ForProvider.Database = rsp.ResolvedValue
+	mg.Spec.ForProvider.DatabaseRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.StorageIntegration),
+		Extract:      v1alpha11.StorageIntegrationName(),
+		Reference:    mg.Spec.ForProvider.StorageIntegrationRef,
+		Selector:     mg.Spec.ForProvider.StorageIntegrationSelector,
+		To: reference.To{
+			List:    &v1alpha11.StorageIntegrationList{},
+			Managed: &v1alpha11.StorageIntegration{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.StorageIntegration")
+	}
+	mg.Spec.ForProvider.StorageIntegration = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.StorageIntegrationRef = rsp.ResolvedReference
+
+	if mg.Spec.ForProvider.FileFormat != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FileFormat.FormatName),
+			Extract:      v1alpha12.FileFormatName(),
+			Reference:    mg.Spec.ForProvider.FileFormat.FormatNameRef,
+			Selector:     mg.Spec.ForProvider.FileFormat.FormatNameSelector,
+			To: reference.To{
+				List:    &v1alpha12.FileFormatList{},
+				Managed: &v1alpha12.FileFormat{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.FileFormat.FormatName")
+		}
+		mg.Spec.ForProvider.FileFormat.FormatName = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.FileFormat.FormatNameRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// StreamParameters are the configurable fields of a Stream. Snowflake can
+// only alter the comment of a stream, so everything else is immutable.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.appendOnly) || self.sourceType == 'TABLE' || self.sourceType == 'VIEW'",message="appendOnly requires a TABLE or VIEW source"
+// +kubebuilder:validation:XValidation:rule="!has(self.insertOnly) || self.sourceType == 'EXTERNAL_TABLE'",message="insertOnly requires an EXTERNAL_TABLE source"
+// +kubebuilder:validation:XValidation:rule="self.sourceType != 'STAGE' || (!has(self.showInitialRows) && !has(self.timeTravel))",message="showInitialRows and timeTravel are not supported on a STAGE source"
+type StreamParameters struct {
+	// name of the stream
+	Name string `json:"name"`
+
+	// database the stream is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the stream is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// type of the object the stream tracks. A STAGE source tracks the
+	// directory table of the stage.
+	// +kubebuilder:validation:Enum=TABLE;EXTERNAL_TABLE;VIEW;STAGE
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceType is immutable"
+	SourceType string `json:"sourceType"`
+
+	// fully qualified name of the object the stream tracks
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="source is immutable"
+	Source string `json:"source"`
+
+	// record inserted rows only, for tables and views
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="appendOnly is immutable"
+	// +optional
+	AppendOnly *bool `json:"appendOnly,omitempty"`
+
+	// record inserted rows only, required for external tables
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="insertOnly is immutable"
+	// +optional
+	InsertOnly *bool `json:"insertOnly,omitempty"`
+
+	// return the rows of the source at creation time as inserts on the first
+	// consumption of the stream
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="showInitialRows is immutable"
+	// +optional
+	ShowInitialRows *bool `json:"showInitialRows,omitempty"`
+
+	// start the stream at a point in the past using Time Travel
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="timeTravel is immutable"
+	// +optional
+	TimeTravel *StreamTimeTravel `json:"timeTravel,omitempty"`
+
+	// comment of the stream
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// StreamTimeTravel is the AT or BEFORE clause the stream is created with.
+// Exactly one of timestamp, offset, statement and stream must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.timestamp), has(self.offset), has(self.statement), has(self.stream)].filter(x, x).size() == 1",message="exactly one of timestamp, offset, statement and stream must be set"
+type StreamTimeTravel struct {
+	// AT includes changes made by the referenced statement or at the
+	// timestamp, BEFORE excludes them
+	// +kubebuilder:validation:Enum=AT;BEFORE
+	// +kubebuilder:default=AT
+	// +optional
+	Clause string `json:"clause,omitempty"`
+
+	// timestamp, e.g. 2024-05-01 10:00:00 +0000
+	// +optional
+	Timestamp *string `json:"timestamp,omitempty"`
+
+	// offset in seconds from the current time, e.g. -3600
+	// +optional
+	Offset *int `json:"offset,omitempty"`
+
+	// query id of a statement
+	// +optional
+	Statement *string `json:"statement,omitempty"`
+
+	// name of another stream whose current offset is used
+	// +optional
+	Stream *string `json:"stream,omitempty"`
+}
+
+// StreamObservation are the observable fields of a Stream.
+type StreamObservation struct {
+	// whether the stream is stale and can no longer be consumed
+	Stale bool `json:"stale,omitempty"`
+
+	// time at which the stream becomes stale unless it is consumed
+	StaleAfter string `json:"staleAfter,omitempty"`
+
+	// mode of the stream, DEFAULT, APPEND_ONLY or INSERT_ONLY
+	Mode string `json:"mode,omitempty"`
+
+	// type of the tracked object
+	SourceType string `json:"sourceType,omitempty"`
+
+	// name of the tracked object
+	TableName string `json:"tableName,omitempty"`
+
+	// reason the stream is invalid, if any
+	InvalidReason string `json:"invalidReason,omitempty"`
+
+	// comment of the stream
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the stream
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the stream
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A StreamSpec defines the desired state of a Stream.
+type StreamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StreamParameters `json:"forProvider"`
+}
+
+// A StreamStatus represents the observed state of a Stream.
+type StreamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StreamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stream records the changes made to a table, external table, view or the
+// directory table of a stage.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".status.atProvider.mode"
+// +kubebuilder:printcolumn:name="STALE-AFTER",type="string",JSONPath=".status.atProvider.staleAfter",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Stream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamSpec   `json:"spec"`
+	Status StreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamList contains a list of Stream
+type StreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stream `json:"items"`
+}
+
+// Stream type metadata.
+var (
+	StreamKind             = reflect.TypeOf(Stream{}).Name()
+	StreamGroupKind        = schema.GroupKind{Group: Group, Kind: StreamKind}.String()
+	StreamKindAPIVersion   = StreamKind + "." + SchemeGroupVersion.String()
+	StreamGroupVersionKind = SchemeGroupVersion.WithKind(StreamKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Stream{}, &StreamList{})
+}
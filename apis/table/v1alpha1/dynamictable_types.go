@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Desired states of a DynamicTable.
+const (
+	DynamicTableStateStarted   = "Started"
+	DynamicTableStateSuspended = "Suspended"
+)
+
+// DynamicTableParameters are the configurable fields of a DynamicTable. A
+// changed query replaces the table, which then refreshes from scratch.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type DynamicTableParameters struct {
+	// name of the dynamic table
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// database the dynamic table is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the dynamic table is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// query whose results the dynamic table holds
+	Query string `json:"query"`
+
+	// maximum time the content of the dynamic table may lag behind its
+	// sources, e.g. 5 minutes, or DOWNSTREAM to refresh only when the dynamic
+	// tables depending on it do
+	// +kubebuilder:validation:Pattern=`^(DOWNSTREAM|[0-9]+ (seconds?|minutes?|hours?|days?))$`
+	TargetLag string `json:"targetLag"`
+
+	// warehouse running the refreshes
+	Warehouse string `json:"warehouse"`
+
+	// whether refreshes process only changes or recompute the whole query.
+	// AUTO lets Snowflake choose when the table is created.
+	// +kubebuilder:validation:Enum=AUTO;FULL;INCREMENTAL
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="refreshMode is immutable"
+	// +kubebuilder:default=AUTO
+	// +optional
+	RefreshMode string `json:"refreshMode,omitempty"`
+
+	// whether the table is first filled when it is created or at its first
+	// scheduled refresh
+	// +kubebuilder:validation:Enum=ON_CREATE;ON_SCHEDULE
+	// +kubebuilder:default=ON_CREATE
+	// +optional
+	Initialize string `json:"initialize,omitempty"`
+
+	// comment of the dynamic table
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// whether the dynamic table is refreshed or suspended
+	// +kubebuilder:validation:Enum=Started;Suspended
+	// +kubebuilder:default=Started
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// DynamicTableObservation are the observable fields of a DynamicTable.
+type DynamicTableObservation struct {
+	// scheduling state of the dynamic table, ACTIVE or SUSPENDED
+	SchedulingState string `json:"schedulingState,omitempty"`
+
+	// target lag of the dynamic table as reported by Snowflake
+	TargetLag string `json:"targetLag,omitempty"`
+
+	// warehouse running the refreshes
+	Warehouse string `json:"warehouse,omitempty"`
+
+	// refresh mode in effect, FULL or INCREMENTAL
+	RefreshMode string `json:"refreshMode,omitempty"`
+
+	// why Snowflake chose the refresh mode in effect
+	RefreshModeReason string `json:"refreshModeReason,omitempty"`
+
+	// CREATE statement of the dynamic table
+	Text string `json:"text,omitempty"`
+
+	// time up to which the sources are reflected in the content of the
+	// dynamic table
+	DataTimestamp string `json:"dataTimestamp,omitempty"`
+
+	// end time of the last completed refresh
+	LastRefreshedOn string `json:"lastRefreshedOn,omitempty"`
+
+	// state of the last completed refresh, e.g. SUCCEEDED or FAILED
+	LastRefreshState string `json:"lastRefreshState,omitempty"`
+
+	// error message of the last completed refresh, if it failed
+	LastRefreshMessage string `json:"lastRefreshMessage,omitempty"`
+
+	// comment of the dynamic table
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the dynamic table
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the dynamic table
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A DynamicTableSpec defines the desired state of a DynamicTable.
+type DynamicTableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DynamicTableParameters `json:"forProvider"`
+}
+
+// A DynamicTableStatus represents the observed state of a DynamicTable.
+type DynamicTableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DynamicTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DynamicTable holds the results of a query, refreshed automatically to stay
+// within a target lag of its sources.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.schedulingState"
+// +kubebuilder:printcolumn:name="LAST-REFRESH",type="string",JSONPath=".status.atProvider.lastRefreshState"
+// +kubebuilder:printcolumn:name="DATA-TIMESTAMP",type="string",JSONPath=".status.atProvider.dataTimestamp",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type DynamicTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DynamicTableSpec   `json:"spec"`
+	Status DynamicTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DynamicTableList contains a list of DynamicTable
+type DynamicTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamicTable `json:"items"`
+}
+
+// DynamicTable type metadata.
+var (
+	DynamicTableKind             = reflect.TypeOf(DynamicTable{}).Name()
+	DynamicTableGroupKind        = schema.GroupKind{Group: Group, Kind: DynamicTableKind}.String()
+	DynamicTableKindAPIVersion   = DynamicTableKind + "." + SchemeGroupVersion.String()
+	DynamicTableGroupVersionKind = SchemeGroupVersion.WithKind(DynamicTableKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DynamicTable{}, &DynamicTableList{})
+}
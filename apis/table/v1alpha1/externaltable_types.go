@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AnnotationKeyRefresh triggers a refresh of the metadata of an ExternalTable
+// or IcebergTable whenever its value changes, e.g. to the current timestamp.
+const AnnotationKeyRefresh = "snowflake.crossplane.io/refresh"
+
+// ConnectionDetailNotificationChannel is the connection secret key holding the
+// ARN of the SQS queue that receives the event notifications of an
+// auto-refresh external table.
+const ConnectionDetailNotificationChannel = "notification_channel"
+
+// ExternalTableParameters are the configurable fields of an ExternalTable.
+// External tables cannot be altered, only refreshed, so their definition is
+// immutable.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.stage) || has(self.stageRef) || has(self.stageSelector)",message="one of stage, stageRef or stageSelector is required"
+type ExternalTableParameters struct {
+	// name of the external table
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// database the external table is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the external table is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// virtual columns of the external table, computed from the VALUE column
+	// or METADATA$FILENAME
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="columns are immutable"
+	// +optional
+	Columns []ExternalTableColumn `json:"columns,omitempty"`
+
+	// names of the columns the external table is partitioned by, which must
+	// be computed from METADATA$FILENAME
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="partitionBy is immutable"
+	// +optional
+	PartitionBy []string `json:"partitionBy,omitempty"`
+
+	// fully qualified name of the external stage holding the files
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1.Stage
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1.StageName()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="stage is immutable"
+	// +optional
+	Stage string `json:"stage,omitempty"`
+
+	// StageRef references a Stage to populate stage.
+	// +optional
+	StageRef *xpv1.Reference `json:"stageRef,omitempty"`
+
+	// StageSelector selects a reference to a Stage to populate stage.
+	// +optional
+	StageSelector *xpv1.Selector `json:"stageSelector,omitempty"`
+
+	// path of the files below the url of the stage
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="path is immutable"
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// regular expression the paths of the files must match
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="pattern is immutable"
+	// +optional
+	Pattern *string `json:"pattern,omitempty"`
+
+	// format of the files
+	FileFormat ExternalTableFileFormat `json:"fileFormat"`
+
+	// refresh the metadata automatically from cloud storage event
+	// notifications
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="autoRefresh is immutable"
+	// +optional
+	AutoRefresh *bool `json:"autoRefresh,omitempty"`
+
+	// refresh the metadata once when the external table is created
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="refreshOnCreate is immutable"
+	// +optional
+	RefreshOnCreate *bool `json:"refreshOnCreate,omitempty"`
+
+	// ARN of the SNS topic for S3 event notifications fanned out through SNS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="awsSnsTopic is immutable"
+	// +optional
+	AWSSNSTopic *string `json:"awsSnsTopic,omitempty"`
+
+	// notification integration for auto-refresh on Google Cloud Storage or
+	// Azure
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="integration is immutable"
+	// +optional
+	Integration *string `json:"integration,omitempty"`
+
+	// comment of the external table
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="comment is immutable"
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ExternalTableColumn is a virtual column of an external table.
+type ExternalTableColumn struct {
+	// name of the column
+	Name string `json:"name"`
+
+	// data type of the column
+	Type string `json:"type"`
+
+	// expression computing the column, e.g. VALUE:c1::DATE
+	Expression string `json:"expression"`
+}
+
+// ExternalTableFileFormat is either a named file format or a format type with
+// options.
+// +kubebuilder:validation:XValidation:rule="(has(self.formatName) || has(self.formatNameRef) || has(self.formatNameSelector)) != has(self.type)",message="exactly one of formatName and type must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.type) == has(oldSelf.type)",message="fileFormat cannot switch between formatName and type"
+// +kubebuilder:validation:XValidation:rule="has(self.options) == has(oldSelf.options)",message="options cannot be added or removed"
+type ExternalTableFileFormat struct {
+	// fully qualified name of an existing file format
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1.FileFormat
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1.FileFormatName()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="formatName is immutable"
+	// +optional
+	FormatName *string `json:"formatName,omitempty"`
+
+	// FormatNameRef references a FileFormat to populate formatName.
+	// +optional
+	FormatNameRef *xpv1.Reference `json:"formatNameRef,omitempty"`
+
+	// FormatNameSelector selects a reference to a FileFormat to populate
+	// formatName.
+	// +optional
+	FormatNameSelector *xpv1.Selector `json:"formatNameSelector,omitempty"`
+
+	// format type
+	// +kubebuilder:validation:Enum=CSV;JSON;AVRO;ORC;PARQUET;XML
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// format type options, e.g. SKIP_HEADER: "1" or FIELD_DELIMITER: "|".
+	// String values are quoted; numbers, booleans, NONE, AUTO and the values
+	// of COMPRESSION and BINARY_FORMAT are passed bare.
+	// +kubebuilder:validation:MaxProperties=64
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k.matches('^[A-Za-z_][A-Za-z0-9_]*$'))",message="option names may only contain letters, digits and underscores"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="options is immutable"
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// ExternalTableObservation are the observable fields of an ExternalTable.
+type ExternalTableObservation struct {
+	// fully qualified name of the stage of the external table
+	Stage string `json:"stage,omitempty"`
+
+	// location of the files, including the url of the stage
+	Location string `json:"location,omitempty"`
+
+	// name of the file format of the external table
+	FileFormatName string `json:"fileFormatName,omitempty"`
+
+	// type of the file format of the external table
+	FileFormatType string `json:"fileFormatType,omitempty"`
+
+	// ARN of the SQS queue receiving the event notifications of an
+	// auto-refresh external table
+	NotificationChannel string `json:"notificationChannel,omitempty"`
+
+	// time the metadata was last refreshed
+	LastRefreshedOn string `json:"lastRefreshedOn,omitempty"`
+
+	// details of the last refresh of the metadata
+	LastRefreshDetails string `json:"lastRefreshDetails,omitempty"`
+
+	// whether the external table can no longer be queried, e.g. because its
+	// stage was dropped
+	Invalid bool `json:"invalid,omitempty"`
+
+	// why the external table is invalid
+	InvalidReason string `json:"invalidReason,omitempty"`
+
+	// comment of the external table
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the external table
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the external table
+	CreatedOn string `json:"createdOn,omitempty"`
+
+	// value of the refresh annotation the external table was last refreshed
+	// for
+	LastRefresh string `json:"lastRefresh,omitempty"`
+}
+
+// A ExternalTableSpec defines the desired state of a ExternalTable.
+type ExternalTableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ExternalTableParameters `json:"forProvider"`
+}
+
+// A ExternalTableStatus represents the observed state of a ExternalTable.
+type ExternalTableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ExternalTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ExternalTable queries files in an external stage as if they were in a
+// table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".status.atProvider.location"
+// +kubebuilder:printcolumn:name="LAST-REFRESHED",type="string",JSONPath=".status.atProvider.lastRefreshedOn",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type ExternalTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalTableSpec   `json:"spec"`
+	Status ExternalTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalTableList contains a list of ExternalTable
+type ExternalTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalTable `json:"items"`
+}
+
+// ExternalTable type metadata.
+var (
+	ExternalTableKind             = reflect.TypeOf(ExternalTable{}).Name()
+	ExternalTableGroupKind        = schema.GroupKind{Group: Group, Kind: ExternalTableKind}.String()
+	ExternalTableKindAPIVersion   = ExternalTableKind + "." + SchemeGroupVersion.String()
+	ExternalTableGroupVersionKind = SchemeGroupVersion.WithKind(ExternalTableKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalTable{}, &ExternalTableList{})
+}
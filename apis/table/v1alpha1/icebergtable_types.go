@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IcebergTableParameters are the configurable fields of an IcebergTable. A
+// table managed by Snowflake is defined by its columns and base location, one
+// managed by an external catalog by the catalog integration and the table in
+// that catalog.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.externalVolume) || has(self.externalVolumeRef) || has(self.externalVolumeSelector)",message="one of externalVolume, externalVolumeRef or externalVolumeSelector is required"
+// +kubebuilder:validation:XValidation:rule="(has(self.catalogIntegration) || has(self.catalogIntegrationRef) || has(self.catalogIntegrationSelector)) ? !has(self.columns) : (has(self.columns) && has(self.baseLocation))",message="columns and baseLocation are required for tables managed by Snowflake, and not allowed with a catalogIntegration"
+// +kubebuilder:validation:XValidation:rule="has(self.catalogIntegration) || has(self.catalogIntegrationRef) || has(self.catalogIntegrationSelector) || !(has(self.catalogTableName) || has(self.catalogNamespace) || has(self.metadataFilePath) || has(self.autoRefresh))",message="catalogTableName, catalogNamespace, metadataFilePath and autoRefresh require a catalogIntegration"
+type IcebergTableParameters struct {
+	// name of the Iceberg table
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// database the Iceberg table is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the Iceberg table is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// external volume holding the data and metadata files of the table
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/externalvolume/v1alpha1.ExternalVolume
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/externalvolume/v1alpha1.ExternalVolumeName()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="externalVolume is immutable"
+	// +optional
+	ExternalVolume string `json:"externalVolume,omitempty"`
+
+	// ExternalVolumeRef references an ExternalVolume to populate
+	// externalVolume.
+	// +optional
+	ExternalVolumeRef *xpv1.Reference `json:"externalVolumeRef,omitempty"`
+
+	// ExternalVolumeSelector selects a reference to an ExternalVolume to
+	// populate externalVolume.
+	// +optional
+	ExternalVolumeSelector *xpv1.Selector `json:"externalVolumeSelector,omitempty"`
+
+	// catalog integration of the external catalog managing the table. The
+	// table is managed by Snowflake when none is given.
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.CatalogIntegration
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1.CatalogIntegrationName()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogIntegration is immutable"
+	// +optional
+	CatalogIntegration *string `json:"catalogIntegration,omitempty"`
+
+	// CatalogIntegrationRef references a CatalogIntegration to populate
+	// catalogIntegration.
+	// +optional
+	CatalogIntegrationRef *xpv1.Reference `json:"catalogIntegrationRef,omitempty"`
+
+	// CatalogIntegrationSelector selects a reference to a CatalogIntegration
+	// to populate catalogIntegration.
+	// +optional
+	CatalogIntegrationSelector *xpv1.Selector `json:"catalogIntegrationSelector,omitempty"`
+
+	// columns of a table managed by Snowflake
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="columns are immutable"
+	// +optional
+	Columns []IcebergTableColumn `json:"columns,omitempty"`
+
+	// path of the files of a table managed by Snowflake, relative to the
+	// location of the external volume
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="baseLocation is immutable"
+	// +optional
+	BaseLocation *string `json:"baseLocation,omitempty"`
+
+	// name of the table in an AWS Glue or REST catalog
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogTableName is immutable"
+	// +optional
+	CatalogTableName *string `json:"catalogTableName,omitempty"`
+
+	// namespace of the table in an AWS Glue or REST catalog, defaulting to
+	// the namespace of the catalog integration
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="catalogNamespace is immutable"
+	// +optional
+	CatalogNamespace *string `json:"catalogNamespace,omitempty"`
+
+	// path of the metadata file of a table read from object storage,
+	// relative to the location of the external volume
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="metadataFilePath is immutable"
+	// +optional
+	MetadataFilePath *string `json:"metadataFilePath,omitempty"`
+
+	// poll the external catalog for changes to the table metadata
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="autoRefresh is immutable"
+	// +optional
+	AutoRefresh *bool `json:"autoRefresh,omitempty"`
+
+	// comment of the Iceberg table
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="comment is immutable"
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// IcebergTableColumn is a column of an Iceberg table managed by Snowflake.
+type IcebergTableColumn struct {
+	// name of the column
+	Name string `json:"name"`
+
+	// data type of the column
+	Type string `json:"type"`
+}
+
+// IcebergTableObservation are the observable fields of an IcebergTable.
+type IcebergTableObservation struct {
+	// external volume of the table
+	ExternalVolume string `json:"externalVolume,omitempty"`
+
+	// catalog of the table, SNOWFLAKE or a catalog integration
+	Catalog string `json:"catalog,omitempty"`
+
+	// whether the table is MANAGED by Snowflake or UNMANAGED
+	TableType string `json:"tableType,omitempty"`
+
+	// base location of the table
+	BaseLocation string `json:"baseLocation,omitempty"`
+
+	// name of the table in its external catalog
+	CatalogTableName string `json:"catalogTableName,omitempty"`
+
+	// namespace of the table in its external catalog
+	CatalogNamespace string `json:"catalogNamespace,omitempty"`
+
+	// state of the automatic refresh, e.g. RUNNING or STALLED
+	RefreshState string `json:"refreshState,omitempty"`
+
+	// why the automatic refresh is not running
+	RefreshStateReason string `json:"refreshStateReason,omitempty"`
+
+	// time of the snapshot the table was last refreshed to
+	LastSnapshotTime string `json:"lastSnapshotTime,omitempty"`
+
+	// comment of the table
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the table
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the table
+	CreatedOn string `json:"createdOn,omitempty"`
+
+	// value of the refresh annotation the table was last refreshed for
+	LastRefresh string `json:"lastRefresh,omitempty"`
+}
+
+// A IcebergTableSpec defines the desired state of a IcebergTable.
+type IcebergTableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IcebergTableParameters `json:"forProvider"`
+}
+
+// A IcebergTableStatus represents the observed state of a IcebergTable.
+type IcebergTableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IcebergTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IcebergTable is a table in Apache Iceberg format whose files are kept in
+// an external volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CATALOG",type="string",JSONPath=".status.atProvider.catalog"
+// +kubebuilder:printcolumn:name="REFRESH",type="string",JSONPath=".status.atProvider.refreshState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type IcebergTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IcebergTableSpec   `json:"spec"`
+	Status IcebergTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IcebergTableList contains a list of IcebergTable
+type IcebergTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IcebergTable `json:"items"`
+}
+
+// IcebergTable type metadata.
+var (
+	IcebergTableKind             = reflect.TypeOf(IcebergTable{}).Name()
+	IcebergTableGroupKind        = schema.GroupKind{Group: Group, Kind: IcebergTableKind}.String()
+	IcebergTableKindAPIVersion   = IcebergTableKind + "." + SchemeGroupVersion.String()
+	IcebergTableGroupVersionKind = SchemeGroupVersion.WithKind(IcebergTableKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IcebergTable{}, &IcebergTableList{})
+}
@@ -0,0 +1,618 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicTable) DeepCopyInto(out *DynamicTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicTable.
+func (in *DynamicTable) DeepCopy() *DynamicTable {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamicTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicTableList) DeepCopyInto(out *DynamicTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DynamicTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicTableList.
+func (in *DynamicTableList) DeepCopy() *DynamicTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamicTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicTableObservation) DeepCopyInto(out *DynamicTableObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicTableObservation.
+func (in *DynamicTableObservation) DeepCopy() *DynamicTableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicTableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicTableParameters) DeepCopyInto(out *DynamicTableParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicTableParameters.
+func (in *DynamicTableParameters) DeepCopy() *DynamicTableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicTableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicTableSpec) DeepCopyInto(out *DynamicTableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicTableSpec.
+func (in *DynamicTableSpec) DeepCopy() *DynamicTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicTableStatus) DeepCopyInto(out *DynamicTableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicTableStatus.
+func (in *DynamicTableStatus) DeepCopy() *DynamicTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTable) DeepCopyInto(out *ExternalTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTable.
+func (in *ExternalTable) DeepCopy() *ExternalTable {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableColumn) DeepCopyInto(out *ExternalTableColumn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableColumn.
+func (in *ExternalTableColumn) DeepCopy() *ExternalTableColumn {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableColumn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableFileFormat) DeepCopyInto(out *ExternalTableFileFormat) {
+	*out = *in
+	if in.FormatName != nil {
+		in, out := &in.FormatName, &out.FormatName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FormatNameRef != nil {
+		in, out := &in.FormatNameRef, &out.FormatNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FormatNameSelector != nil {
+		in, out := &in.FormatNameSelector, &out.FormatNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableFileFormat.
+func (in *ExternalTableFileFormat) DeepCopy() *ExternalTableFileFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableFileFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableList) DeepCopyInto(out *ExternalTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableList.
+func (in *ExternalTableList) DeepCopy() *ExternalTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableObservation) DeepCopyInto(out *ExternalTableObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableObservation.
+func (in *ExternalTableObservation) DeepCopy() *ExternalTableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableParameters) DeepCopyInto(out *ExternalTableParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]ExternalTableColumn, len(*in))
+		copy(*out, *in)
+	}
+	if in.PartitionBy != nil {
+		in, out := &in.PartitionBy, &out.PartitionBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StageRef != nil {
+		in, out := &in.StageRef, &out.StageRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StageSelector != nil {
+		in, out := &in.StageSelector, &out.StageSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(string)
+		**out = **in
+	}
+	in.FileFormat.DeepCopyInto(&out.FileFormat)
+	if in.AutoRefresh != nil {
+		in, out := &in.AutoRefresh, &out.AutoRefresh
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshOnCreate != nil {
+		in, out := &in.RefreshOnCreate, &out.RefreshOnCreate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AWSSNSTopic != nil {
+		in, out := &in.AWSSNSTopic, &out.AWSSNSTopic
+		*out = new(string)
+		**out = **in
+	}
+	if in.Integration != nil {
+		in, out := &in.Integration, &out.Integration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableParameters.
+func (in *ExternalTableParameters) DeepCopy() *ExternalTableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableSpec) DeepCopyInto(out *ExternalTableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableSpec.
+func (in *ExternalTableSpec) DeepCopy() *ExternalTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTableStatus) DeepCopyInto(out *ExternalTableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTableStatus.
+func (in *ExternalTableStatus) DeepCopy() *ExternalTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTable) DeepCopyInto(out *IcebergTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTable.
+func (in *IcebergTable) DeepCopy() *IcebergTable {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IcebergTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTableColumn) DeepCopyInto(out *IcebergTableColumn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTableColumn.
+func (in *IcebergTableColumn) DeepCopy() *IcebergTableColumn {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTableColumn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTableList) DeepCopyInto(out *IcebergTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IcebergTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTableList.
+func (in *IcebergTableList) DeepCopy() *IcebergTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IcebergTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTableObservation) DeepCopyInto(out *IcebergTableObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTableObservation.
+func (in *IcebergTableObservation) DeepCopy() *IcebergTableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTableParameters) DeepCopyInto(out *IcebergTableParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalVolumeRef != nil {
+		in, out := &in.ExternalVolumeRef, &out.ExternalVolumeRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalVolumeSelector != nil {
+		in, out := &in.ExternalVolumeSelector, &out.ExternalVolumeSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogIntegration != nil {
+		in, out := &in.CatalogIntegration, &out.CatalogIntegration
+		*out = new(string)
+		**out = **in
+	}
+	if in.CatalogIntegrationRef != nil {
+		in, out := &in.CatalogIntegrationRef, &out.CatalogIntegrationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogIntegrationSelector != nil {
+		in, out := &in.CatalogIntegrationSelector, &out.CatalogIntegrationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]IcebergTableColumn, len(*in))
+		copy(*out, *in)
+	}
+	if in.BaseLocation != nil {
+		in, out := &in.BaseLocation, &out.BaseLocation
+		*out = new(string)
+		**out = **in
+	}
+	if in.CatalogTableName != nil {
+		in, out := &in.CatalogTableName, &out.CatalogTableName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CatalogNamespace != nil {
+		in, out := &in.CatalogNamespace, &out.CatalogNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetadataFilePath != nil {
+		in, out := &in.MetadataFilePath, &out.MetadataFilePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoRefresh != nil {
+		in, out := &in.AutoRefresh, &out.AutoRefresh
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTableParameters.
+func (in *IcebergTableParameters) DeepCopy() *IcebergTableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTableSpec) DeepCopyInto(out *IcebergTableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTableSpec.
+func (in *IcebergTableSpec) DeepCopy() *IcebergTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IcebergTableStatus) DeepCopyInto(out *IcebergTableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IcebergTableStatus.
+func (in *IcebergTableStatus) DeepCopy() *IcebergTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IcebergTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
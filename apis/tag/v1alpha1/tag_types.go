@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TagParameters are the configurable fields of a Tag.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+type TagParameters struct {
+	// name of the tag
+	Name string `json:"name"`
+
+	// database the tag is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the tag is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// values the tag may be set to. Any value is allowed if empty.
+	// +kubebuilder:validation:MaxItems=300
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+
+	// comment of the tag
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// TagObservation are the observable fields of a Tag.
+type TagObservation struct {
+	// values the tag may be set to
+	AllowedValues []string `json:"allowedValues,omitempty"`
+
+	// comment of the tag
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the tag
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the tag
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A TagSpec defines the desired state of a Tag.
+type TagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagParameters `json:"forProvider"`
+}
+
+// A TagStatus represents the observed state of a Tag.
+type TagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Tag is a schema object whose values are set on other objects, e.g. to
+// attribute their cost.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Tag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagSpec   `json:"spec"`
+	Status TagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagList contains a list of Tag
+type TagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tag `json:"items"`
+}
+
+// Tag type metadata.
+var (
+	TagKind             = reflect.TypeOf(Tag{}).Name()
+	TagGroupKind        = schema.GroupKind{Group: Group, Kind: TagKind}.String()
+	TagKindAPIVersion   = TagKind + "." + SchemeGroupVersion.String()
+	TagGroupVersionKind = SchemeGroupVersion.WithKind(TagKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Tag{}, &TagList{})
+}
+
+// TagName returns the fully qualified name of a referenced Tag, for use when
+// resolving references to it from other resources.
+func TagName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Tag)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TagAssociationParameters are the configurable fields of a TagAssociation.
+// +kubebuilder:validation:XValidation:rule="has(self.tag) || has(self.tagRef) || has(self.tagSelector)",message="one of tag, tagRef or tagSelector is required"
+type TagAssociationParameters struct {
+	// fully qualified name of the tag to set
+	// +crossplane:generate:reference:type=Tag
+	// +crossplane:generate:reference:extractor=TagName()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tag is immutable"
+	// +optional
+	Tag string `json:"tag,omitempty"`
+
+	// TagRef references a Tag to populate tag.
+	// +optional
+	TagRef *xpv1.Reference `json:"tagRef,omitempty"`
+
+	// TagSelector selects a reference to a Tag to populate tag.
+	// +optional
+	TagSelector *xpv1.Selector `json:"tagSelector,omitempty"`
+
+	// value the tag is set to
+	Value string `json:"value"`
+
+	// kind of the tagged object
+	// +kubebuilder:validation:Enum=DATABASE;SCHEMA;TABLE;VIEW;COLUMN;WAREHOUSE;USER;ROLE
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectType is immutable"
+	ObjectType string `json:"objectType"`
+
+	// name of the tagged object, fully qualified for schema objects. Columns
+	// are named by the table they belong to, e.g. db.schema.table.column.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="objectName is immutable"
+	ObjectName string `json:"objectName"`
+}
+
+// TagAssociationObservation are the observable fields of a TagAssociation.
+type TagAssociationObservation struct {
+	// value the tag is set to on the object
+	Value string `json:"value,omitempty"`
+}
+
+// A TagAssociationSpec defines the desired state of a TagAssociation.
+type TagAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagAssociationParameters `json:"forProvider"`
+}
+
+// A TagAssociationStatus represents the observed state of a TagAssociation.
+type TagAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TagAssociation sets the value of a Tag on a database, schema, table,
+// column, warehouse, user or role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="OBJECT",type="string",JSONPath=".spec.forProvider.objectName"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type TagAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagAssociationSpec   `json:"spec"`
+	Status TagAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagAssociationList contains a list of TagAssociation
+type TagAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TagAssociation `json:"items"`
+}
+
+// TagAssociation type metadata.
+var (
+	TagAssociationKind             = reflect.TypeOf(TagAssociation{}).Name()
+	TagAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: TagAssociationKind}.String()
+	TagAssociationKindAPIVersion   = TagAssociationKind + "." + SchemeGroupVersion.String()
+	TagAssociationGroupVersionKind = SchemeGroupVersion.WithKind(TagAssociationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TagAssociation{}, &TagAssociationList{})
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Desired states of a Task.
+const (
+	TaskStateStarted   = "Started"
+	TaskStateSuspended = "Suspended"
+)
+
+// TaskParameters are the configurable fields of a Task. A task runs either on
+// a warehouse or serverless, sized by userTaskManagedInitialWarehouseSize.
+// +kubebuilder:validation:XValidation:rule="has(self.database) || has(self.databaseRef) || has(self.databaseSelector)",message="one of database, databaseRef or databaseSelector is required"
+// +kubebuilder:validation:XValidation:rule="!(has(self.warehouse) && has(self.userTaskManagedInitialWarehouseSize))",message="warehouse and userTaskManagedInitialWarehouseSize are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.schedule) && (has(self.after) || has(self.afterRefs) || has(self.afterSelector)))",message="only root tasks can have a schedule"
+type TaskParameters struct {
+	// name of the task
+	Name string `json:"name"`
+
+	// database the task is created in
+	// +crossplane:generate:reference:type=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.Database
+	// +crossplane:generate:reference:extractor=github.com/allenkallz/provider-snowflake/apis/database/v1alpha1.DatabaseName()
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to populate database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database to populate database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// schema the task is created in
+	// +kubebuilder:default=PUBLIC
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// SQL statement, procedure call or Snowflake Scripting block run by the
+	// task
+	SQL string `json:"sql"`
+
+	// schedule of a root task
+	// +optional
+	Schedule *TaskSchedule `json:"schedule,omitempty"`
+
+	// warehouse running the task
+	// +optional
+	Warehouse *string `json:"warehouse,omitempty"`
+
+	// initial size of the Snowflake managed compute of a serverless task
+	// +kubebuilder:validation:Enum=XSMALL;SMALL;MEDIUM;LARGE;XLARGE;XXLARGE
+	// +optional
+	UserTaskManagedInitialWarehouseSize *string `json:"userTaskManagedInitialWarehouseSize,omitempty"`
+
+	// fully qualified names of the predecessor tasks, making this task a
+	// child task in a DAG
+	// +crossplane:generate:reference:type=Task
+	// +crossplane:generate:reference:extractor=TaskName()
+	// +optional
+	After []string `json:"after,omitempty"`
+
+	// AfterRefs references Tasks to populate after.
+	// +optional
+	AfterRefs []xpv1.Reference `json:"afterRefs,omitempty"`
+
+	// AfterSelector selects references to Tasks to populate after.
+	// +optional
+	AfterSelector *xpv1.Selector `json:"afterSelector,omitempty"`
+
+	// boolean SQL expression that must be true for the task to run, e.g.
+	// SYSTEM$STREAM_HAS_DATA('ORDERS_CHANGES')
+	// +optional
+	When *string `json:"when,omitempty"`
+
+	// session parameters set for the task runs, e.g. TIMEZONE
+	// +optional
+	SessionParameters map[string]string `json:"sessionParameters,omitempty"`
+
+	// time limit of a single run in milliseconds
+	// +optional
+	UserTaskTimeoutMs *int `json:"userTaskTimeoutMs,omitempty"`
+
+	// number of consecutive failed runs after which the task is suspended
+	// +optional
+	SuspendTaskAfterNumFailures *int `json:"suspendTaskAfterNumFailures,omitempty"`
+
+	// allow runs of the DAG to overlap, root tasks only
+	// +optional
+	AllowOverlappingExecution *bool `json:"allowOverlappingExecution,omitempty"`
+
+	// notification integration receiving task error notifications
+	// +optional
+	ErrorIntegration *string `json:"errorIntegration,omitempty"`
+
+	// comment of the task
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// whether the task is resumed and runs on schedule or after its
+	// predecessors, or is suspended
+	// +kubebuilder:validation:Enum=Started;Suspended
+	// +kubebuilder:default=Started
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// TaskSchedule is either an interval in minutes or a cron expression.
+// +kubebuilder:validation:XValidation:rule="has(self.minutes) != has(self.cron)",message="exactly one of minutes and cron must be set"
+type TaskSchedule struct {
+	// interval between runs in minutes
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Minutes *int `json:"minutes,omitempty"`
+
+	// cron expression followed by a time zone, e.g. 0 9 * * MON-FRI UTC
+	// +optional
+	Cron *string `json:"cron,omitempty"`
+}
+
+// TaskObservation are the observable fields of a Task.
+type TaskObservation struct {
+	// state of the task, started or suspended
+	State string `json:"state,omitempty"`
+
+	// schedule of the task as reported by Snowflake
+	Schedule string `json:"schedule,omitempty"`
+
+	// warehouse running the task, empty for serverless tasks
+	Warehouse string `json:"warehouse,omitempty"`
+
+	// SQL run by the task
+	Definition string `json:"definition,omitempty"`
+
+	// condition of the task
+	Condition string `json:"condition,omitempty"`
+
+	// fully qualified names of the predecessor tasks
+	Predecessors []string `json:"predecessors,omitempty"`
+
+	// whether runs of the DAG may overlap
+	AllowOverlappingExecution bool `json:"allowOverlappingExecution,omitempty"`
+
+	// notification integration receiving task error notifications
+	ErrorIntegration string `json:"errorIntegration,omitempty"`
+
+	// session and task parameters set on the task
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// comment of the task
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the task
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the task
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A TaskSpec defines the desired state of a Task.
+type TaskSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaskParameters `json:"forProvider"`
+}
+
+// A TaskStatus represents the observed state of a Task.
+type TaskStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Task runs SQL on a schedule or after its predecessor tasks in a DAG.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".status.atProvider.schedule",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Task struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskSpec   `json:"spec"`
+	Status TaskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskList contains a list of Task
+type TaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Task `json:"items"`
+}
+
+// Task type metadata.
+var (
+	TaskKind             = reflect.TypeOf(Task{}).Name()
+	TaskGroupKind        = schema.GroupKind{Group: Group, Kind: TaskKind}.String()
+	TaskKindAPIVersion   = TaskKind + "." + SchemeGroupVersion.String()
+	TaskGroupVersionKind = SchemeGroupVersion.WithKind(TaskKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Task{}, &TaskList{})
+}
+
+// TaskName returns the fully qualified name of a referenced Task, for use
+// when resolving the predecessors of other tasks.
+func TaskName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Task)
+		if !ok {
+			return ""
+		}
+		p := cr.Spec.ForProvider
+		return strings.Join([]string{p.Database, p.Schema, p.Name}, ".")
+	}
+}
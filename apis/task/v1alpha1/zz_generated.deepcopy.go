@@ -0,0 +1,265 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Task) DeepCopyInto(out *Task) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
+func (in *Task) DeepCopy() *Task {
+	if in == nil {
+		return nil
+	}
+	out := new(Task)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Task) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskList) DeepCopyInto(out *TaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Task, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskList.
+func (in *TaskList) DeepCopy() *TaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskObservation) DeepCopyInto(out *TaskObservation) {
+	*out = *in
+	if in.Predecessors != nil {
+		in, out := &in.Predecessors, &out.Predecessors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskObservation.
+func (in *TaskObservation) DeepCopy() *TaskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskParameters) DeepCopyInto(out *TaskParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(TaskSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Warehouse != nil {
+		in, out := &in.Warehouse, &out.Warehouse
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserTaskManagedInitialWarehouseSize != nil {
+		in, out := &in.UserTaskManagedInitialWarehouseSize, &out.UserTaskManagedInitialWarehouseSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AfterRefs != nil {
+		in, out := &in.AfterRefs, &out.AfterRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AfterSelector != nil {
+		in, out := &in.AfterSelector, &out.AfterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionParameters != nil {
+		in, out := &in.SessionParameters, &out.SessionParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UserTaskTimeoutMs != nil {
+		in, out := &in.UserTaskTimeoutMs, &out.UserTaskTimeoutMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SuspendTaskAfterNumFailures != nil {
+		in, out := &in.SuspendTaskAfterNumFailures, &out.SuspendTaskAfterNumFailures
+		*out = new(int)
+		**out = **in
+	}
+	if in.AllowOverlappingExecution != nil {
+		in, out := &in.AllowOverlappingExecution, &out.AllowOverlappingExecution
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorIntegration != nil {
+		in, out := &in.ErrorIntegration, &out.ErrorIntegration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskParameters.
+func (in *TaskParameters) DeepCopy() *TaskParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskSchedule) DeepCopyInto(out *TaskSchedule) {
+	*out = *in
+	if in.Minutes != nil {
+		in, out := &in.Minutes, &out.Minutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.Cron != nil {
+		in, out := &in.Cron, &out.Cron
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSchedule.
+func (in *TaskSchedule) DeepCopy() *TaskSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSpec.
+func (in *TaskSpec) DeepCopy() *TaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskStatus) DeepCopyInto(out *TaskStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStatus.
+func (in *TaskStatus) DeepCopy() *TaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -36,6 +36,24 @@ type ProviderConfigSpec struct {
 	SnowflakeAccount string              `json:"snowflakeAccount"`
 	Username         string              `json:"username"`
 	FingerPrint      ProviderCredentials `json:"fingerPrint"`
+
+	// role every request runs as, owning the objects created through this
+	// ProviderConfig. The default role of the user is used when unset.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// warehouse statements run on. The default warehouse of the user is used
+	// when unset.
+	// +optional
+	Warehouse string `json:"warehouse,omitempty"`
+
+	// database statements run in when they do not name one
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// schema statements run in when they do not name one
+	// +optional
+	Schema string `json:"schema,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
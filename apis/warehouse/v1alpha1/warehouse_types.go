@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// WarehouseParameters are the configurable fields of a Warehouse.
+// +kubebuilder:validation:XValidation:rule="!has(self.minClusterCount) || !has(self.maxClusterCount) || self.minClusterCount <= self.maxClusterCount",message="minClusterCount must not exceed maxClusterCount"
+type WarehouseParameters struct {
+	// name of the warehouse
+	Name string `json:"name"`
+
+	// type of the warehouse
+	// +kubebuilder:validation:Enum=STANDARD;SNOWPARK-OPTIMIZED
+	// +optional
+	WarehouseType *string `json:"warehouseType,omitempty"`
+
+	// size of the compute cluster of the warehouse
+	// +kubebuilder:validation:Enum=XSMALL;SMALL;MEDIUM;LARGE;XLARGE;XXLARGE;XXXLARGE;X4LARGE;X5LARGE;X6LARGE
+	// +optional
+	WarehouseSize *string `json:"warehouseSize,omitempty"`
+
+	// minimum number of clusters of a multi-cluster warehouse
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinClusterCount *int `json:"minClusterCount,omitempty"`
+
+	// maximum number of clusters of a multi-cluster warehouse
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxClusterCount *int `json:"maxClusterCount,omitempty"`
+
+	// policy for starting and shutting down clusters of a multi-cluster
+	// warehouse
+	// +kubebuilder:validation:Enum=STANDARD;ECONOMY
+	// +optional
+	ScalingPolicy *string `json:"scalingPolicy,omitempty"`
+
+	// seconds of inactivity after which the warehouse is suspended, 0
+	// disables the suspension
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	AutoSuspend *int `json:"autoSuspend,omitempty"`
+
+	// whether the warehouse resumes when a statement is submitted to it
+	// +optional
+	AutoResume *bool `json:"autoResume,omitempty"`
+
+	// whether the warehouse is created suspended. It is only applied on
+	// creation.
+	// +optional
+	InitiallySuspended *bool `json:"initiallySuspended,omitempty"`
+
+	// comment of the warehouse
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// WarehouseObservation are the observable fields of a Warehouse.
+type WarehouseObservation struct {
+	// STARTED, SUSPENDED or RESIZING
+	State string `json:"state,omitempty"`
+
+	// type of the warehouse
+	Type string `json:"type,omitempty"`
+
+	// size of the warehouse as reported by Snowflake, e.g. X-Small
+	Size string `json:"size,omitempty"`
+
+	// minimum number of clusters
+	MinClusterCount string `json:"minClusterCount,omitempty"`
+
+	// maximum number of clusters
+	MaxClusterCount string `json:"maxClusterCount,omitempty"`
+
+	// scaling policy of the clusters
+	ScalingPolicy string `json:"scalingPolicy,omitempty"`
+
+	// seconds of inactivity after which the warehouse is suspended
+	AutoSuspend string `json:"autoSuspend,omitempty"`
+
+	// whether the warehouse resumes automatically
+	AutoResume string `json:"autoResume,omitempty"`
+
+	// resource monitor assigned to the warehouse
+	ResourceMonitor string `json:"resourceMonitor,omitempty"`
+
+	// comment of the warehouse
+	Comment string `json:"comment,omitempty"`
+
+	// role owning the warehouse
+	Owner string `json:"owner,omitempty"`
+
+	// creation time of the warehouse
+	CreatedOn string `json:"createdOn,omitempty"`
+}
+
+// A WarehouseSpec defines the desired state of a Warehouse.
+type WarehouseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WarehouseParameters `json:"forProvider"`
+}
+
+// A WarehouseStatus represents the observed state of a Warehouse.
+type WarehouseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WarehouseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Warehouse is a cluster of compute resources running queries and DML.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".status.atProvider.size"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,snowflake}
+type Warehouse struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WarehouseSpec   `json:"spec"`
+	Status WarehouseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WarehouseList contains a list of Warehouse
+type WarehouseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Warehouse `json:"items"`
+}
+
+// Warehouse type metadata.
+var (
+	WarehouseKind             = reflect.TypeOf(Warehouse{}).Name()
+	WarehouseGroupKind        = schema.GroupKind{Group: Group, Kind: WarehouseKind}.String()
+	WarehouseKindAPIVersion   = WarehouseKind + "." + SchemeGroupVersion.String()
+	WarehouseGroupVersionKind = SchemeGroupVersion.WithKind(WarehouseKind)
+)
+
+// WarehouseName returns the Snowflake name of a referenced Warehouse, for
+// use when resolving references to it from other resources.
+func WarehouseName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Warehouse)
+		if !ok {
+			return ""
+		}
+		return cr.Spec.ForProvider.Name
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&Warehouse{}, &WarehouseList{})
+}
@@ -0,0 +1,193 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Warehouse) DeepCopyInto(out *Warehouse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Warehouse.
+func (in *Warehouse) DeepCopy() *Warehouse {
+	if in == nil {
+		return nil
+	}
+	out := new(Warehouse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Warehouse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseList) DeepCopyInto(out *WarehouseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Warehouse, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseList.
+func (in *WarehouseList) DeepCopy() *WarehouseList {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WarehouseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseObservation) DeepCopyInto(out *WarehouseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseObservation.
+func (in *WarehouseObservation) DeepCopy() *WarehouseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseParameters) DeepCopyInto(out *WarehouseParameters) {
+	*out = *in
+	if in.WarehouseType != nil {
+		in, out := &in.WarehouseType, &out.WarehouseType
+		*out = new(string)
+		**out = **in
+	}
+	if in.WarehouseSize != nil {
+		in, out := &in.WarehouseSize, &out.WarehouseSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinClusterCount != nil {
+		in, out := &in.MinClusterCount, &out.MinClusterCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxClusterCount != nil {
+		in, out := &in.MaxClusterCount, &out.MaxClusterCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScalingPolicy != nil {
+		in, out := &in.ScalingPolicy, &out.ScalingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoSuspend != nil {
+		in, out := &in.AutoSuspend, &out.AutoSuspend
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutoResume != nil {
+		in, out := &in.AutoResume, &out.AutoResume
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InitiallySuspended != nil {
+		in, out := &in.InitiallySuspended, &out.InitiallySuspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseParameters.
+func (in *WarehouseParameters) DeepCopy() *WarehouseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
+func (in *WarehouseSpec) DeepCopy() *WarehouseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
+func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WarehouseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,76 @@
+package snowflake
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	accountv1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+)
+
+// FetchAccount returns the observed state of an account of the organization,
+// or ErrNotFound. Dropped accounts in their grace period are not listed.
+func (c ClientInfo) FetchAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) (accountv1alpha1.AccountObservation, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW ACCOUNTS LIKE "+QuoteString(p.Name))
+	if err != nil {
+		return accountv1alpha1.AccountObservation{}, err
+	}
+
+	for _, r := range rows {
+		if !strings.EqualFold(r["account_name"], p.Name) {
+			continue
+		}
+		return accountv1alpha1.AccountObservation{
+			OrganizationName:  r["organization_name"],
+			AccountLocator:    r["account_locator"],
+			AccountURL:        r["account_url"],
+			AccountLocatorURL: r["account_locator_url"],
+			Edition:           r["edition"],
+			Region:            r["snowflake_region"],
+			RegionGroup:       r["region_group"],
+			Comment:           r["comment"],
+			CreatedOn:         r["created_on"],
+		}, nil
+	}
+	return accountv1alpha1.AccountObservation{}, ErrNotFound
+}
+
+// CreateAccount creates an account along with its admin user.
+func (c ClientInfo) CreateAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE ACCOUNT " + QuoteIdentifier(p.Name))
+	b.WriteString(" ADMIN_NAME = " + QuoteIdentifier(p.AdminName))
+	b.WriteString(" ADMIN_RSA_PUBLIC_KEY = " + QuoteString(p.AdminRSAPublicKey))
+	b.WriteString(" EMAIL = " + QuoteString(p.Email))
+	b.WriteString(" EDITION = " + p.Edition)
+	if p.RegionGroup != nil {
+		b.WriteString(" REGION_GROUP = " + *p.RegionGroup)
+	}
+	if p.Region != nil {
+		b.WriteString(" REGION = " + *p.Region)
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// UpdateAccount updates the comment of an account, the only property that
+// can change after its creation.
+func (c ClientInfo) UpdateAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) error {
+	if p.Comment == nil {
+		return nil
+	}
+	_, err := c.ExecuteStatement(ctx, "COMMENT ON ACCOUNT "+QuoteIdentifier(p.Name)+" IS "+QuoteString(*p.Comment))
+	return err
+}
+
+// DeleteAccount drops an account. It can be restored during its grace period.
+func (c ClientInfo) DeleteAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP ACCOUNT IF EXISTS "+QuoteIdentifier(p.Name)+
+		" GRACE_PERIOD_IN_DAYS = "+strconv.Itoa(p.GracePeriodInDays))
+	return err
+}
@@ -0,0 +1,131 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	alertv1alpha1 "github.com/allenkallz/provider-snowflake/apis/alert/v1alpha1"
+)
+
+// AlertName returns the fully qualified identifier of an alert.
+func AlertName(p *alertv1alpha1.AlertParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// AlertSchedule returns s in the form Snowflake reports it, e.g. 60 MINUTE or
+// USING CRON 0 9 * * * UTC.
+func AlertSchedule(s alertv1alpha1.AlertSchedule) string {
+	switch {
+	case s.Minutes != nil:
+		return strconv.Itoa(*s.Minutes) + " MINUTE"
+	case s.Cron != nil:
+		return "USING CRON " + strings.Join(strings.Fields(*s.Cron), " ")
+	}
+	return ""
+}
+
+// FetchAlert returns the observed state of an alert along with its last
+// evaluation, or ErrNotFound.
+func (c ClientInfo) FetchAlert(ctx context.Context, p *alertv1alpha1.AlertParameters) (alertv1alpha1.AlertObservation, error) {
+	row, err := c.showObject(ctx, "ALERTS", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return alertv1alpha1.AlertObservation{}, err
+	}
+
+	obs := alertv1alpha1.AlertObservation{
+		State:     row["state"],
+		Schedule:  row["schedule"],
+		Warehouse: nullable(row["warehouse"]),
+		Condition: row["condition"],
+		Action:    row["action"],
+		Comment:   row["comment"],
+		Owner:     row["owner"],
+		CreatedOn: row["created_on"],
+	}
+
+	// ALERT_HISTORY also lists the next evaluation, yet to run
+	rows, err := c.ExecuteStatement(ctx, fmt.Sprintf(
+		"SELECT SCHEDULED_TIME, STATE FROM TABLE(%s.INFORMATION_SCHEMA.ALERT_HISTORY(ALERT_NAME => %s)) "+
+			"WHERE SCHEMA_NAME = %s AND STATE NOT IN ('SCHEDULED', 'EXECUTING') ORDER BY SCHEDULED_TIME DESC LIMIT 1",
+		QuoteIdentifier(p.Database), QuoteString(row["name"]), QuoteString(row["schema_name"])))
+	if err != nil {
+		return alertv1alpha1.AlertObservation{}, err
+	}
+	if len(rows) > 0 {
+		obs.LastExecutedOn = rows[0]["SCHEDULED_TIME"]
+		obs.LastExecutionState = rows[0]["STATE"]
+	}
+	return obs, nil
+}
+
+// CreateAlert creates an alert. New alerts are always suspended.
+func (c ClientInfo) CreateAlert(ctx context.Context, p *alertv1alpha1.AlertParameters) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE ALERT " + AlertName(p))
+	if p.Warehouse != nil {
+		b.WriteString(" WAREHOUSE = " + QuoteIdentifier(*p.Warehouse))
+	}
+	b.WriteString(" SCHEDULE = " + QuoteString(AlertSchedule(p.Schedule)))
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+	b.WriteString(" IF (EXISTS (" + p.Condition + ")) THEN " + p.Action)
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// UpdateAlert brings a suspended alert in line with p.
+func (c ClientInfo) UpdateAlert(ctx context.Context, p *alertv1alpha1.AlertParameters, obs alertv1alpha1.AlertObservation) error {
+	name := AlertName(p)
+
+	var set []string
+	if p.Warehouse != nil && !strings.EqualFold(*p.Warehouse, obs.Warehouse) {
+		set = append(set, "WAREHOUSE = "+QuoteIdentifier(*p.Warehouse))
+	}
+	if s := AlertSchedule(p.Schedule); s != strings.Join(strings.Fields(obs.Schedule), " ") {
+		set = append(set, "SCHEDULE = "+QuoteString(s))
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		set = append(set, "COMMENT = "+QuoteString(*p.Comment))
+	}
+
+	var stmts []string
+	if len(set) > 0 {
+		stmts = append(stmts, "ALTER ALERT "+name+" SET "+strings.Join(set, " "))
+	}
+	if NormalizeSQL(p.Condition) != NormalizeSQL(obs.Condition) {
+		stmts = append(stmts, "ALTER ALERT "+name+" MODIFY CONDITION EXISTS ("+p.Condition+")")
+	}
+	if NormalizeSQL(p.Action) != NormalizeSQL(obs.Action) {
+		stmts = append(stmts, "ALTER ALERT "+name+" MODIFY ACTION "+p.Action)
+	}
+
+	for _, stmt := range stmts {
+		if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SuspendAlert suspends the alert with the given fully qualified name.
+func (c ClientInfo) SuspendAlert(ctx context.Context, name string) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER ALERT "+name+" SUSPEND")
+	return err
+}
+
+// ResumeAlert resumes the alert with the given fully qualified name.
+func (c ClientInfo) ResumeAlert(ctx context.Context, name string) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER ALERT "+name+" RESUME")
+	return err
+}
+
+// DeleteAlert drops an alert.
+func (c ClientInfo) DeleteAlert(ctx context.Context, p *alertv1alpha1.AlertParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP ALERT IF EXISTS "+AlertName(p))
+	return err
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	alertv1alpha1 "github.com/allenkallz/provider-snowflake/apis/alert/v1alpha1"
+)
+
+func TestUpdateAlert(t *testing.T) {
+	p := alertv1alpha1.AlertParameters{
+		Name:      "negative_amounts",
+		Database:  "sales",
+		Schema:    "public",
+		Warehouse: ptr.To("alerting"),
+		Schedule:  alertv1alpha1.AlertSchedule{Minutes: ptr.To(60)},
+		Condition: "SELECT 1 FROM orders WHERE amount < 0",
+		Action:    "CALL notify('negative amounts')",
+	}
+
+	cases := map[string]struct {
+		reason string
+		obs    alertv1alpha1.AlertObservation
+		want   []string
+	}{
+		"UpToDate": {
+			reason: "Nothing should be altered when the alert matches.",
+			obs: alertv1alpha1.AlertObservation{
+				Schedule:  "60 MINUTE",
+				Warehouse: "ALERTING",
+				Condition: "SELECT 1\nFROM orders WHERE amount < 0",
+				Action:    "CALL notify('negative amounts')",
+			},
+		},
+		"Changed": {
+			reason: "Properties should be set before the condition and action are modified.",
+			obs: alertv1alpha1.AlertObservation{
+				Schedule:  "USING CRON 0 * * * * UTC",
+				Warehouse: "COMPUTE_WH",
+				Condition: "SELECT 1 FROM orders WHERE amount <= 0",
+				Action:    "CALL notify('amounts')",
+			},
+			want: []string{
+				"ALTER ALERT sales.public.negative_amounts SET WAREHOUSE = alerting SCHEDULE = '60 MINUTE'",
+				"ALTER ALERT sales.public.negative_amounts MODIFY CONDITION EXISTS (SELECT 1 FROM orders WHERE amount < 0)",
+				"ALTER ALERT sales.public.negative_amounts MODIFY ACTION CALL notify('negative amounts')",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.UpdateAlert(context.Background(), &p, tc.obs); err != nil {
+				t.Fatalf("\n%s\nc.UpdateAlert(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nc.UpdateAlert(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
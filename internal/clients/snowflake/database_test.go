@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+)
+
+func TestCreateDatabase(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.DatabaseParameters
+		want   string
+	}{
+		"Database": {
+			reason: "The database should be created in the session of the ProviderConfig.",
+			p:      v1alpha1.DatabaseParameters{Name: "partner_sales"},
+			want:   "CREATE DATABASE partner_sales",
+		},
+		"QuotedName": {
+			reason: "Database names should be quoted so that they cannot extend the statement.",
+			p:      v1alpha1.DatabaseParameters{Name: "sales; DROP DATABASE RAW"},
+			want:   `CREATE DATABASE "sales; DROP DATABASE RAW"`,
+		},
+		"Share": {
+			reason: "The database should be created from the named share.",
+			p:      v1alpha1.DatabaseParameters{Name: "partner_sales", FromShare: ptr.To("PARTNER_ORG.PARTNER_ACCT.SALES_SHARE")},
+			want:   "CREATE DATABASE partner_sales FROM SHARE PARTNER_ORG.PARTNER_ACCT.SALES_SHARE",
+		},
+		"QuotedShare": {
+			reason: "Share names should be quoted so that they cannot extend the statement.",
+			p:      v1alpha1.DatabaseParameters{Name: "partner_sales", FromShare: ptr.To("PARTNER_ORG.PARTNER_ACCT.SALES; DROP DATABASE RAW")},
+			want:   `CREATE DATABASE partner_sales FROM SHARE PARTNER_ORG.PARTNER_ACCT."SALES; DROP DATABASE RAW"`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			c.Role, c.Warehouse = "PROVISIONER", "ADMIN_WH"
+			if _, err := c.CreateDatabase(context.Background(), &tc.p); err != nil {
+				t.Fatalf("\n%s\nc.CreateDatabase(...): %v\n", tc.reason, err)
+			}
+			want := []statementRequest{{Statement: tc.want, Timeout: statementTimeout, Role: "PROVISIONER", Warehouse: "ADMIN_WH"}}
+			if diff := cmp.Diff(want, api.requests); diff != "" {
+				t.Errorf("\n%s\nc.CreateDatabase(...): -want requests, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeleteDatabase(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		statements []string
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.DatabaseParameters
+		err    error
+		want   want
+	}{
+		"Restrict": {
+			reason: "A database should be dropped with RESTRICT by default.",
+			p:      v1alpha1.DatabaseParameters{Name: "analytics"},
+			want:   want{statements: []string{"DROP DATABASE IF EXISTS analytics RESTRICT"}},
+		},
+		"Cascade": {
+			reason: "A database with dropBehavior CASCADE should be dropped with CASCADE.",
+			p:      v1alpha1.DatabaseParameters{Name: "analytics", DropBehavior: "CASCADE"},
+			want:   want{statements: []string{"DROP DATABASE IF EXISTS analytics CASCADE"}},
+		},
+		"Failed": {
+			reason: "Errors dropping the database should be returned.",
+			p:      v1alpha1.DatabaseParameters{Name: "analytics"},
+			err:    errBoom,
+			want: want{
+				statements: []string{"DROP DATABASE IF EXISTS analytics RESTRICT"},
+				err:        errors.Errorf("statement failed with code 000001: %s", errBoom),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{Rows: func(string) ([]Row, error) { return nil, tc.err }}
+			c := newTestClient(t, api)
+			err := c.DeleteDatabase(context.Background(), &tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.DeleteDatabase(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.statements, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.DeleteDatabase(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
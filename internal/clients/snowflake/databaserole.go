@@ -0,0 +1,186 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	dbv1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+)
+
+// DatabaseRoleName returns the qualified name of a database role.
+func DatabaseRoleName(p *dbv1alpha1.DatabaseRoleParameters) string {
+	return QualifiedName(p.Database, p.Name)
+}
+
+// databaseRoleGrants returns the privileges p grants to the database role.
+func databaseRoleGrants(p *dbv1alpha1.DatabaseRoleParameters) []objectGrant {
+	var grants []objectGrant
+	for _, g := range p.Grants {
+		for _, priv := range g.Privileges {
+			grants = append(grants, objectGrant{Privilege: priv, ObjectType: g.ObjectType, Name: g.ObjectName})
+		}
+	}
+	return grants
+}
+
+// impliedDatabaseRoleGrant reports whether key is the USAGE privilege on the
+// database of the role, which roles of a database hold whether it is granted
+// or not. It is never revoked.
+func impliedDatabaseRoleGrant(p *dbv1alpha1.DatabaseRoleParameters, key string) bool {
+	return key == objectGrant{Privilege: "USAGE", ObjectType: "DATABASE", Name: p.Database}.key()
+}
+
+// DatabaseRoleGrantsUpToDate reports whether the privileges granted to a
+// database role match those of p.
+func DatabaseRoleGrantsUpToDate(p *dbv1alpha1.DatabaseRoleParameters, obs dbv1alpha1.DatabaseRoleObservation) bool {
+	want := map[string]bool{}
+	for _, g := range databaseRoleGrants(p) {
+		want[g.key()] = true
+	}
+	have := map[string]bool{}
+	for _, k := range obs.Grants {
+		if !want[k] && impliedDatabaseRoleGrant(p, k) {
+			continue
+		}
+		have[k] = true
+	}
+	if len(want) != len(have) {
+		return false
+	}
+	for k := range want {
+		if !have[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// FetchDatabaseRole returns the observed state of a database role, or
+// ErrNotFound.
+func (c ClientInfo) FetchDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters) (dbv1alpha1.DatabaseRoleObservation, error) {
+	row, err := c.showObject(ctx, "DATABASE ROLES", p.Name, "IN DATABASE "+QuoteIdentifier(p.Database))
+	if err != nil {
+		return dbv1alpha1.DatabaseRoleObservation{}, err
+	}
+
+	obs := dbv1alpha1.DatabaseRoleObservation{
+		Comment:   row["comment"],
+		Owner:     row["owner"],
+		CreatedOn: row["created_on"],
+	}
+
+	name := DatabaseRoleName(p)
+	grants, err := c.databaseRoleGrantsOf(ctx, name)
+	if err != nil {
+		return dbv1alpha1.DatabaseRoleObservation{}, err
+	}
+	for _, g := range grants {
+		obs.Grants = append(obs.Grants, g.key())
+	}
+
+	of, err := c.ExecuteStatement(ctx, "SHOW GRANTS OF DATABASE ROLE "+name)
+	if err != nil {
+		return dbv1alpha1.DatabaseRoleObservation{}, err
+	}
+	for _, g := range of {
+		if strings.EqualFold(g["granted_to"], "ROLE") {
+			obs.GrantedToRoles = append(obs.GrantedToRoles, g["grantee_name"])
+		}
+	}
+	return obs, nil
+}
+
+// databaseRoleGrantsOf returns the privileges granted to a database role, in
+// the order Snowflake lists them.
+func (c ClientInfo) databaseRoleGrantsOf(ctx context.Context, role string) ([]objectGrant, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW GRANTS TO DATABASE ROLE "+role)
+	if err != nil {
+		return nil, err
+	}
+	grants := make([]objectGrant, 0, len(rows))
+	for _, g := range rows {
+		// ownership is transferred rather than granted
+		if strings.EqualFold(g["privilege"], "OWNERSHIP") {
+			continue
+		}
+		grants = append(grants, objectGrant{Privilege: g["privilege"], ObjectType: g["granted_on"], Name: g["name"]})
+	}
+	return grants, nil
+}
+
+// CreateDatabaseRole creates a database role, grants it the privileges of p
+// and grants it to the account roles of p.
+func (c ClientInfo) CreateDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters) error {
+	stmt := "CREATE DATABASE ROLE " + DatabaseRoleName(p)
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+	if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+		return err
+	}
+
+	return c.UpdateDatabaseRole(ctx, p, dbv1alpha1.DatabaseRoleObservation{})
+}
+
+// UpdateDatabaseRole grants and revokes privileges of a database role, grants
+// it to and revokes it from account roles and sets its comment.
+func (c ClientInfo) UpdateDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters, obs dbv1alpha1.DatabaseRoleObservation) error {
+	name := DatabaseRoleName(p)
+
+	have := map[string]bool{}
+	for _, k := range obs.Grants {
+		have[k] = true
+	}
+	var stmts []string
+	want := map[string]bool{}
+	for _, g := range databaseRoleGrants(p) {
+		want[g.key()] = true
+		if !have[g.key()] {
+			stmts = append(stmts, "GRANT "+g.on()+" TO DATABASE ROLE "+name)
+		}
+	}
+
+	// observed grants are keyed in upper case, so the grants to revoke are
+	// read again to keep the case of quoted names
+	stale := false
+	for _, k := range obs.Grants {
+		stale = stale || !want[k] && !impliedDatabaseRoleGrant(p, k)
+	}
+	if stale {
+		grants, err := c.databaseRoleGrantsOf(ctx, name)
+		if err != nil {
+			return err
+		}
+		// revoke objects before the schemas and database holding them
+		for i := len(grants) - 1; i >= 0; i-- {
+			if k := grants[i].key(); !want[k] && !impliedDatabaseRoleGrant(p, k) {
+				stmts = append(stmts, "REVOKE "+grants[i].on()+" FROM DATABASE ROLE "+name)
+			}
+		}
+	}
+
+	added, removed := NameDiff(p.GrantedToRoles, obs.GrantedToRoles)
+	for _, r := range added {
+		stmts = append(stmts, "GRANT DATABASE ROLE "+name+" TO ROLE "+QuoteIdentifier(r))
+	}
+	for _, r := range removed {
+		stmts = append(stmts, "REVOKE DATABASE ROLE "+name+" FROM ROLE "+QuoteIdentifier(r))
+	}
+
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		stmts = append(stmts, "ALTER DATABASE ROLE "+name+" SET COMMENT = "+QuoteString(*p.Comment))
+	}
+
+	for _, s := range stmts {
+		if _, err := c.ExecuteStatement(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteDatabaseRole drops a database role.
+func (c ClientInfo) DeleteDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP DATABASE ROLE IF EXISTS "+DatabaseRoleName(p))
+	return err
+}
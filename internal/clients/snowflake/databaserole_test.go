@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	dbv1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+)
+
+func TestUpdateDatabaseRole(t *testing.T) {
+	granted := []Row{
+		{"privilege": "USAGE", "granted_on": "DATABASE", "name": "SALES"},
+		{"privilege": "OWNERSHIP", "granted_on": "SCHEMA", "name": "SALES.REPORTING"},
+		{"privilege": "USAGE", "granted_on": "SCHEMA", "name": "SALES.PUBLIC"},
+		{"privilege": "SELECT", "granted_on": "TABLE", "name": `SALES.PUBLIC."Orders"`},
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      dbv1alpha1.DatabaseRoleParameters
+		want   []string
+	}{
+		"UpToDate": {
+			reason: "Nothing should be granted or revoked when the grants match, whether or not usage of the database is listed.",
+			p: dbv1alpha1.DatabaseRoleParameters{Name: "analyst", Database: "SALES", Grants: []dbv1alpha1.DatabaseRoleGrant{
+				{Privileges: []string{"USAGE"}, ObjectType: "SCHEMA", ObjectName: "SALES.PUBLIC"},
+				{Privileges: []string{"SELECT"}, ObjectType: "TABLE", ObjectName: `SALES.PUBLIC."Orders"`},
+			}},
+			want: []string{},
+		},
+		"RevokeQuoted": {
+			reason: "Grants should be revoked by their observed names, keeping the case of quoted names and leaving ownership and database usage alone.",
+			p: dbv1alpha1.DatabaseRoleParameters{Name: "analyst", Database: "SALES", Grants: []dbv1alpha1.DatabaseRoleGrant{
+				{Privileges: []string{"USAGE"}, ObjectType: "SCHEMA", ObjectName: "SALES.PUBLIC"},
+				{Privileges: []string{"SELECT"}, ObjectType: "VIEW", ObjectName: "SALES.PUBLIC.CUSTOMERS"},
+			}},
+			want: []string{
+				"GRANT SELECT ON VIEW SALES.PUBLIC.CUSTOMERS TO DATABASE ROLE SALES.analyst",
+				`REVOKE SELECT ON TABLE SALES.PUBLIC."Orders" FROM DATABASE ROLE SALES.analyst`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{Rows: func(s string) ([]Row, error) {
+				switch {
+				case strings.HasPrefix(s, "SHOW DATABASE ROLES"):
+					return []Row{{"name": "ANALYST"}}, nil
+				case strings.HasPrefix(s, "SHOW GRANTS TO"):
+					return granted, nil
+				}
+				return nil, nil
+			}}
+			c := newTestClient(t, api)
+			obs, err := c.FetchDatabaseRole(context.Background(), &tc.p)
+			if err != nil {
+				t.Fatalf("\n%s\nc.FetchDatabaseRole(...): %v\n", tc.reason, err)
+			}
+			api.requests = nil
+			if err := c.UpdateDatabaseRole(context.Background(), &tc.p, obs); err != nil {
+				t.Fatalf("\n%s\nc.UpdateDatabaseRole(...): %v\n", tc.reason, err)
+			}
+			got := []string{}
+			for _, s := range api.statements() {
+				if !strings.HasPrefix(s, "SHOW ") {
+					got = append(got, s)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nc.UpdateDatabaseRole(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tablev1alpha1 "github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+)
+
+// lagUnits are the durations of the units a target lag can be given in.
+var lagUnits = map[string]int{"SECOND": 1, "MINUTE": 60, "HOUR": 3600, "DAY": 86400}
+
+// DynamicTableName returns the fully qualified identifier of a dynamic table.
+func DynamicTableName(p *tablev1alpha1.DynamicTableParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// lagSeconds returns the number of seconds of a target lag such as 90 seconds
+// or 1 hour, or -1 if it is not a duration.
+func lagSeconds(lag string) int {
+	f := strings.Fields(strings.ToUpper(lag))
+	if len(f) != 2 {
+		return -1
+	}
+	n, err := strconv.Atoi(f[0])
+	unit, ok := lagUnits[strings.TrimSuffix(f[1], "S")]
+	if err != nil || !ok {
+		return -1
+	}
+	return n * unit
+}
+
+// SameTargetLag reports whether two target lags are equal, e.g. 60 minutes
+// and 1 hour, as Snowflake may report a lag in another unit.
+func SameTargetLag(a, b string) bool {
+	if sa, sb := lagSeconds(a), lagSeconds(b); sa >= 0 || sb >= 0 {
+		return sa == sb
+	}
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+// SameDynamicTableQuery reports whether the CREATE statement reported by
+// Snowflake ends with the query of p.
+func SameDynamicTableQuery(p *tablev1alpha1.DynamicTableParameters, text string) bool {
+	text, query := NormalizeSQL(text), NormalizeSQL(p.Query)
+	if !strings.HasSuffix(text, query) {
+		return false
+	}
+	return strings.HasSuffix(strings.ToUpper(strings.TrimSuffix(text, query)), " AS ")
+}
+
+// targetLag renders the target lag of p, quoting durations.
+func targetLag(p *tablev1alpha1.DynamicTableParameters) string {
+	if strings.EqualFold(p.TargetLag, "DOWNSTREAM") {
+		return "DOWNSTREAM"
+	}
+	return QuoteString(p.TargetLag)
+}
+
+// FetchDynamicTable returns the observed state of a dynamic table along with
+// its last completed refresh, or ErrNotFound.
+func (c ClientInfo) FetchDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) (tablev1alpha1.DynamicTableObservation, error) {
+	row, err := c.showObject(ctx, "DYNAMIC TABLES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return tablev1alpha1.DynamicTableObservation{}, err
+	}
+
+	obs := tablev1alpha1.DynamicTableObservation{
+		SchedulingState:   row["scheduling_state"],
+		TargetLag:         row["target_lag"],
+		Warehouse:         row["warehouse"],
+		RefreshMode:       row["refresh_mode"],
+		RefreshModeReason: nullable(row["refresh_mode_reason"]),
+		Text:              row["text"],
+		DataTimestamp:     row["data_timestamp"],
+		Comment:           row["comment"],
+		Owner:             row["owner"],
+		CreatedOn:         row["created_on"],
+	}
+
+	// the refresh history also lists the next refresh, yet to run
+	rows, err := c.ExecuteStatement(ctx, fmt.Sprintf(
+		"SELECT REFRESH_END_TIME, STATE, STATE_MESSAGE FROM TABLE(%s.INFORMATION_SCHEMA.DYNAMIC_TABLE_REFRESH_HISTORY(NAME => %s)) "+
+			"WHERE STATE NOT IN ('SCHEDULED', 'EXECUTING') ORDER BY DATA_TIMESTAMP DESC LIMIT 1",
+		QuoteIdentifier(p.Database), QuoteString(DynamicTableName(p))))
+	if err != nil {
+		return tablev1alpha1.DynamicTableObservation{}, err
+	}
+	if len(rows) > 0 {
+		obs.LastRefreshedOn = rows[0]["REFRESH_END_TIME"]
+		obs.LastRefreshState = rows[0]["STATE"]
+		obs.LastRefreshMessage = rows[0]["STATE_MESSAGE"]
+	}
+	return obs, nil
+}
+
+func createDynamicTableSQL(p *tablev1alpha1.DynamicTableParameters, replace bool) string {
+	var b strings.Builder
+
+	b.WriteString("CREATE ")
+	if replace {
+		b.WriteString("OR REPLACE ")
+	}
+	b.WriteString("DYNAMIC TABLE " + DynamicTableName(p))
+	b.WriteString(" TARGET_LAG = " + targetLag(p))
+	b.WriteString(" WAREHOUSE = " + QuoteIdentifier(p.Warehouse))
+	if p.RefreshMode != "" {
+		b.WriteString(" REFRESH_MODE = " + p.RefreshMode)
+	}
+	if p.Initialize != "" {
+		b.WriteString(" INITIALIZE = " + p.Initialize)
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+	b.WriteString(" AS " + p.Query)
+	return b.String()
+}
+
+// CreateDynamicTable creates a dynamic table. New dynamic tables are always
+// active.
+func (c ClientInfo) CreateDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) error {
+	_, err := c.ExecuteStatement(ctx, createDynamicTableSQL(p, false))
+	return err
+}
+
+// ReplaceDynamicTable recreates a dynamic table with the query of p. Its
+// content is dropped and initialized again.
+func (c ClientInfo) ReplaceDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) error {
+	_, err := c.ExecuteStatement(ctx, createDynamicTableSQL(p, true))
+	return err
+}
+
+// UpdateDynamicTable brings the target lag, warehouse and comment of a dynamic
+// table in line with p.
+func (c ClientInfo) UpdateDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters, obs tablev1alpha1.DynamicTableObservation) error {
+	var set []string
+	if !SameTargetLag(p.TargetLag, obs.TargetLag) {
+		set = append(set, "TARGET_LAG = "+targetLag(p))
+	}
+	if !strings.EqualFold(p.Warehouse, obs.Warehouse) {
+		set = append(set, "WAREHOUSE = "+QuoteIdentifier(p.Warehouse))
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		set = append(set, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	_, err := c.ExecuteStatement(ctx, "ALTER DYNAMIC TABLE "+DynamicTableName(p)+" SET "+strings.Join(set, " "))
+	return err
+}
+
+// SuspendDynamicTable suspends the refreshes of the dynamic table with the
+// given fully qualified name.
+func (c ClientInfo) SuspendDynamicTable(ctx context.Context, name string) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER DYNAMIC TABLE "+name+" SUSPEND")
+	return err
+}
+
+// ResumeDynamicTable resumes the refreshes of the dynamic table with the
+// given fully qualified name.
+func (c ClientInfo) ResumeDynamicTable(ctx context.Context, name string) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER DYNAMIC TABLE "+name+" RESUME")
+	return err
+}
+
+// DeleteDynamicTable drops a dynamic table.
+func (c ClientInfo) DeleteDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP DYNAMIC TABLE IF EXISTS "+DynamicTableName(p))
+	return err
+}
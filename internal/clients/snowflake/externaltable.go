@@ -0,0 +1,100 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	stagev1alpha1 "github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1"
+	tablev1alpha1 "github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+)
+
+func externalTableName(p *tablev1alpha1.ExternalTableParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchExternalTable returns the observed state of an external table, or
+// ErrNotFound.
+func (c ClientInfo) FetchExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) (tablev1alpha1.ExternalTableObservation, error) {
+	row, err := c.showObject(ctx, "EXTERNAL TABLES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return tablev1alpha1.ExternalTableObservation{}, err
+	}
+
+	return tablev1alpha1.ExternalTableObservation{
+		Stage:               row["stage"],
+		Location:            row["location"],
+		FileFormatName:      row["file_format_name"],
+		FileFormatType:      row["file_format_type"],
+		NotificationChannel: nullable(row["notification_channel"]),
+		LastRefreshedOn:     row["last_refreshed_on"],
+		LastRefreshDetails:  row["last_refresh_details"],
+		Invalid:             strings.EqualFold(row["invalid"], "true"),
+		InvalidReason:       nullable(row["invalid_reason"]),
+		Comment:             row["comment"],
+		Owner:               row["owner"],
+		CreatedOn:           row["created_on"],
+	}, nil
+}
+
+// CreateExternalTable creates an external table.
+func (c ClientInfo) CreateExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE EXTERNAL TABLE " + externalTableName(p))
+	if len(p.Columns) > 0 {
+		cols := make([]string, len(p.Columns))
+		for i, col := range p.Columns {
+			cols[i] = QuoteIdentifier(col.Name) + " " + col.Type + " AS (" + col.Expression + ")"
+		}
+		b.WriteString(" (" + strings.Join(cols, ", ") + ")")
+	}
+	if len(p.PartitionBy) > 0 {
+		b.WriteString(" PARTITION BY (" + IdentifierList(p.PartitionBy) + ")")
+	}
+
+	location := "@" + QualifiedName(SplitQualifiedName(p.Stage)...)
+	if p.Path != nil {
+		location += "/" + strings.TrimPrefix(*p.Path, "/")
+	}
+	b.WriteString(" LOCATION = " + location)
+
+	if p.Integration != nil {
+		b.WriteString(" INTEGRATION = " + QuoteString(*p.Integration))
+	}
+	if p.RefreshOnCreate != nil {
+		b.WriteString(" REFRESH_ON_CREATE = " + FormatBool(*p.RefreshOnCreate))
+	}
+	if p.AutoRefresh != nil {
+		b.WriteString(" AUTO_REFRESH = " + FormatBool(*p.AutoRefresh))
+	}
+	if p.Pattern != nil {
+		b.WriteString(" PATTERN = " + QuoteString(*p.Pattern))
+	}
+	ff, err := stageFileFormat((*stagev1alpha1.StageFileFormat)(&p.FileFormat))
+	if err != nil {
+		return err
+	}
+	b.WriteString(" FILE_FORMAT = (" + ff + ")")
+	if p.AWSSNSTopic != nil {
+		b.WriteString(" AWS_SNS_TOPIC = " + QuoteString(*p.AWSSNSTopic))
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+
+	_, err = c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// RefreshExternalTable synchronizes the metadata of an external table with
+// the files in its location.
+func (c ClientInfo) RefreshExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER EXTERNAL TABLE "+externalTableName(p)+" REFRESH")
+	return err
+}
+
+// DeleteExternalTable drops an external table. The files stay in place.
+func (c ClientInfo) DeleteExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP EXTERNAL TABLE IF EXISTS "+externalTableName(p))
+	return err
+}
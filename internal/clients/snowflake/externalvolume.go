@@ -0,0 +1,246 @@
+package snowflake
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	externalvolumev1alpha1 "github.com/allenkallz/provider-snowflake/apis/externalvolume/v1alpha1"
+)
+
+// storageLocation is a storage location as DESC EXTERNAL VOLUME reports it.
+type storageLocation struct {
+	Name                     string `json:"NAME"`
+	StorageProvider          string `json:"STORAGE_PROVIDER"`
+	StorageBaseURL           string `json:"STORAGE_BASE_URL"`
+	StorageAWSRoleARN        string `json:"STORAGE_AWS_ROLE_ARN"`
+	StorageAWSIAMUserARN     string `json:"STORAGE_AWS_IAM_USER_ARN"`
+	StorageAWSExternalID     string `json:"STORAGE_AWS_EXTERNAL_ID"`
+	StorageGCPServiceAccount string `json:"STORAGE_GCP_SERVICE_ACCOUNT"`
+	AzureTenantID            string `json:"AZURE_TENANT_ID"`
+	AzureConsentURL          string `json:"AZURE_CONSENT_URL"`
+	AzureMultiTenantAppName  string `json:"AZURE_MULTI_TENANT_APP_NAME"`
+	EncryptionType           string `json:"ENCRYPTION_TYPE"`
+	EncryptionKMSKeyID       string `json:"ENCRYPTION_KMS_KEY_ID"`
+}
+
+// storageLocations decodes the STORAGE_LOCATION_<n> properties of an external
+// volume, in the order of n.
+func storageLocations(props map[string]string) ([]externalvolumev1alpha1.StorageLocationObservation, error) {
+	var locs []externalvolumev1alpha1.StorageLocationObservation
+	for n := 1; ; n++ {
+		v, ok := props["STORAGE_LOCATION_"+strconv.Itoa(n)]
+		if !ok {
+			return locs, nil
+		}
+		var l storageLocation
+		if err := json.Unmarshal([]byte(v), &l); err != nil {
+			return nil, errors.Wrap(err, "cannot decode storage location")
+		}
+		locs = append(locs, externalvolumev1alpha1.StorageLocationObservation(l))
+	}
+}
+
+// StorageLocationUpToDate reports whether the properties set in l match the
+// observed location.
+func StorageLocationUpToDate(l externalvolumev1alpha1.StorageLocation, obs externalvolumev1alpha1.StorageLocationObservation) bool {
+	if !strings.EqualFold(l.StorageProvider, obs.StorageProvider) || l.StorageBaseURL != obs.StorageBaseURL {
+		return false
+	}
+	if l.StorageAWSRoleARN != nil && *l.StorageAWSRoleARN != obs.StorageAWSRoleARN {
+		return false
+	}
+	if l.StorageAWSExternalID != nil && *l.StorageAWSExternalID != obs.StorageAWSExternalID {
+		return false
+	}
+	if l.AzureTenantID != nil && *l.AzureTenantID != obs.AzureTenantID {
+		return false
+	}
+	if e := l.Encryption; e != nil {
+		if !strings.EqualFold(e.Type, obs.EncryptionType) || e.KMSKeyID != nil && *e.KMSKeyID != obs.EncryptionKMSKeyID {
+			return false
+		}
+	}
+	return true
+}
+
+// storageLocationChanges returns the locations of p that are missing from the
+// observed ones or differ from them, and the names of the observed locations
+// that are no longer in p or differ from it.
+func storageLocationChanges(p *externalvolumev1alpha1.ExternalVolumeParameters, observed []externalvolumev1alpha1.StorageLocationObservation) (added []externalvolumev1alpha1.StorageLocation, removed []string) {
+	have := make(map[string]externalvolumev1alpha1.StorageLocationObservation, len(observed))
+	for _, l := range observed {
+		have[strings.ToUpper(l.Name)] = l
+	}
+	want := make(map[string]bool, len(p.StorageLocations))
+	for _, l := range p.StorageLocations {
+		want[strings.ToUpper(l.Name)] = true
+		obs, ok := have[strings.ToUpper(l.Name)]
+		switch {
+		case !ok:
+			added = append(added, l)
+		case !StorageLocationUpToDate(l, obs):
+			added = append(added, l)
+			removed = append(removed, obs.Name)
+		}
+	}
+	for _, l := range observed {
+		if !want[strings.ToUpper(l.Name)] {
+			removed = append(removed, l.Name)
+		}
+	}
+	return added, removed
+}
+
+// StorageLocationsUpToDate reports whether the observed storage locations of a
+// volume are the ones of p.
+func StorageLocationsUpToDate(p *externalvolumev1alpha1.ExternalVolumeParameters, observed []externalvolumev1alpha1.StorageLocationObservation) bool {
+	added, removed := storageLocationChanges(p, observed)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// storageLocationSQL renders a storage location as a parenthesized list of
+// its properties.
+func storageLocationSQL(l externalvolumev1alpha1.StorageLocation) string {
+	props := []string{
+		"NAME = " + QuoteString(l.Name),
+		"STORAGE_PROVIDER = " + QuoteString(l.StorageProvider),
+		"STORAGE_BASE_URL = " + QuoteString(l.StorageBaseURL),
+	}
+	if l.StorageAWSRoleARN != nil {
+		props = append(props, "STORAGE_AWS_ROLE_ARN = "+QuoteString(*l.StorageAWSRoleARN))
+	}
+	if l.StorageAWSExternalID != nil {
+		props = append(props, "STORAGE_AWS_EXTERNAL_ID = "+QuoteString(*l.StorageAWSExternalID))
+	}
+	if l.AzureTenantID != nil {
+		props = append(props, "AZURE_TENANT_ID = "+QuoteString(*l.AzureTenantID))
+	}
+	if e := l.Encryption; e != nil {
+		enc := "TYPE = " + QuoteString(e.Type)
+		if e.KMSKeyID != nil {
+			enc += " KMS_KEY_ID = " + QuoteString(*e.KMSKeyID)
+		}
+		props = append(props, "ENCRYPTION = ("+enc+")")
+	}
+	return "(" + strings.Join(props, " ") + ")"
+}
+
+// FetchExternalVolume returns the observed state of an external volume, or
+// ErrNotFound.
+func (c ClientInfo) FetchExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters) (externalvolumev1alpha1.ExternalVolumeObservation, error) {
+	row, err := c.showObject(ctx, "EXTERNAL VOLUMES", p.Name, "")
+	if err != nil {
+		return externalvolumev1alpha1.ExternalVolumeObservation{}, err
+	}
+
+	props, err := c.describeObject(ctx, "EXTERNAL VOLUME", QuoteIdentifier(p.Name))
+	if err != nil {
+		return externalvolumev1alpha1.ExternalVolumeObservation{}, err
+	}
+	locs, err := storageLocations(props)
+	if err != nil {
+		return externalvolumev1alpha1.ExternalVolumeObservation{}, err
+	}
+
+	return externalvolumev1alpha1.ExternalVolumeObservation{
+		StorageLocations: locs,
+		Active:           props["ACTIVE"],
+		AllowWrites:      strings.EqualFold(props["ALLOW_WRITES"], "true"),
+		Comment:          row["comment"],
+		Owner:            row["owner"],
+		CreatedOn:        row["created_on"],
+	}, nil
+}
+
+// CreateExternalVolume creates an external volume.
+func (c ClientInfo) CreateExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters) error {
+	locs := make([]string, len(p.StorageLocations))
+	for i, l := range p.StorageLocations {
+		locs[i] = storageLocationSQL(l)
+	}
+
+	var b strings.Builder
+	b.WriteString("CREATE EXTERNAL VOLUME " + QuoteIdentifier(p.Name))
+	b.WriteString(" STORAGE_LOCATIONS = (" + strings.Join(locs, ", ") + ")")
+	if p.AllowWrites != nil {
+		b.WriteString(" ALLOW_WRITES = " + FormatBool(*p.AllowWrites))
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// replacingSuffix names the temporary location a changed storage location is
+// added under while it is replaced.
+const replacingSuffix = "_REPLACING"
+
+// UpdateExternalVolume brings an external volume in line with p. Storage
+// locations are added before the old ones are removed, as a volume cannot be
+// left without any. Names must be unique, so a changed location is first added
+// under a temporary name, then removed and added again, after which the
+// temporary location is removed.
+func (c ClientInfo) UpdateExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters, obs externalvolumev1alpha1.ExternalVolumeObservation) error {
+	alter := "ALTER EXTERNAL VOLUME " + QuoteIdentifier(p.Name)
+	added, removed := storageLocationChanges(p, obs.StorageLocations)
+
+	have := make(map[string]bool, len(obs.StorageLocations))
+	for _, l := range obs.StorageLocations {
+		have[strings.ToUpper(l.Name)] = true
+	}
+
+	var adds, removes, readds, temps []string
+	temporary := map[string]bool{}
+	for _, l := range added {
+		if !have[strings.ToUpper(l.Name)] {
+			adds = append(adds, alter+" ADD STORAGE_LOCATION = "+storageLocationSQL(l))
+			continue
+		}
+		tmp := l
+		tmp.Name += replacingSuffix
+		temporary[strings.ToUpper(tmp.Name)] = true
+		// a previous update may have failed after adding the temporary
+		// location
+		if !have[strings.ToUpper(tmp.Name)] {
+			adds = append(adds, alter+" ADD STORAGE_LOCATION = "+storageLocationSQL(tmp))
+		}
+		readds = append(readds, alter+" ADD STORAGE_LOCATION = "+storageLocationSQL(l))
+		temps = append(temps, alter+" REMOVE STORAGE_LOCATION "+QuoteString(tmp.Name))
+	}
+	for _, n := range removed {
+		if !temporary[strings.ToUpper(n)] {
+			removes = append(removes, alter+" REMOVE STORAGE_LOCATION "+QuoteString(n))
+		}
+	}
+	stmts := append(append(append(adds, removes...), readds...), temps...)
+
+	var set []string
+	if p.AllowWrites != nil && *p.AllowWrites != obs.AllowWrites {
+		set = append(set, "ALLOW_WRITES = "+FormatBool(*p.AllowWrites))
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		set = append(set, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	if len(set) > 0 {
+		stmts = append(stmts, alter+" SET "+strings.Join(set, " "))
+	}
+
+	for _, stmt := range stmts {
+		if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteExternalVolume drops an external volume.
+func (c ClientInfo) DeleteExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP EXTERNAL VOLUME IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
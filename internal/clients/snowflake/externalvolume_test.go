@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	externalvolumev1alpha1 "github.com/allenkallz/provider-snowflake/apis/externalvolume/v1alpha1"
+)
+
+func TestUpdateExternalVolume(t *testing.T) {
+	gcs := func(name, url string) externalvolumev1alpha1.StorageLocation {
+		return externalvolumev1alpha1.StorageLocation{Name: name, StorageProvider: "GCS", StorageBaseURL: url}
+	}
+	observed := func(name, url string) externalvolumev1alpha1.StorageLocationObservation {
+		return externalvolumev1alpha1.StorageLocationObservation{Name: name, StorageProvider: "GCS", StorageBaseURL: url}
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      externalvolumev1alpha1.ExternalVolumeParameters
+		obs    externalvolumev1alpha1.ExternalVolumeObservation
+		want   []string
+	}{
+		"Added": {
+			reason: "New locations should be added before old ones are removed.",
+			p: externalvolumev1alpha1.ExternalVolumeParameters{Name: "lake", StorageLocations: []externalvolumev1alpha1.StorageLocation{
+				gcs("secondary", "gcs://secondary/"),
+			}},
+			obs: externalvolumev1alpha1.ExternalVolumeObservation{StorageLocations: []externalvolumev1alpha1.StorageLocationObservation{
+				observed("primary", "gcs://primary/"),
+			}},
+			want: []string{
+				"ALTER EXTERNAL VOLUME lake ADD STORAGE_LOCATION = (NAME = 'secondary' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://secondary/')",
+				"ALTER EXTERNAL VOLUME lake REMOVE STORAGE_LOCATION 'primary'",
+			},
+		},
+		"Changed": {
+			reason: "A changed location should be replaced through a temporary location, so that the volume is never left without one.",
+			p: externalvolumev1alpha1.ExternalVolumeParameters{Name: "lake", StorageLocations: []externalvolumev1alpha1.StorageLocation{
+				gcs("primary", "gcs://moved/"),
+			}},
+			obs: externalvolumev1alpha1.ExternalVolumeObservation{StorageLocations: []externalvolumev1alpha1.StorageLocationObservation{
+				observed("primary", "gcs://primary/"),
+			}},
+			want: []string{
+				"ALTER EXTERNAL VOLUME lake ADD STORAGE_LOCATION = (NAME = 'primary_REPLACING' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://moved/')",
+				"ALTER EXTERNAL VOLUME lake REMOVE STORAGE_LOCATION 'primary'",
+				"ALTER EXTERNAL VOLUME lake ADD STORAGE_LOCATION = (NAME = 'primary' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://moved/')",
+				"ALTER EXTERNAL VOLUME lake REMOVE STORAGE_LOCATION 'primary_REPLACING'",
+			},
+		},
+		"ChangedRetried": {
+			reason: "A temporary location left by a failed update should be reused.",
+			p: externalvolumev1alpha1.ExternalVolumeParameters{Name: "lake", StorageLocations: []externalvolumev1alpha1.StorageLocation{
+				gcs("primary", "gcs://moved/"),
+			}},
+			obs: externalvolumev1alpha1.ExternalVolumeObservation{StorageLocations: []externalvolumev1alpha1.StorageLocationObservation{
+				observed("primary", "gcs://primary/"),
+				observed("primary_REPLACING", "gcs://moved/"),
+			}},
+			want: []string{
+				"ALTER EXTERNAL VOLUME lake REMOVE STORAGE_LOCATION 'primary'",
+				"ALTER EXTERNAL VOLUME lake ADD STORAGE_LOCATION = (NAME = 'primary' STORAGE_PROVIDER = 'GCS' STORAGE_BASE_URL = 'gcs://moved/')",
+				"ALTER EXTERNAL VOLUME lake REMOVE STORAGE_LOCATION 'primary_REPLACING'",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.UpdateExternalVolume(context.Background(), &tc.p, tc.obs); err != nil {
+				t.Fatalf("\n%s\nc.UpdateExternalVolume(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.UpdateExternalVolume(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
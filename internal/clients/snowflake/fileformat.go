@@ -0,0 +1,358 @@
+package snowflake
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	ffv1alpha1 "github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1"
+)
+
+// FileFormatInfo is the state of a file format as reported by Snowflake.
+type FileFormatInfo struct {
+	Type      string
+	Comment   string
+	Owner     string
+	CreatedOn string
+
+	// format options decoded from the format_options column, in the JSON
+	// representation Snowflake uses there
+	Options map[string]interface{}
+}
+
+// formatOptions holds file format options keyed by their SQL name, using the
+// same value types as the decoded format_options column: bool, float64,
+// string and []string.
+type formatOptions map[string]interface{}
+
+func (o formatOptions) str(key string, v *string) {
+	if v != nil {
+		o[key] = *v
+	}
+}
+
+func (o formatOptions) boolean(key string, v *bool) {
+	if v != nil {
+		o[key] = *v
+	}
+}
+
+func (o formatOptions) integer(key string, v *int) {
+	if v != nil {
+		o[key] = float64(*v)
+	}
+}
+
+func (o formatOptions) list(key string, v []string) {
+	if v != nil {
+		o[key] = v
+	}
+}
+
+// keywordFormatOptions take a bare keyword rather than a string literal.
+var keywordFormatOptions = map[string]bool{
+	"COMPRESSION":   true,
+	"BINARY_FORMAT": true,
+}
+
+// formatOptionDefaults are the Snowflake defaults options are reset to once
+// they are removed from the spec.
+var formatOptionDefaults = map[string]interface{}{
+	"COMPRESSION":                    "AUTO",
+	"RECORD_DELIMITER":               "\n",
+	"FIELD_DELIMITER":                ",",
+	"FILE_EXTENSION":                 "NONE",
+	"PARSE_HEADER":                   false,
+	"SKIP_HEADER":                    float64(0),
+	"SKIP_BLANK_LINES":               false,
+	"DATE_FORMAT":                    "AUTO",
+	"TIME_FORMAT":                    "AUTO",
+	"TIMESTAMP_FORMAT":               "AUTO",
+	"BINARY_FORMAT":                  "HEX",
+	"ESCAPE":                         "NONE",
+	"ESCAPE_UNENCLOSED_FIELD":        `\`,
+	"TRIM_SPACE":                     false,
+	"FIELD_OPTIONALLY_ENCLOSED_BY":   "NONE",
+	"NULL_IF":                        []string{`\N`},
+	"ERROR_ON_COLUMN_COUNT_MISMATCH": true,
+	"REPLACE_INVALID_CHARACTERS":     false,
+	"EMPTY_FIELD_AS_NULL":            true,
+	"SKIP_BYTE_ORDER_MARK":           true,
+	"ENCODING":                       "UTF8",
+	"ENABLE_OCTAL":                   false,
+	"ALLOW_DUPLICATE":                false,
+	"STRIP_OUTER_ARRAY":              false,
+	"STRIP_NULL_VALUES":              false,
+	"IGNORE_UTF8_ERRORS":             false,
+	"BINARY_AS_TEXT":                 true,
+	"USE_LOGICAL_TYPE":               false,
+	"USE_VECTORIZED_SCANNER":         false,
+	"PRESERVE_SPACE":                 false,
+	"STRIP_OUTER_ELEMENT":            false,
+	"DISABLE_SNOWFLAKE_DATA":         false,
+	"DISABLE_AUTO_CONVERT":           false,
+}
+
+// FileFormatOptions returns the options set in the type specific options
+// block of p. Options that are not set keep their Snowflake default.
+func FileFormatOptions(p *ffv1alpha1.FileFormatParameters) map[string]interface{} { //nolint:gocyclo // flat list of options
+	o := formatOptions{}
+
+	if c := p.CSV; c != nil {
+		o.str("COMPRESSION", c.Compression)
+		o.str("RECORD_DELIMITER", c.RecordDelimiter)
+		o.str("FIELD_DELIMITER", c.FieldDelimiter)
+		o.str("FILE_EXTENSION", c.FileExtension)
+		o.boolean("PARSE_HEADER", c.ParseHeader)
+		o.integer("SKIP_HEADER", c.SkipHeader)
+		o.boolean("SKIP_BLANK_LINES", c.SkipBlankLines)
+		o.str("DATE_FORMAT", c.DateFormat)
+		o.str("TIME_FORMAT", c.TimeFormat)
+		o.str("TIMESTAMP_FORMAT", c.TimestampFormat)
+		o.str("BINARY_FORMAT", c.BinaryFormat)
+		o.str("ESCAPE", c.Escape)
+		o.str("ESCAPE_UNENCLOSED_FIELD", c.EscapeUnenclosedField)
+		o.boolean("TRIM_SPACE", c.TrimSpace)
+		o.str("FIELD_OPTIONALLY_ENCLOSED_BY", c.FieldOptionallyEnclosedBy)
+		o.list("NULL_IF", c.NullIf)
+		o.boolean("ERROR_ON_COLUMN_COUNT_MISMATCH", c.ErrorOnColumnCountMismatch)
+		o.boolean("REPLACE_INVALID_CHARACTERS", c.ReplaceInvalidCharacters)
+		o.boolean("EMPTY_FIELD_AS_NULL", c.EmptyFieldAsNull)
+		o.boolean("SKIP_BYTE_ORDER_MARK", c.SkipByteOrderMark)
+		o.str("ENCODING", c.Encoding)
+	}
+
+	if j := p.JSON; j != nil {
+		o.str("COMPRESSION", j.Compression)
+		o.str("DATE_FORMAT", j.DateFormat)
+		o.str("TIME_FORMAT", j.TimeFormat)
+		o.str("TIMESTAMP_FORMAT", j.TimestampFormat)
+		o.str("BINARY_FORMAT", j.BinaryFormat)
+		o.boolean("TRIM_SPACE", j.TrimSpace)
+		o.list("NULL_IF", j.NullIf)
+		o.str("FILE_EXTENSION", j.FileExtension)
+		o.boolean("ENABLE_OCTAL", j.EnableOctal)
+		o.boolean("ALLOW_DUPLICATE", j.AllowDuplicate)
+		o.boolean("STRIP_OUTER_ARRAY", j.StripOuterArray)
+		o.boolean("STRIP_NULL_VALUES", j.StripNullValues)
+		o.boolean("REPLACE_INVALID_CHARACTERS", j.ReplaceInvalidCharacters)
+		o.boolean("IGNORE_UTF8_ERRORS", j.IgnoreUTF8Errors)
+		o.boolean("SKIP_BYTE_ORDER_MARK", j.SkipByteOrderMark)
+	}
+
+	if a := p.Avro; a != nil {
+		o.str("COMPRESSION", a.Compression)
+		o.boolean("TRIM_SPACE", a.TrimSpace)
+		o.boolean("REPLACE_INVALID_CHARACTERS", a.ReplaceInvalidCharacters)
+		o.list("NULL_IF", a.NullIf)
+	}
+
+	if r := p.ORC; r != nil {
+		o.boolean("TRIM_SPACE", r.TrimSpace)
+		o.boolean("REPLACE_INVALID_CHARACTERS", r.ReplaceInvalidCharacters)
+		o.list("NULL_IF", r.NullIf)
+	}
+
+	if q := p.Parquet; q != nil {
+		o.str("COMPRESSION", q.Compression)
+		o.boolean("BINARY_AS_TEXT", q.BinaryAsText)
+		o.boolean("USE_LOGICAL_TYPE", q.UseLogicalType)
+		o.boolean("USE_VECTORIZED_SCANNER", q.UseVectorizedScanner)
+		o.boolean("TRIM_SPACE", q.TrimSpace)
+		o.boolean("REPLACE_INVALID_CHARACTERS", q.ReplaceInvalidCharacters)
+		o.list("NULL_IF", q.NullIf)
+	}
+
+	if x := p.XML; x != nil {
+		o.str("COMPRESSION", x.Compression)
+		o.boolean("IGNORE_UTF8_ERRORS", x.IgnoreUTF8Errors)
+		o.boolean("PRESERVE_SPACE", x.PreserveSpace)
+		o.boolean("STRIP_OUTER_ELEMENT", x.StripOuterElement)
+		o.boolean("DISABLE_SNOWFLAKE_DATA", x.DisableSnowflakeData)
+		o.boolean("DISABLE_AUTO_CONVERT", x.DisableAutoConvert)
+		o.boolean("REPLACE_INVALID_CHARACTERS", x.ReplaceInvalidCharacters)
+		o.boolean("SKIP_BYTE_ORDER_MARK", x.SkipByteOrderMark)
+	}
+
+	return o
+}
+
+// FileFormatOptionsUpToDate reports whether every option set in p matches the
+// observed options. Values are compared in normalized form, so keywords such
+// as NONE and AUTO match regardless of case and numbers match regardless of
+// how Snowflake encodes them.
+func FileFormatOptionsUpToDate(p *ffv1alpha1.FileFormatParameters, observed map[string]interface{}) bool {
+	for k, want := range FileFormatOptions(p) {
+		if !formatOptionEqual(k, want, observed[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// FileFormatOptionNames returns the sorted names of the options set in p.
+func FileFormatOptionNames(p *ffv1alpha1.FileFormatParameters) []string {
+	opts := FileFormatOptions(p)
+	names := make([]string, 0, len(opts))
+	for k := range opts {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FileFormatNonDefaultOptions returns the sorted names of the observed options
+// that differ from their Snowflake defaults.
+func FileFormatNonDefaultOptions(observed map[string]interface{}) []string {
+	names := []string{}
+	for k, d := range formatOptionDefaults {
+		if v, ok := observed[k]; ok && !formatOptionEqual(k, d, v) {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func formatOptionEqual(key string, want, got interface{}) bool {
+	switch w := want.(type) {
+	case bool:
+		g, ok := got.(bool)
+		return ok && g == w
+	case float64:
+		g, ok := got.(float64)
+		return ok && g == w
+	case []string:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return len(w) == 0 && got == nil
+		}
+		for i := range w {
+			if s, ok := g[i].(string); !ok || s != w[i] {
+				return false
+			}
+		}
+		return true
+	case string:
+		g, ok := got.(string)
+		if !ok {
+			return false
+		}
+		if isFormatKeyword(key, w) {
+			return strings.EqualFold(g, w)
+		}
+		return g == w
+	}
+	return false
+}
+
+func isFormatKeyword(key, v string) bool {
+	return keywordFormatOptions[key] || strings.EqualFold(v, "NONE") || strings.EqualFold(v, "AUTO")
+}
+
+// formatOptionsSQL renders options as KEY = value pairs sorted by key.
+func formatOptionsSQL(opts map[string]interface{}) string {
+	keys := make([]string, 0, len(opts))
+	for k := range opts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		var v string
+		switch o := opts[k].(type) {
+		case bool:
+			v = FormatBool(o)
+		case float64:
+			v = strconv.FormatFloat(o, 'f', -1, 64)
+		case []string:
+			v = QuoteStringList(o)
+		case string:
+			if isFormatKeyword(k, o) {
+				v = strings.ToUpper(o)
+			} else {
+				v = QuoteString(o)
+			}
+		}
+		pairs = append(pairs, k+" = "+v)
+	}
+	return strings.Join(pairs, " ")
+}
+
+func fileFormatName(p *ffv1alpha1.FileFormatParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchFileFormat returns the state of a file format, or ErrNotFound.
+func (c ClientInfo) FetchFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters) (FileFormatInfo, error) {
+	row, err := c.showObject(ctx, "FILE FORMATS", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return FileFormatInfo{}, err
+	}
+
+	info := FileFormatInfo{
+		Type:      row["type"],
+		Comment:   row["comment"],
+		Owner:     row["owner"],
+		CreatedOn: row["created_on"],
+		Options:   map[string]interface{}{},
+	}
+
+	if fo := row["format_options"]; fo != "" {
+		if err := json.Unmarshal([]byte(fo), &info.Options); err != nil {
+			return FileFormatInfo{}, errors.Wrap(err, "cannot decode file format options")
+		}
+	}
+	return info, nil
+}
+
+// CreateFileFormat creates a file format.
+func (c ClientInfo) CreateFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters) error {
+	stmt := "CREATE FILE FORMAT " + fileFormatName(p) + " TYPE = " + p.Type
+	if opts := formatOptionsSQL(FileFormatOptions(p)); opts != "" {
+		stmt += " " + opts
+	}
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateFileFormat sets the options and comment of a file format. Options in
+// nonDefault that p does not set are reset to their defaults, as file formats
+// cannot unset options.
+func (c ClientInfo) UpdateFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters, nonDefault []string) error {
+	opts := FileFormatOptions(p)
+	for _, k := range nonDefault {
+		if _, ok := opts[k]; ok {
+			continue
+		}
+		if d, ok := formatOptionDefaults[k]; ok {
+			opts[k] = d
+		}
+	}
+
+	set := formatOptionsSQL(opts)
+	if p.Comment != nil {
+		set = strings.TrimSpace(set + " COMMENT = " + QuoteString(*p.Comment))
+	}
+	if set == "" {
+		return nil
+	}
+
+	_, err := c.ExecuteStatement(ctx, "ALTER FILE FORMAT "+fileFormatName(p)+" SET "+set)
+	return err
+}
+
+// DeleteFileFormat drops a file format.
+func (c ClientInfo) DeleteFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP FILE FORMAT IF EXISTS "+fileFormatName(p))
+	return err
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	ffv1alpha1 "github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1"
+)
+
+func TestUpdateFileFormat(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		p          ffv1alpha1.FileFormatParameters
+		nonDefault []string
+		want       []string
+	}{
+		"Set": {
+			reason: "The options and comment of the spec should be set.",
+			p: ffv1alpha1.FileFormatParameters{
+				Database: "RAW", Schema: "PUBLIC", Name: "csv", Type: "CSV",
+				CSV:     &ffv1alpha1.CSVOptions{FieldDelimiter: ptr.To("|"), SkipHeader: ptr.To(1)},
+				Comment: ptr.To("pipe separated"),
+			},
+			nonDefault: []string{"FIELD_DELIMITER", "SKIP_HEADER"},
+			want:       []string{"ALTER FILE FORMAT RAW.PUBLIC.csv SET FIELD_DELIMITER = '|' SKIP_HEADER = 1 COMMENT = 'pipe separated'"},
+		},
+		"Removed": {
+			reason: "Options that differ from their defaults but are not set in the spec should be reset.",
+			p: ffv1alpha1.FileFormatParameters{
+				Database: "RAW", Schema: "PUBLIC", Name: "csv", Type: "CSV",
+				CSV: &ffv1alpha1.CSVOptions{SkipHeader: ptr.To(1)},
+			},
+			nonDefault: []string{"COMPRESSION", "FIELD_DELIMITER", "NULL_IF", "SKIP_HEADER", "TRIM_SPACE"},
+			want:       []string{`ALTER FILE FORMAT RAW.PUBLIC.csv SET COMPRESSION = AUTO FIELD_DELIMITER = ',' NULL_IF = ('\\N') SKIP_HEADER = 1 TRIM_SPACE = FALSE`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.UpdateFileFormat(context.Background(), &tc.p, tc.nonDefault); err != nil {
+				t.Fatalf("\n%s\nc.UpdateFileFormat(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.UpdateFileFormat(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFileFormatNonDefaultOptions(t *testing.T) {
+	observed := map[string]interface{}{
+		"TYPE":             "CSV",
+		"COMPRESSION":      "auto",
+		"FIELD_DELIMITER":  "|",
+		"SKIP_HEADER":      float64(1),
+		"NULL_IF":          []interface{}{`\N`},
+		"TRIM_SPACE":       false,
+		"RECORD_DELIMITER": "\n",
+	}
+	want := []string{"FIELD_DELIMITER", "SKIP_HEADER"}
+	if diff := cmp.Diff(want, FileFormatNonDefaultOptions(observed)); diff != "" {
+		t.Errorf("FileFormatNonDefaultOptions(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,349 @@
+package snowflake
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	functionv1alpha1 "github.com/allenkallz/provider-snowflake/apis/function/v1alpha1"
+)
+
+// routine holds the fields functions and procedures share.
+type routine struct {
+	Arguments      []functionv1alpha1.FunctionArgument
+	ReturnType     string
+	Language       string
+	RuntimeVersion *string
+	Packages       []string
+	Imports        []string
+	Handler        *string
+	Body           *string
+}
+
+// routineObservation holds the observed fields functions and procedures
+// share.
+type routineObservation struct {
+	ReturnType     string
+	Language       string
+	RuntimeVersion string
+	Packages       []string
+	Imports        []string
+	Handler        string
+	Body           string
+}
+
+func functionRoutine(p *functionv1alpha1.FunctionParameters) routine {
+	return routine{
+		Arguments:      p.Arguments,
+		ReturnType:     p.ReturnType,
+		Language:       p.Language,
+		RuntimeVersion: p.RuntimeVersion,
+		Packages:       p.Packages,
+		Imports:        p.Imports,
+		Handler:        p.Handler,
+		Body:           p.Body,
+	}
+}
+
+func procedureRoutine(p *functionv1alpha1.ProcedureParameters) routine {
+	return routine{
+		Arguments:      p.Arguments,
+		ReturnType:     p.ReturnType,
+		Language:       p.Language,
+		RuntimeVersion: p.RuntimeVersion,
+		Packages:       p.Packages,
+		Imports:        p.Imports,
+		Handler:        p.Handler,
+		Body:           p.Body,
+	}
+}
+
+// routineIdentifier returns the name and argument types identifying a
+// function or procedure, e.g. DB.PUBLIC.NORMALIZE(VARCHAR, NUMBER).
+func routineIdentifier(database, schema, name string, args []functionv1alpha1.FunctionArgument) string {
+	types := make([]string, len(args))
+	for i, a := range args {
+		types[i] = a.Type
+	}
+	return QualifiedName(database, schema, name) + "(" + strings.Join(types, ", ") + ")"
+}
+
+// FunctionIdentifier returns the identifier of the function described by p,
+// used as its external name.
+func FunctionIdentifier(p *functionv1alpha1.FunctionParameters) string {
+	return routineIdentifier(p.Database, p.Schema, p.Name, p.Arguments)
+}
+
+// ProcedureIdentifier returns the identifier of the procedure described by p,
+// used as its external name.
+func ProcedureIdentifier(p *functionv1alpha1.ProcedureParameters) string {
+	return routineIdentifier(p.Database, p.Schema, p.Name, p.Arguments)
+}
+
+// SameIdentifier reports whether two function or procedure identifiers name
+// the same signature, ignoring case and spacing.
+func SameIdentifier(a, b string) bool {
+	strip := func(s string) string { return strings.Join(strings.Fields(s), "") }
+	return strings.EqualFold(strip(a), strip(b))
+}
+
+// dataTypeSynonyms maps SQL data types to the name Snowflake reports them by.
+var dataTypeSynonyms = map[string]string{
+	"STRING":    "VARCHAR",
+	"TEXT":      "VARCHAR",
+	"CHAR":      "VARCHAR",
+	"CHARACTER": "VARCHAR",
+	"INT":       "NUMBER",
+	"INTEGER":   "NUMBER",
+	"BIGINT":    "NUMBER",
+	"SMALLINT":  "NUMBER",
+	"TINYINT":   "NUMBER",
+	"BYTEINT":   "NUMBER",
+	"DECIMAL":   "NUMBER",
+	"NUMERIC":   "NUMBER",
+	"DOUBLE":    "FLOAT",
+	"REAL":      "FLOAT",
+	"FLOAT4":    "FLOAT",
+	"FLOAT8":    "FLOAT",
+	"DATETIME":  "TIMESTAMP_NTZ",
+}
+
+// typePrecision matches the length, precision and scale of data types.
+var typePrecision = regexp.MustCompile(`\(\d+(,\d+)?\)`)
+
+// SameDataType reports whether want, as written in a spec, matches the data
+// type Snowflake reports. Types written without precision match any
+// precision, e.g. VARCHAR matches VARCHAR(16777216) and TABLE (ID NUMBER)
+// matches TABLE (ID NUMBER(38,0)).
+func SameDataType(want, got string) bool {
+	norm := func(s string) string {
+		s = strings.ToUpper(strings.Join(strings.Fields(s), ""))
+		base := s
+		if i := strings.Index(s, "("); i >= 0 {
+			base = s[:i]
+		}
+		if syn, ok := dataTypeSynonyms[base]; ok {
+			s = syn + s[len(base):]
+		}
+		return s
+	}
+	w, g := norm(want), norm(got)
+	if !typePrecision.MatchString(w) {
+		g = typePrecision.ReplaceAllString(g, "")
+	}
+	return w == g
+}
+
+// unquoteList parses lists of quoted values of DESC output, e.g.
+// ['numpy','pandas'].
+func unquoteList(s string) []string {
+	items := splitList(s)
+	for i, item := range items {
+		items[i] = strings.Trim(item, `'"`)
+	}
+	return items
+}
+
+// describeRoutine returns the properties of DESC FUNCTION or DESC PROCEDURE,
+// keyed by property name.
+func (c ClientInfo) describeRoutine(ctx context.Context, objectType, identifier string) (map[string]string, error) {
+	rows, err := c.ExecuteStatement(ctx, "DESC "+objectType+" "+identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	props := make(map[string]string, len(rows))
+	for _, r := range rows {
+		props[r["property"]] = r["value"]
+	}
+	return props, nil
+}
+
+// routineUpToDate reports whether the observed fields of a function or
+// procedure match r.
+func routineUpToDate(r routine, obs routineObservation) bool {
+	language := r.Language
+	if language == "" {
+		language = "SQL"
+	}
+
+	switch {
+	case !SameDataType(r.ReturnType, obs.ReturnType),
+		!strings.EqualFold(language, obs.Language),
+		!SameNames(r.Packages, obs.Packages),
+		!SameNames(r.Imports, obs.Imports):
+		return false
+	case r.RuntimeVersion != nil && *r.RuntimeVersion != obs.RuntimeVersion,
+		r.Handler != nil && *r.Handler != obs.Handler,
+		r.Body != nil && NormalizeSQL(*r.Body) != NormalizeSQL(obs.Body):
+		return false
+	}
+	return true
+}
+
+// createRoutineSQL returns the CREATE statement of the function or procedure
+// with the given qualified name. Replacing statements copy the grants of the
+// replaced object.
+func createRoutineSQL(objectType, name string, r routine, executeAs string, replace bool) string {
+	args := make([]string, len(r.Arguments))
+	for i, a := range r.Arguments {
+		args[i] = QuoteIdentifier(a.Name) + " " + a.Type
+	}
+
+	stmt := "CREATE "
+	if replace {
+		stmt += "OR REPLACE "
+	}
+	stmt += objectType + " " + name + "(" + strings.Join(args, ", ") + ")"
+	if replace {
+		stmt += " COPY GRANTS"
+	}
+	stmt += " RETURNS " + r.ReturnType
+	if r.Language != "" {
+		stmt += " LANGUAGE " + r.Language
+	}
+	if r.RuntimeVersion != nil {
+		stmt += " RUNTIME_VERSION = " + QuoteString(*r.RuntimeVersion)
+	}
+	if len(r.Packages) > 0 {
+		stmt += " PACKAGES = " + QuoteStringList(r.Packages)
+	}
+	if len(r.Imports) > 0 {
+		stmt += " IMPORTS = " + QuoteStringList(r.Imports)
+	}
+	if r.Handler != nil {
+		stmt += " HANDLER = " + QuoteString(*r.Handler)
+	}
+	if executeAs != "" {
+		stmt += " EXECUTE AS " + executeAs
+	}
+	if r.Body != nil {
+		stmt += " AS " + QuoteString(*r.Body)
+	}
+	return stmt
+}
+
+// replaceRoutine replaces a function or procedure with the one described by
+// r. When the signature changed, the object created with the previous
+// signature is dropped as Snowflake keeps it as an overload.
+func (c ClientInfo) replaceRoutine(ctx context.Context, objectType, name, identifier, previous string, r routine, executeAs string) error {
+	if _, err := c.ExecuteStatement(ctx, createRoutineSQL(objectType, name, r, executeAs, true)); err != nil {
+		return err
+	}
+	if previous == "" || SameIdentifier(identifier, previous) {
+		return nil
+	}
+	_, err := c.ExecuteStatement(ctx, "DROP "+objectType+" IF EXISTS "+previous)
+	return err
+}
+
+// FunctionUpToDate reports whether the function identified by identifier
+// matches p, including its signature.
+func FunctionUpToDate(p *functionv1alpha1.FunctionParameters, identifier string, obs functionv1alpha1.FunctionObservation) bool {
+	return SameIdentifier(FunctionIdentifier(p), identifier) && routineUpToDate(functionRoutine(p), routineObservation{
+		ReturnType:     obs.ReturnType,
+		Language:       obs.Language,
+		RuntimeVersion: obs.RuntimeVersion,
+		Packages:       obs.Packages,
+		Imports:        obs.Imports,
+		Handler:        obs.Handler,
+		Body:           obs.Body,
+	})
+}
+
+// FetchFunction returns the observed state of the function with the given
+// identifier, or ErrNotFound.
+func (c ClientInfo) FetchFunction(ctx context.Context, identifier string) (functionv1alpha1.FunctionObservation, error) {
+	props, err := c.describeRoutine(ctx, "FUNCTION", identifier)
+	if err != nil {
+		return functionv1alpha1.FunctionObservation{}, err
+	}
+
+	return functionv1alpha1.FunctionObservation{
+		Signature:      props["signature"],
+		ReturnType:     props["returns"],
+		Language:       props["language"],
+		RuntimeVersion: props["runtime_version"],
+		Packages:       unquoteList(props["packages"]),
+		Imports:        unquoteList(props["imports"]),
+		Handler:        props["handler"],
+		Body:           props["body"],
+	}, nil
+}
+
+// CreateFunction creates a function.
+func (c ClientInfo) CreateFunction(ctx context.Context, p *functionv1alpha1.FunctionParameters) error {
+	_, err := c.ExecuteStatement(ctx, createRoutineSQL("FUNCTION", QualifiedName(p.Database, p.Schema, p.Name), functionRoutine(p), "", false))
+	return err
+}
+
+// ReplaceFunction replaces the function identified by previous with the one
+// described by p.
+func (c ClientInfo) ReplaceFunction(ctx context.Context, p *functionv1alpha1.FunctionParameters, previous string) error {
+	return c.replaceRoutine(ctx, "FUNCTION", QualifiedName(p.Database, p.Schema, p.Name), FunctionIdentifier(p), previous, functionRoutine(p), "")
+}
+
+// DeleteFunction drops the function with the given identifier.
+func (c ClientInfo) DeleteFunction(ctx context.Context, identifier string) error {
+	_, err := c.ExecuteStatement(ctx, "DROP FUNCTION IF EXISTS "+identifier)
+	return err
+}
+
+// ProcedureUpToDate reports whether the procedure identified by identifier
+// matches p, including its signature.
+func ProcedureUpToDate(p *functionv1alpha1.ProcedureParameters, identifier string, obs functionv1alpha1.ProcedureObservation) bool {
+	executeAs := p.ExecuteAs
+	if executeAs == "" {
+		executeAs = "OWNER"
+	}
+	return SameIdentifier(ProcedureIdentifier(p), identifier) &&
+		strings.EqualFold(executeAs, obs.ExecuteAs) &&
+		routineUpToDate(procedureRoutine(p), routineObservation{
+			ReturnType:     obs.ReturnType,
+			Language:       obs.Language,
+			RuntimeVersion: obs.RuntimeVersion,
+			Packages:       obs.Packages,
+			Imports:        obs.Imports,
+			Handler:        obs.Handler,
+			Body:           obs.Body,
+		})
+}
+
+// FetchProcedure returns the observed state of the procedure with the given
+// identifier, or ErrNotFound.
+func (c ClientInfo) FetchProcedure(ctx context.Context, identifier string) (functionv1alpha1.ProcedureObservation, error) {
+	props, err := c.describeRoutine(ctx, "PROCEDURE", identifier)
+	if err != nil {
+		return functionv1alpha1.ProcedureObservation{}, err
+	}
+
+	return functionv1alpha1.ProcedureObservation{
+		Signature:      props["signature"],
+		ReturnType:     props["returns"],
+		Language:       props["language"],
+		RuntimeVersion: props["runtime_version"],
+		Packages:       unquoteList(props["packages"]),
+		Imports:        unquoteList(props["imports"]),
+		Handler:        props["handler"],
+		Body:           props["body"],
+		ExecuteAs:      props["execute as"],
+	}, nil
+}
+
+// CreateProcedure creates a procedure.
+func (c ClientInfo) CreateProcedure(ctx context.Context, p *functionv1alpha1.ProcedureParameters) error {
+	_, err := c.ExecuteStatement(ctx, createRoutineSQL("PROCEDURE", QualifiedName(p.Database, p.Schema, p.Name), procedureRoutine(p), p.ExecuteAs, false))
+	return err
+}
+
+// ReplaceProcedure replaces the procedure identified by previous with the one
+// described by p.
+func (c ClientInfo) ReplaceProcedure(ctx context.Context, p *functionv1alpha1.ProcedureParameters, previous string) error {
+	return c.replaceRoutine(ctx, "PROCEDURE", QualifiedName(p.Database, p.Schema, p.Name), ProcedureIdentifier(p), previous, procedureRoutine(p), p.ExecuteAs)
+}
+
+// DeleteProcedure drops the procedure with the given identifier.
+func (c ClientInfo) DeleteProcedure(ctx context.Context, identifier string) error {
+	_, err := c.ExecuteStatement(ctx, "DROP PROCEDURE IF EXISTS "+identifier)
+	return err
+}
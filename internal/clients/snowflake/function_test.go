@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	functionv1alpha1 "github.com/allenkallz/provider-snowflake/apis/function/v1alpha1"
+)
+
+func TestReplaceFunction(t *testing.T) {
+	p := functionv1alpha1.FunctionParameters{
+		Name:       "normalize",
+		Database:   "util",
+		Schema:     "public",
+		Arguments:  []functionv1alpha1.FunctionArgument{{Name: "s", Type: "varchar"}},
+		ReturnType: "varchar",
+		Body:       ptr.To("lower(trim(s))"),
+	}
+	replace := "CREATE OR REPLACE FUNCTION util.public.normalize(s varchar) COPY GRANTS RETURNS varchar AS 'lower(trim(s))'"
+
+	cases := map[string]struct {
+		reason   string
+		previous string
+		want     []string
+	}{
+		"Unchanged": {
+			reason:   "A function whose signature is unchanged should only be replaced.",
+			previous: "UTIL.PUBLIC.NORMALIZE(VARCHAR)",
+			want:     []string{replace},
+		},
+		"SignatureChanged": {
+			reason:   "The overload with the previous signature should be dropped once the function is replaced.",
+			previous: "util.public.normalize(varchar, number)",
+			want:     []string{replace, "DROP FUNCTION IF EXISTS util.public.normalize(varchar, number)"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.ReplaceFunction(context.Background(), &p, tc.previous); err != nil {
+				t.Fatalf("\n%s\nc.ReplaceFunction(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.ReplaceFunction(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	grantv1alpha1 "github.com/allenkallz/provider-snowflake/apis/grant/v1alpha1"
+)
+
+// objectGrant is a privilege on an object granted to a share or role.
+type objectGrant struct {
+	Privilege  string
+	ObjectType string
+	Name       string
+}
+
+// key returns a comparable form of the grant, as PRIVILEGE ON TYPE NAME.
+func (g objectGrant) key() string {
+	name := strings.Join(SplitQualifiedName(g.Name), ".")
+	return strings.ToUpper(g.Privilege + " ON " + strings.ReplaceAll(g.ObjectType, "_", " ") + " " + name)
+}
+
+// on returns the grant as PRIVILEGE ON TYPE NAME, keeping the quotes of
+// quoted name parts so that observed grants keep their case.
+func (g objectGrant) on() string {
+	return g.Privilege + " ON " + strings.ReplaceAll(g.ObjectType, "_", " ") + " " + quoteQualifiedName(g.Name)
+}
+
+// pluralObjectType returns the plural of an object type as used by SHOW and
+// by grants on all or future objects, e.g. MASKING POLICIES.
+func pluralObjectType(objectType string) string {
+	t := strings.ReplaceAll(objectType, "_", " ")
+	if strings.HasSuffix(t, "Y") {
+		return strings.TrimSuffix(t, "Y") + "IES"
+	}
+	return t + "S"
+}
+
+// ownershipObjects returns whether p transfers ownership of all or future
+// objects, or "" for a single object.
+func ownershipObjects(p *grantv1alpha1.GrantOwnershipParameters) string {
+	if p.Objects == nil {
+		return ""
+	}
+	return *p.Objects
+}
+
+// ownershipScope returns the IN DATABASE or IN SCHEMA clause of a grant on
+// all or future objects.
+func ownershipScope(p *grantv1alpha1.GrantOwnershipParameters) string {
+	if p.InSchema != nil {
+		return "IN SCHEMA " + QualifiedName(SplitQualifiedName(*p.InSchema)...)
+	}
+	return "IN DATABASE " + QuoteIdentifier(*p.InDatabase)
+}
+
+// ownershipOn returns the objects a GRANT OWNERSHIP statement is on.
+func ownershipOn(p *grantv1alpha1.GrantOwnershipParameters) string {
+	switch ownershipObjects(p) {
+	case grantv1alpha1.GrantOwnershipObjectsAll:
+		return "ALL " + pluralObjectType(p.ObjectType) + " " + ownershipScope(p)
+	case grantv1alpha1.GrantOwnershipObjectsFuture:
+		return "FUTURE " + pluralObjectType(p.ObjectType) + " " + ownershipScope(p)
+	}
+	return strings.ReplaceAll(p.ObjectType, "_", " ") + " " + QualifiedName(SplitQualifiedName(*p.ObjectName)...)
+}
+
+// ownershipGrantee returns the role ownership is transferred to.
+func ownershipGrantee(p *grantv1alpha1.GrantOwnershipParameters) string {
+	if p.ToDatabaseRole != nil {
+		return "DATABASE ROLE " + QualifiedName(SplitQualifiedName(*p.ToDatabaseRole)...)
+	}
+	return "ROLE " + QuoteIdentifier(*p.ToRole)
+}
+
+// ownershipRole returns the name of the role ownership is transferred to, as
+// shown by SHOW. Database roles are shown without their database.
+func ownershipRole(p *grantv1alpha1.GrantOwnershipParameters) string {
+	if p.ToDatabaseRole != nil {
+		parts := SplitQualifiedName(*p.ToDatabaseRole)
+		return parts[len(parts)-1]
+	}
+	return *p.ToRole
+}
+
+// ownedBy reports whether owner, as shown by SHOW, is the role ownership is
+// transferred to.
+func ownedBy(p *grantv1alpha1.GrantOwnershipParameters, owner string) bool {
+	parts := SplitQualifiedName(owner)
+	return strings.EqualFold(parts[len(parts)-1], ownershipRole(p))
+}
+
+// grantOwnershipSQL returns the statement transferring ownership of the
+// objects of p to grantee.
+func grantOwnershipSQL(p *grantv1alpha1.GrantOwnershipParameters, grantee string) string {
+	stmt := "GRANT OWNERSHIP ON " + ownershipOn(p) + " TO " + grantee
+	if ownershipObjects(p) != grantv1alpha1.GrantOwnershipObjectsFuture {
+		currentGrants := p.CurrentGrants
+		if currentGrants == "" {
+			currentGrants = "COPY"
+		}
+		stmt += " " + currentGrants + " CURRENT GRANTS"
+	}
+	return stmt
+}
+
+// FetchGrantOwnership returns the observed ownership of the objects of p, or
+// ErrNotFound when the role does not own them.
+func (c ClientInfo) FetchGrantOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) (grantv1alpha1.GrantOwnershipObservation, error) {
+	switch ownershipObjects(p) {
+	case grantv1alpha1.GrantOwnershipObjectsFuture:
+		return c.fetchFutureOwnership(ctx, p)
+	case grantv1alpha1.GrantOwnershipObjectsAll:
+		return c.fetchAllOwnership(ctx, p)
+	}
+
+	rows, err := c.ExecuteStatement(ctx, "SHOW GRANTS ON "+ownershipOn(p))
+	if err != nil {
+		return grantv1alpha1.GrantOwnershipObservation{}, err
+	}
+	for _, r := range rows {
+		if strings.EqualFold(r["privilege"], "OWNERSHIP") && ownedBy(p, r["grantee_name"]) {
+			return grantv1alpha1.GrantOwnershipObservation{Owner: r["grantee_name"]}, nil
+		}
+	}
+	return grantv1alpha1.GrantOwnershipObservation{}, ErrNotFound
+}
+
+// fetchAllOwnership reports the role as owner when it owns every object of
+// the type in the schema or database.
+func (c ClientInfo) fetchAllOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) (grantv1alpha1.GrantOwnershipObservation, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW "+pluralObjectType(p.ObjectType)+" "+ownershipScope(p))
+	if err != nil {
+		return grantv1alpha1.GrantOwnershipObservation{}, err
+	}
+	for _, r := range rows {
+		if p.ObjectType == "SCHEMA" && strings.EqualFold(r["name"], "INFORMATION_SCHEMA") {
+			continue
+		}
+		if !ownedBy(p, r["owner"]) {
+			return grantv1alpha1.GrantOwnershipObservation{}, ErrNotFound
+		}
+	}
+	return grantv1alpha1.GrantOwnershipObservation{Owner: ownershipRole(p)}, nil
+}
+
+// fetchFutureOwnership looks up the future ownership grant of the schema or
+// database.
+func (c ClientInfo) fetchFutureOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) (grantv1alpha1.GrantOwnershipObservation, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW FUTURE GRANTS "+ownershipScope(p))
+	if err != nil {
+		return grantv1alpha1.GrantOwnershipObservation{}, err
+	}
+	for _, r := range rows {
+		if strings.EqualFold(r["privilege"], "OWNERSHIP") && strings.EqualFold(strings.ReplaceAll(r["grant_on"], " ", "_"), p.ObjectType) && ownedBy(p, r["grantee_name"]) {
+			return grantv1alpha1.GrantOwnershipObservation{Owner: r["grantee_name"]}, nil
+		}
+	}
+	return grantv1alpha1.GrantOwnershipObservation{}, ErrNotFound
+}
+
+// CreateGrantOwnership transfers ownership of the objects of p to its role.
+func (c ClientInfo) CreateGrantOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) error {
+	_, err := c.ExecuteStatement(ctx, grantOwnershipSQL(p, ownershipGrantee(p)))
+	return err
+}
+
+// DeleteGrantOwnership returns ownership of the objects of p to its revert
+// role, if any, and revokes the ownership of future objects.
+func (c ClientInfo) DeleteGrantOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) error {
+	var stmt string
+	switch {
+	case ownershipObjects(p) == grantv1alpha1.GrantOwnershipObjectsFuture:
+		stmt = "REVOKE OWNERSHIP ON " + ownershipOn(p) + " FROM " + ownershipGrantee(p)
+	case p.RevertToRole != nil:
+		stmt = grantOwnershipSQL(p, "ROLE "+QuoteIdentifier(*p.RevertToRole))
+	default:
+		return nil
+	}
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
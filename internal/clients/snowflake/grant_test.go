@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	grantv1alpha1 "github.com/allenkallz/provider-snowflake/apis/grant/v1alpha1"
+)
+
+func TestDeleteGrantOwnership(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      grantv1alpha1.GrantOwnershipParameters
+		want   []string
+	}{
+		"Kept": {
+			reason: "Ownership of objects should be kept when no role to revert to is given.",
+			p: grantv1alpha1.GrantOwnershipParameters{
+				ObjectType: "TABLE",
+				ObjectName: ptr.To("sales.public.orders"),
+				ToRole:     ptr.To("loader"),
+			},
+		},
+		"RevertObject": {
+			reason: "Ownership of an object should be returned to the role to revert to, copying its grants.",
+			p: grantv1alpha1.GrantOwnershipParameters{
+				ObjectType:   "TABLE",
+				ObjectName:   ptr.To("sales.public.orders"),
+				ToRole:       ptr.To("loader"),
+				RevertToRole: ptr.To("sysadmin"),
+			},
+			want: []string{"GRANT OWNERSHIP ON TABLE sales.public.orders TO ROLE sysadmin COPY CURRENT GRANTS"},
+		},
+		"RevertAll": {
+			reason: "Ownership of all objects in a schema should be returned with the current grants option of the spec.",
+			p: grantv1alpha1.GrantOwnershipParameters{
+				ObjectType:     "TABLE",
+				Objects:        ptr.To(grantv1alpha1.GrantOwnershipObjectsAll),
+				InSchema:       ptr.To("sales.public"),
+				ToDatabaseRole: ptr.To("sales.loader"),
+				CurrentGrants:  "REVOKE",
+				RevertToRole:   ptr.To("sysadmin"),
+			},
+			want: []string{"GRANT OWNERSHIP ON ALL TABLES IN SCHEMA sales.public TO ROLE sysadmin REVOKE CURRENT GRANTS"},
+		},
+		"Future": {
+			reason: "Ownership of future objects should be revoked.",
+			p: grantv1alpha1.GrantOwnershipParameters{
+				ObjectType: "TABLE",
+				Objects:    ptr.To(grantv1alpha1.GrantOwnershipObjectsFuture),
+				InDatabase: ptr.To("sales"),
+				ToRole:     ptr.To("loader"),
+			},
+			want: []string{"REVOKE OWNERSHIP ON FUTURE TABLES IN DATABASE sales FROM ROLE loader"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.DeleteGrantOwnership(context.Background(), &tc.p); err != nil {
+				t.Fatalf("\n%s\nc.DeleteGrantOwnership(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nc.DeleteGrantOwnership(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package snowflake
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	tablev1alpha1 "github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+)
+
+// autoRefreshStatus is the part of the SYSTEM$AUTO_REFRESH_STATUS result an
+// IcebergTable reports.
+type autoRefreshStatus struct {
+	ExecutionState              string `json:"executionState"`
+	InvalidExecutionStateReason string `json:"invalidExecutionStateReason"`
+	LastSnapshotTime            string `json:"lastSnapshotTime"`
+}
+
+func icebergTableName(p *tablev1alpha1.IcebergTableParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchIcebergTable returns the observed state of an Iceberg table along with
+// the state of its automatic refresh, or ErrNotFound.
+func (c ClientInfo) FetchIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) (tablev1alpha1.IcebergTableObservation, error) {
+	row, err := c.showObject(ctx, "ICEBERG TABLES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return tablev1alpha1.IcebergTableObservation{}, err
+	}
+
+	obs := tablev1alpha1.IcebergTableObservation{
+		ExternalVolume:   row["external_volume_name"],
+		Catalog:          row["catalog_name"],
+		TableType:        row["iceberg_table_type"],
+		BaseLocation:     nullable(row["base_location"]),
+		CatalogTableName: nullable(row["catalog_table_name"]),
+		CatalogNamespace: nullable(row["catalog_namespace"]),
+		Comment:          row["comment"],
+		Owner:            row["owner"],
+		CreatedOn:        row["created_on"],
+	}
+
+	// only tables polling their catalog have a refresh status
+	if p.AutoRefresh == nil || !*p.AutoRefresh {
+		return obs, nil
+	}
+	rows, err := c.ExecuteStatement(ctx, "SELECT SYSTEM$AUTO_REFRESH_STATUS("+QuoteString(icebergTableName(p))+") AS STATUS")
+	if err != nil {
+		return tablev1alpha1.IcebergTableObservation{}, err
+	}
+	if len(rows) > 0 {
+		var s autoRefreshStatus
+		if err := json.Unmarshal([]byte(rows[0]["STATUS"]), &s); err != nil {
+			return tablev1alpha1.IcebergTableObservation{}, errors.Wrap(err, "cannot decode auto refresh status")
+		}
+		obs.RefreshState = s.ExecutionState
+		obs.RefreshStateReason = s.InvalidExecutionStateReason
+		obs.LastSnapshotTime = s.LastSnapshotTime
+	}
+	return obs, nil
+}
+
+// CreateIcebergTable creates an Iceberg table, either managed by Snowflake or
+// by the catalog of a catalog integration.
+func (c ClientInfo) CreateIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE ICEBERG TABLE " + icebergTableName(p))
+	if len(p.Columns) > 0 {
+		cols := make([]string, len(p.Columns))
+		for i, col := range p.Columns {
+			cols[i] = QuoteIdentifier(col.Name) + " " + col.Type
+		}
+		b.WriteString(" (" + strings.Join(cols, ", ") + ")")
+	}
+
+	b.WriteString(" EXTERNAL_VOLUME = " + QuoteString(p.ExternalVolume))
+	if p.CatalogIntegration != nil {
+		b.WriteString(" CATALOG = " + QuoteString(*p.CatalogIntegration))
+	} else {
+		b.WriteString(" CATALOG = 'SNOWFLAKE'")
+	}
+	if p.BaseLocation != nil {
+		b.WriteString(" BASE_LOCATION = " + QuoteString(*p.BaseLocation))
+	}
+	if p.CatalogTableName != nil {
+		b.WriteString(" CATALOG_TABLE_NAME = " + QuoteString(*p.CatalogTableName))
+	}
+	if p.CatalogNamespace != nil {
+		b.WriteString(" CATALOG_NAMESPACE = " + QuoteString(*p.CatalogNamespace))
+	}
+	if p.MetadataFilePath != nil {
+		b.WriteString(" METADATA_FILE_PATH = " + QuoteString(*p.MetadataFilePath))
+	}
+	if p.AutoRefresh != nil {
+		b.WriteString(" AUTO_REFRESH = " + FormatBool(*p.AutoRefresh))
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// RefreshIcebergTable updates the metadata of an Iceberg table managed by an
+// external catalog to its latest snapshot, or to the metadata file of p for
+// tables read from object storage.
+func (c ClientInfo) RefreshIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) error {
+	stmt := "ALTER ICEBERG TABLE " + icebergTableName(p) + " REFRESH"
+	if p.MetadataFilePath != nil {
+		stmt += " " + QuoteString(*p.MetadataFilePath)
+	}
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// DeleteIcebergTable drops an Iceberg table. The files of tables managed by
+// Snowflake are deleted along with it.
+func (c ClientInfo) DeleteIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP ICEBERG TABLE IF EXISTS "+icebergTableName(p))
+	return err
+}
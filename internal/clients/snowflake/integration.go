@@ -0,0 +1,472 @@
+package snowflake
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	integrationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+)
+
+// fetchIntegration returns the SHOW row and the DESC properties of an
+// integration, or ErrNotFound. kind is the integration type used in SHOW and
+// DESC, e.g. STORAGE.
+func (c ClientInfo) fetchIntegration(ctx context.Context, kind, name string) (Row, map[string]string, error) {
+	row, err := c.showObject(ctx, kind+" INTEGRATIONS", name, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	props, err := c.describeObject(ctx, kind+" INTEGRATION", QuoteIdentifier(name))
+	if err != nil {
+		return nil, nil, err
+	}
+	return row, props, nil
+}
+
+// setOrUnsetList renders SET and UNSET clauses for an optional list property.
+func setOrUnsetList(set, unset *[]string, property string, want, have []string) {
+	switch {
+	case len(want) > 0:
+		*set = append(*set, property+" = "+QuoteStringList(want))
+	case len(have) > 0:
+		*unset = append(*unset, property)
+	}
+}
+
+// alterIntegration runs the SET and UNSET clauses for an integration.
+func (c ClientInfo) alterIntegration(ctx context.Context, kind, name string, set, unset []string) error {
+	if len(set) > 0 {
+		if _, err := c.ExecuteStatement(ctx, "ALTER "+kind+" INTEGRATION "+QuoteIdentifier(name)+" SET "+strings.Join(set, " ")); err != nil {
+			return err
+		}
+	}
+	if len(unset) > 0 {
+		if _, err := c.ExecuteStatement(ctx, "ALTER "+kind+" INTEGRATION "+QuoteIdentifier(name)+" UNSET "+strings.Join(unset, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FetchStorageIntegration returns the observed state of a storage
+// integration, or ErrNotFound.
+func (c ClientInfo) FetchStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters) (integrationv1alpha1.StorageIntegrationObservation, error) {
+	row, props, err := c.fetchIntegration(ctx, "STORAGE", p.Name)
+	if err != nil {
+		return integrationv1alpha1.StorageIntegrationObservation{}, err
+	}
+
+	return integrationv1alpha1.StorageIntegrationObservation{
+		Enabled:                  strings.EqualFold(props["ENABLED"], "true"),
+		StorageProvider:          props["STORAGE_PROVIDER"],
+		StorageAllowedLocations:  splitList(props["STORAGE_ALLOWED_LOCATIONS"]),
+		StorageBlockedLocations:  splitList(props["STORAGE_BLOCKED_LOCATIONS"]),
+		StorageAWSRoleARN:        props["STORAGE_AWS_ROLE_ARN"],
+		StorageAWSObjectACL:      props["STORAGE_AWS_OBJECT_ACL"],
+		StorageAWSIAMUserARN:     props["STORAGE_AWS_IAM_USER_ARN"],
+		StorageAWSExternalID:     props["STORAGE_AWS_EXTERNAL_ID"],
+		StorageGCPServiceAccount: props["STORAGE_GCP_SERVICE_ACCOUNT"],
+		AzureTenantID:            props["AZURE_TENANT_ID"],
+		AzureConsentURL:          props["AZURE_CONSENT_URL"],
+		AzureMultiTenantAppName:  props["AZURE_MULTI_TENANT_APP_NAME"],
+		Comment:                  row["comment"],
+		CreatedOn:                row["created_on"],
+	}, nil
+}
+
+// storageIntegrationProperties renders the properties of p that can be set
+// on creation as well as altered.
+func storageIntegrationProperties(p *integrationv1alpha1.StorageIntegrationParameters) []string {
+	props := []string{"STORAGE_ALLOWED_LOCATIONS = " + QuoteStringList(p.StorageAllowedLocations)}
+	if p.Enabled != nil {
+		props = append(props, "ENABLED = "+FormatBool(*p.Enabled))
+	}
+	if p.StorageAWSRoleARN != nil {
+		props = append(props, "STORAGE_AWS_ROLE_ARN = "+QuoteString(*p.StorageAWSRoleARN))
+	}
+	if p.StorageAWSObjectACL != nil {
+		props = append(props, "STORAGE_AWS_OBJECT_ACL = "+QuoteString(*p.StorageAWSObjectACL))
+	}
+	if p.AzureTenantID != nil {
+		props = append(props, "AZURE_TENANT_ID = "+QuoteString(*p.AzureTenantID))
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// CreateStorageIntegration creates a storage integration. It is never
+// replaced afterwards, as that would generate a new external ID.
+func (c ClientInfo) CreateStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters) error {
+	props := append([]string{"TYPE = EXTERNAL_STAGE", "STORAGE_PROVIDER = " + QuoteString(p.StorageProvider)}, storageIntegrationProperties(p)...)
+	if len(p.StorageBlockedLocations) > 0 {
+		props = append(props, "STORAGE_BLOCKED_LOCATIONS = "+QuoteStringList(p.StorageBlockedLocations))
+	}
+
+	_, err := c.ExecuteStatement(ctx, "CREATE STORAGE INTEGRATION "+QuoteIdentifier(p.Name)+" "+strings.Join(props, " "))
+	return err
+}
+
+// UpdateStorageIntegration sets the properties of a storage integration.
+func (c ClientInfo) UpdateStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters, obs integrationv1alpha1.StorageIntegrationObservation) error {
+	set := storageIntegrationProperties(p)
+	var unset []string
+	setOrUnsetList(&set, &unset, "STORAGE_BLOCKED_LOCATIONS", p.StorageBlockedLocations, obs.StorageBlockedLocations)
+
+	return c.alterIntegration(ctx, "STORAGE", p.Name, set, unset)
+}
+
+// DeleteStorageIntegration drops a storage integration.
+func (c ClientInfo) DeleteStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP STORAGE INTEGRATION IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
+
+// FetchApiIntegration returns the observed state of an API integration, or
+// ErrNotFound.
+func (c ClientInfo) FetchApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters) (integrationv1alpha1.ApiIntegrationObservation, error) {
+	row, props, err := c.fetchIntegration(ctx, "API", p.Name)
+	if err != nil {
+		return integrationv1alpha1.ApiIntegrationObservation{}, err
+	}
+
+	return integrationv1alpha1.ApiIntegrationObservation{
+		Enabled:                 strings.EqualFold(props["ENABLED"], "true"),
+		APIProvider:             props["API_PROVIDER"],
+		APIAWSRoleARN:           props["API_AWS_ROLE_ARN"],
+		APIAWSIAMUserARN:        props["API_AWS_IAM_USER_ARN"],
+		APIAWSExternalID:        props["API_AWS_EXTERNAL_ID"],
+		APIGCPServiceAccount:    props["API_GCP_SERVICE_ACCOUNT"],
+		AzureConsentURL:         props["AZURE_CONSENT_URL"],
+		AzureMultiTenantAppName: props["AZURE_MULTI_TENANT_APP_NAME"],
+		APIAllowedPrefixes:      splitList(props["API_ALLOWED_PREFIXES"]),
+		APIBlockedPrefixes:      splitList(props["API_BLOCKED_PREFIXES"]),
+		Comment:                 row["comment"],
+		CreatedOn:               row["created_on"],
+	}, nil
+}
+
+// apiIntegrationProperties renders the properties of p that can be set on
+// creation as well as altered. The API key is only rendered if given.
+func apiIntegrationProperties(p *integrationv1alpha1.ApiIntegrationParameters, apiKey string) []string {
+	props := []string{"API_ALLOWED_PREFIXES = " + QuoteStringList(p.APIAllowedPrefixes)}
+	if p.Enabled != nil {
+		props = append(props, "ENABLED = "+FormatBool(*p.Enabled))
+	}
+	if p.APIAWSRoleARN != nil {
+		props = append(props, "API_AWS_ROLE_ARN = "+QuoteString(*p.APIAWSRoleARN))
+	}
+	if p.AzureTenantID != nil {
+		props = append(props, "AZURE_TENANT_ID = "+QuoteString(*p.AzureTenantID))
+	}
+	if p.AzureADApplicationID != nil {
+		props = append(props, "AZURE_AD_APPLICATION_ID = "+QuoteString(*p.AzureADApplicationID))
+	}
+	if p.GoogleAudience != nil {
+		props = append(props, "GOOGLE_AUDIENCE = "+QuoteString(*p.GoogleAudience))
+	}
+	if apiKey != "" {
+		props = append(props, "API_KEY = "+QuoteString(apiKey))
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// CreateApiIntegration creates an API integration.
+func (c ClientInfo) CreateApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters, apiKey string) error {
+	props := append([]string{"API_PROVIDER = " + p.APIProvider}, apiIntegrationProperties(p, apiKey)...)
+	if len(p.APIBlockedPrefixes) > 0 {
+		props = append(props, "API_BLOCKED_PREFIXES = "+QuoteStringList(p.APIBlockedPrefixes))
+	}
+
+	_, err := c.ExecuteStatement(ctx, "CREATE API INTEGRATION "+QuoteIdentifier(p.Name)+" "+strings.Join(props, " "))
+	return err
+}
+
+// UpdateApiIntegration sets the properties of an API integration.
+func (c ClientInfo) UpdateApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters, obs integrationv1alpha1.ApiIntegrationObservation, apiKey string) error {
+	set := apiIntegrationProperties(p, apiKey)
+	var unset []string
+	setOrUnsetList(&set, &unset, "API_BLOCKED_PREFIXES", p.APIBlockedPrefixes, obs.APIBlockedPrefixes)
+
+	return c.alterIntegration(ctx, "API", p.Name, set, unset)
+}
+
+// DeleteApiIntegration drops an API integration.
+func (c ClientInfo) DeleteApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP API INTEGRATION IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
+
+// notificationStringProperties maps the DESC property names of the string
+// properties of a notification integration to their value in p.
+func notificationStringProperties(p *integrationv1alpha1.NotificationIntegrationParameters) map[string]*string {
+	return map[string]*string{
+		"AWS_SNS_TOPIC_ARN":               p.AWSSNSTopicARN,
+		"AWS_SNS_ROLE_ARN":                p.AWSSNSRoleARN,
+		"GCP_PUBSUB_SUBSCRIPTION_NAME":    p.GCPPubsubSubscriptionName,
+		"GCP_PUBSUB_TOPIC_NAME":           p.GCPPubsubTopicName,
+		"AZURE_STORAGE_QUEUE_PRIMARY_URI": p.AzureStorageQueuePrimaryURI,
+		"AZURE_EVENT_GRID_TOPIC_ENDPOINT": p.AzureEventGridTopicEndpoint,
+		"AZURE_TENANT_ID":                 p.AzureTenantID,
+		"WEBHOOK_URL":                     p.WebhookURL,
+		"WEBHOOK_BODY_TEMPLATE":           p.WebhookBodyTemplate,
+	}
+}
+
+// NotificationIntegrationUpToDate reports whether the properties set in p
+// match the observed ones. The webhook secret and headers are not compared
+// as Snowflake does not report them in a comparable form.
+func NotificationIntegrationUpToDate(p *integrationv1alpha1.NotificationIntegrationParameters, obs integrationv1alpha1.NotificationIntegrationObservation) bool {
+	for k, v := range notificationStringProperties(p) {
+		if v != nil && *v != obs.Properties[k] {
+			return false
+		}
+	}
+	if p.AllowedRecipients != nil && !SameNames(p.AllowedRecipients, splitList(obs.Properties["ALLOWED_RECIPIENTS"])) {
+		return false
+	}
+	if p.Enabled != nil && *p.Enabled != obs.Enabled {
+		return false
+	}
+	return p.Comment == nil || *p.Comment == obs.Comment
+}
+
+// FetchNotificationIntegration returns the observed state of a notification
+// integration, or ErrNotFound.
+func (c ClientInfo) FetchNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) (integrationv1alpha1.NotificationIntegrationObservation, error) {
+	row, props, err := c.fetchIntegration(ctx, "NOTIFICATION", p.Name)
+	if err != nil {
+		return integrationv1alpha1.NotificationIntegrationObservation{}, err
+	}
+
+	return integrationv1alpha1.NotificationIntegrationObservation{
+		Enabled:                 strings.EqualFold(props["ENABLED"], "true"),
+		Type:                    props["TYPE"],
+		Direction:               props["DIRECTION"],
+		NotificationProvider:    props["NOTIFICATION_PROVIDER"],
+		SFAWSIAMUserARN:         props["SF_AWS_IAM_USER_ARN"],
+		SFAWSExternalID:         props["SF_AWS_EXTERNAL_ID"],
+		GCPPubsubServiceAccount: props["GCP_PUBSUB_SERVICE_ACCOUNT"],
+		AzureConsentURL:         props["AZURE_CONSENT_URL"],
+		AzureMultiTenantAppName: props["AZURE_MULTI_TENANT_APP_NAME"],
+		Properties:              props,
+		Comment:                 row["comment"],
+		CreatedOn:               row["created_on"],
+	}, nil
+}
+
+// notificationIntegrationProperties renders the properties of p that can be
+// set on creation as well as altered.
+func notificationIntegrationProperties(p *integrationv1alpha1.NotificationIntegrationParameters) []string {
+	var props []string
+	strs := notificationStringProperties(p)
+	for _, k := range []string{
+		"AWS_SNS_TOPIC_ARN", "AWS_SNS_ROLE_ARN",
+		"GCP_PUBSUB_SUBSCRIPTION_NAME", "GCP_PUBSUB_TOPIC_NAME",
+		"AZURE_STORAGE_QUEUE_PRIMARY_URI", "AZURE_EVENT_GRID_TOPIC_ENDPOINT", "AZURE_TENANT_ID",
+		"WEBHOOK_URL", "WEBHOOK_BODY_TEMPLATE",
+	} {
+		if v := strs[k]; v != nil {
+			props = append(props, k+" = "+QuoteString(*v))
+		}
+	}
+	if p.AllowedRecipients != nil {
+		props = append(props, "ALLOWED_RECIPIENTS = "+QuoteStringList(p.AllowedRecipients))
+	}
+	if p.WebhookSecret != nil {
+		props = append(props, "WEBHOOK_SECRET = "+*p.WebhookSecret)
+	}
+	if len(p.WebhookHeaders) > 0 {
+		headers := make([]string, 0, len(p.WebhookHeaders))
+		for _, k := range sortedKeys(p.WebhookHeaders) {
+			headers = append(headers, QuoteString(k)+" = "+QuoteString(p.WebhookHeaders[k]))
+		}
+		props = append(props, "WEBHOOK_HEADERS = ("+strings.Join(headers, ", ")+")")
+	}
+	if p.Enabled != nil {
+		props = append(props, "ENABLED = "+FormatBool(*p.Enabled))
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// CreateNotificationIntegration creates a notification integration.
+func (c ClientInfo) CreateNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) error {
+	props := []string{"TYPE = " + p.Type}
+	if p.Direction != nil {
+		props = append(props, "DIRECTION = "+*p.Direction)
+	}
+	if p.NotificationProvider != nil {
+		props = append(props, "NOTIFICATION_PROVIDER = "+*p.NotificationProvider)
+	}
+	props = append(props, notificationIntegrationProperties(p)...)
+
+	_, err := c.ExecuteStatement(ctx, "CREATE NOTIFICATION INTEGRATION "+QuoteIdentifier(p.Name)+" "+strings.Join(props, " "))
+	return err
+}
+
+// UpdateNotificationIntegration sets the properties of a notification
+// integration.
+func (c ClientInfo) UpdateNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) error {
+	return c.alterIntegration(ctx, "NOTIFICATION", p.Name, notificationIntegrationProperties(p), nil)
+}
+
+// DeleteNotificationIntegration drops a notification integration.
+func (c ClientInfo) DeleteNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP NOTIFICATION INTEGRATION IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
+
+// FetchExternalAccessIntegration returns the observed state of an external
+// access integration, or ErrNotFound.
+func (c ClientInfo) FetchExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) (integrationv1alpha1.ExternalAccessIntegrationObservation, error) {
+	row, props, err := c.fetchIntegration(ctx, "EXTERNAL ACCESS", p.Name)
+	if err != nil {
+		return integrationv1alpha1.ExternalAccessIntegrationObservation{}, err
+	}
+
+	return integrationv1alpha1.ExternalAccessIntegrationObservation{
+		Enabled:                              strings.EqualFold(props["ENABLED"], "true"),
+		AllowedNetworkRules:                  splitList(props["ALLOWED_NETWORK_RULES"]),
+		AllowedAPIAuthenticationIntegrations: splitList(props["ALLOWED_API_AUTHENTICATION_INTEGRATIONS"]),
+		AllowedAuthenticationSecrets:         splitList(props["ALLOWED_AUTHENTICATION_SECRETS"]),
+		Comment:                              row["comment"],
+		CreatedOn:                            row["created_on"],
+	}, nil
+}
+
+// externalAccessIntegrationProperties renders the properties of p, using
+// empty lists for the lists that are not set so that ALTER removes them.
+func externalAccessIntegrationProperties(p *integrationv1alpha1.ExternalAccessIntegrationParameters) []string {
+	props := []string{
+		"ALLOWED_NETWORK_RULES = (" + strings.Join(p.AllowedNetworkRules, ", ") + ")",
+		"ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (" + IdentifierList(p.AllowedAPIAuthenticationIntegrations) + ")",
+		"ALLOWED_AUTHENTICATION_SECRETS = (" + strings.Join(p.AllowedAuthenticationSecrets, ", ") + ")",
+	}
+	if p.Enabled != nil {
+		props = append(props, "ENABLED = "+FormatBool(*p.Enabled))
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// CreateExternalAccessIntegration creates an external access integration.
+// Snowflake requires at least one allowed network rule.
+func (c ClientInfo) CreateExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) error {
+	if len(p.AllowedNetworkRules) == 0 {
+		return errors.New("an external access integration needs at least one allowed network rule")
+	}
+
+	props := externalAccessIntegrationProperties(p)
+	if p.Enabled == nil {
+		props = append(props, "ENABLED = TRUE")
+	}
+	_, err := c.ExecuteStatement(ctx, "CREATE EXTERNAL ACCESS INTEGRATION "+QuoteIdentifier(p.Name)+" "+strings.Join(props, " "))
+	return err
+}
+
+// UpdateExternalAccessIntegration sets the properties of an external access
+// integration.
+func (c ClientInfo) UpdateExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) error {
+	return c.alterIntegration(ctx, "EXTERNAL ACCESS", p.Name, externalAccessIntegrationProperties(p), nil)
+}
+
+// DeleteExternalAccessIntegration drops an external access integration.
+func (c ClientInfo) DeleteExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP EXTERNAL ACCESS INTEGRATION IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
+
+// FetchCatalogIntegration returns the observed state of a catalog
+// integration, or ErrNotFound.
+func (c ClientInfo) FetchCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters) (integrationv1alpha1.CatalogIntegrationObservation, error) {
+	row, props, err := c.fetchIntegration(ctx, "CATALOG", p.Name)
+	if err != nil {
+		return integrationv1alpha1.CatalogIntegrationObservation{}, err
+	}
+
+	return integrationv1alpha1.CatalogIntegrationObservation{
+		Enabled:                strings.EqualFold(props["ENABLED"], "true"),
+		CatalogSource:          props["CATALOG_SOURCE"],
+		TableFormat:            props["TABLE_FORMAT"],
+		CatalogNamespace:       props["CATALOG_NAMESPACE"],
+		GlueAWSRoleARN:         props["GLUE_AWS_ROLE_ARN"],
+		GlueAWSIAMUserARN:      props["GLUE_AWS_IAM_USER_ARN"],
+		GlueAWSExternalID:      props["GLUE_AWS_EXTERNAL_ID"],
+		RefreshIntervalSeconds: props["REFRESH_INTERVAL_SECONDS"],
+		Comment:                row["comment"],
+		CreatedOn:              row["created_on"],
+	}, nil
+}
+
+// catalogIntegrationProperties renders the properties of p that can be set on
+// creation as well as altered. The OAuth client secret of a Polaris catalog is
+// only rendered if given.
+func catalogIntegrationProperties(p *integrationv1alpha1.CatalogIntegrationParameters, clientSecret string) []string {
+	var props []string
+	if p.Enabled != nil {
+		props = append(props, "ENABLED = "+FormatBool(*p.Enabled))
+	}
+	if p.RefreshIntervalSeconds != nil {
+		props = append(props, "REFRESH_INTERVAL_SECONDS = "+strconv.Itoa(*p.RefreshIntervalSeconds))
+	}
+	if clientSecret != "" {
+		props = append(props, "REST_AUTHENTICATION = (OAUTH_CLIENT_SECRET = "+QuoteString(clientSecret)+")")
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// CreateCatalogIntegration creates a catalog integration. Snowflake requires
+// ENABLED to be given.
+func (c ClientInfo) CreateCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters, clientSecret string) error {
+	props := []string{"CATALOG_SOURCE = " + p.CatalogSource, "TABLE_FORMAT = " + p.TableFormat}
+	if p.CatalogNamespace != nil {
+		props = append(props, "CATALOG_NAMESPACE = "+QuoteString(*p.CatalogNamespace))
+	}
+	if g := p.Glue; g != nil {
+		props = append(props, "GLUE_AWS_ROLE_ARN = "+QuoteString(g.AWSRoleARN), "GLUE_CATALOG_ID = "+QuoteString(g.CatalogID))
+		if g.Region != nil {
+			props = append(props, "GLUE_REGION = "+QuoteString(*g.Region))
+		}
+	}
+	if pc := p.Polaris; pc != nil {
+		props = append(props,
+			"REST_CONFIG = (CATALOG_URI = "+QuoteString(pc.CatalogURI)+" WAREHOUSE = "+QuoteString(pc.CatalogName)+")",
+			"REST_AUTHENTICATION = (TYPE = OAUTH OAUTH_CLIENT_ID = "+QuoteString(pc.OAuthClientID)+
+				" OAUTH_CLIENT_SECRET = "+QuoteString(clientSecret)+
+				" OAUTH_ALLOWED_SCOPES = "+QuoteStringList(pc.OAuthAllowedScopes)+")")
+	}
+	if p.Enabled == nil {
+		props = append(props, "ENABLED = TRUE")
+	}
+	// the client secret is part of REST_AUTHENTICATION above
+	props = append(props, catalogIntegrationProperties(p, "")...)
+
+	_, err := c.ExecuteStatement(ctx, "CREATE CATALOG INTEGRATION "+QuoteIdentifier(p.Name)+" "+strings.Join(props, " "))
+	return err
+}
+
+// UpdateCatalogIntegration sets the properties of a catalog integration.
+func (c ClientInfo) UpdateCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters, clientSecret string) error {
+	return c.alterIntegration(ctx, "CATALOG", p.Name, catalogIntegrationProperties(p, clientSecret), nil)
+}
+
+// DeleteCatalogIntegration drops a catalog integration.
+func (c ClientInfo) DeleteCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP CATALOG INTEGRATION IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
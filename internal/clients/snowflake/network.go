@@ -0,0 +1,238 @@
+package snowflake
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	networkv1alpha1 "github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+)
+
+const paramNetworkPolicy = "NETWORK_POLICY"
+
+func networkRuleName(p *networkv1alpha1.NetworkRuleParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchNetworkRule returns the observed state of a network rule, or
+// ErrNotFound.
+func (c ClientInfo) FetchNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) (networkv1alpha1.NetworkRuleObservation, error) {
+	row, err := c.showObject(ctx, "NETWORK RULES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return networkv1alpha1.NetworkRuleObservation{}, err
+	}
+
+	obs := networkv1alpha1.NetworkRuleObservation{
+		Type:      row["type"],
+		Mode:      row["mode"],
+		Comment:   row["comment"],
+		Owner:     row["owner"],
+		CreatedOn: row["created_on"],
+	}
+
+	// the values are only returned by DESC
+	rows, err := c.ExecuteStatement(ctx, "DESC NETWORK RULE "+networkRuleName(p))
+	if err != nil {
+		return networkv1alpha1.NetworkRuleObservation{}, err
+	}
+	if len(rows) > 0 {
+		obs.ValueList = splitList(rows[0]["value_list"])
+	}
+	return obs, nil
+}
+
+// CreateNetworkRule creates a network rule.
+func (c ClientInfo) CreateNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) error {
+	stmt := "CREATE NETWORK RULE " + networkRuleName(p) + " TYPE = " + p.Type + " VALUE_LIST = " + QuoteStringList(p.ValueList)
+	if p.Mode != "" {
+		stmt += " MODE = " + p.Mode
+	}
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateNetworkRule sets the values and comment of a network rule.
+func (c ClientInfo) UpdateNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) error {
+	stmt := "ALTER NETWORK RULE " + networkRuleName(p) + " SET VALUE_LIST = " + QuoteStringList(p.ValueList)
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// DeleteNetworkRule drops a network rule.
+func (c ClientInfo) DeleteNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP NETWORK RULE IF EXISTS "+networkRuleName(p))
+	return err
+}
+
+// parseNetworkRuleList parses the network rule lists of DESC NETWORK POLICY,
+// which are JSON arrays of objects naming the rules.
+func parseNetworkRuleList(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var rules []struct {
+		Name string `json:"fullyQualifiedRuleName"`
+	}
+	if err := json.Unmarshal([]byte(s), &rules); err != nil {
+		return nil, errors.Wrap(err, "cannot decode network rule list")
+	}
+
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name
+	}
+	return names, nil
+}
+
+// FetchNetworkPolicy returns the observed state of a network policy, or
+// ErrNotFound.
+func (c ClientInfo) FetchNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) (networkv1alpha1.NetworkPolicyObservation, error) {
+	// SHOW NETWORK POLICIES does not support LIKE
+	rows, err := c.ExecuteStatement(ctx, "SHOW NETWORK POLICIES")
+	if err != nil {
+		return networkv1alpha1.NetworkPolicyObservation{}, err
+	}
+
+	var row Row
+	for _, r := range rows {
+		if strings.EqualFold(r["name"], p.Name) {
+			row = r
+			break
+		}
+	}
+	if row == nil {
+		return networkv1alpha1.NetworkPolicyObservation{}, ErrNotFound
+	}
+
+	obs := networkv1alpha1.NetworkPolicyObservation{
+		Comment:   row["comment"],
+		CreatedOn: row["created_on"],
+	}
+
+	props, err := c.ExecuteStatement(ctx, "DESC NETWORK POLICY "+QuoteIdentifier(p.Name))
+	if err != nil {
+		return networkv1alpha1.NetworkPolicyObservation{}, err
+	}
+	for _, prop := range props {
+		switch v := prop["value"]; prop["name"] {
+		case "ALLOWED_IP_LIST":
+			obs.AllowedIPList = splitList(v)
+		case "BLOCKED_IP_LIST":
+			obs.BlockedIPList = splitList(v)
+		case "ALLOWED_NETWORK_RULE_LIST":
+			if obs.AllowedNetworkRuleList, err = parseNetworkRuleList(v); err != nil {
+				return networkv1alpha1.NetworkPolicyObservation{}, err
+			}
+		case "BLOCKED_NETWORK_RULE_LIST":
+			if obs.BlockedNetworkRuleList, err = parseNetworkRuleList(v); err != nil {
+				return networkv1alpha1.NetworkPolicyObservation{}, err
+			}
+		}
+	}
+	return obs, nil
+}
+
+// networkPolicyProperties renders all lists of p, including empty ones so
+// that entries removed from the spec are removed from the policy.
+func networkPolicyProperties(p *networkv1alpha1.NetworkPolicyParameters) string {
+	props := []string{
+		"ALLOWED_IP_LIST = " + QuoteStringList(p.AllowedIPList),
+		"BLOCKED_IP_LIST = " + QuoteStringList(p.BlockedIPList),
+		"ALLOWED_NETWORK_RULE_LIST = (" + strings.Join(p.AllowedNetworkRuleList, ", ") + ")",
+		"BLOCKED_NETWORK_RULE_LIST = (" + strings.Join(p.BlockedNetworkRuleList, ", ") + ")",
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return strings.Join(props, " ")
+}
+
+// CreateNetworkPolicy creates a network policy.
+func (c ClientInfo) CreateNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) error {
+	_, err := c.ExecuteStatement(ctx, "CREATE NETWORK POLICY "+QuoteIdentifier(p.Name)+" "+networkPolicyProperties(p))
+	return err
+}
+
+// UpdateNetworkPolicy replaces the lists and sets the comment of a network
+// policy.
+func (c ClientInfo) UpdateNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER NETWORK POLICY "+QuoteIdentifier(p.Name)+" SET "+networkPolicyProperties(p))
+	return err
+}
+
+// DeleteNetworkPolicy drops a network policy. Snowflake refuses to drop a
+// policy that is still set for the account or a user.
+func (c ClientInfo) DeleteNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP NETWORK POLICY IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
+
+// FetchNetworkPolicyAttachment reports whether the network policy of p is set
+// for the account and which of the given users it is set for. Users that do
+// not exist are skipped.
+func (c ClientInfo) FetchNetworkPolicyAttachment(ctx context.Context, p *networkv1alpha1.NetworkPolicyAttachmentParameters, users []string) (networkv1alpha1.NetworkPolicyAttachmentObservation, error) {
+	obs := networkv1alpha1.NetworkPolicyAttachmentObservation{}
+
+	params, err := c.showParameters(ctx, "ACCOUNT", "")
+	if err != nil {
+		return obs, err
+	}
+	obs.Account = strings.EqualFold(params[paramNetworkPolicy], p.NetworkPolicy)
+
+	for _, u := range users {
+		params, err := c.showParameters(ctx, "USER", QuoteIdentifier(u))
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return obs, err
+		}
+		if strings.EqualFold(params[paramNetworkPolicy], p.NetworkPolicy) {
+			obs.Users = append(obs.Users, u)
+		}
+	}
+	return obs, nil
+}
+
+// SetNetworkPolicy sets a network policy for the account, if account is set,
+// and for the given users.
+func (c ClientInfo) SetNetworkPolicy(ctx context.Context, policy string, account bool, users []string) error {
+	if account {
+		if _, err := c.ExecuteStatement(ctx, "ALTER ACCOUNT SET NETWORK_POLICY = "+QuoteIdentifier(policy)); err != nil {
+			return err
+		}
+	}
+	for _, u := range users {
+		if _, err := c.ExecuteStatement(ctx, "ALTER USER "+QuoteIdentifier(u)+" SET NETWORK_POLICY = "+QuoteIdentifier(policy)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnsetNetworkPolicy removes the network policy of the account, if account is
+// set, and of the given users.
+func (c ClientInfo) UnsetNetworkPolicy(ctx context.Context, account bool, users []string) error {
+	if account {
+		if _, err := c.ExecuteStatement(ctx, "ALTER ACCOUNT UNSET NETWORK_POLICY"); err != nil {
+			return err
+		}
+	}
+	for _, u := range users {
+		if _, err := c.ExecuteStatement(ctx, "ALTER USER IF EXISTS "+QuoteIdentifier(u)+" UNSET NETWORK_POLICY"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
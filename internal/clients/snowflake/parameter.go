@@ -0,0 +1,96 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	parameterv1alpha1 "github.com/allenkallz/provider-snowflake/apis/parameter/v1alpha1"
+)
+
+// showParameter returns the SHOW PARAMETERS row of a single parameter of the
+// account or of an object, e.g. IN WAREHOUSE LOADING, or ErrNotFound if
+// Snowflake has no such parameter.
+func (c ClientInfo) showParameter(ctx context.Context, key, in string) (Row, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW PARAMETERS LIKE "+QuoteString(key)+" IN "+in)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		if strings.EqualFold(r["key"], key) {
+			return r, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// parameterObject returns the object an ObjectParameter is set on, e.g.
+// SCHEMA SALES.PUBLIC.
+func parameterObject(p *parameterv1alpha1.ObjectParameterParameters) string {
+	return p.ObjectType + " " + QualifiedName(SplitQualifiedName(p.ObjectName)...)
+}
+
+// FetchAccountParameter returns the observed state of a parameter set at the
+// account level, or ErrNotFound if it is not set there.
+func (c ClientInfo) FetchAccountParameter(ctx context.Context, p *parameterv1alpha1.AccountParameterParameters) (parameterv1alpha1.AccountParameterObservation, error) {
+	row, err := c.showParameter(ctx, p.Key, "ACCOUNT")
+	if err != nil {
+		return parameterv1alpha1.AccountParameterObservation{}, err
+	}
+
+	// parameters left to their default report an empty level
+	if !strings.EqualFold(row["level"], "ACCOUNT") {
+		return parameterv1alpha1.AccountParameterObservation{}, ErrNotFound
+	}
+
+	return parameterv1alpha1.AccountParameterObservation{
+		Value:       row["value"],
+		Level:       row["level"],
+		Default:     row["default"],
+		Description: row["description"],
+	}, nil
+}
+
+// SetAccountParameter sets a parameter at the account level.
+func (c ClientInfo) SetAccountParameter(ctx context.Context, p *parameterv1alpha1.AccountParameterParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER ACCOUNT SET "+p.Key+" = "+parameterValue(p.Value))
+	return err
+}
+
+// UnsetAccountParameter resets a parameter of the account to its default.
+func (c ClientInfo) UnsetAccountParameter(ctx context.Context, p *parameterv1alpha1.AccountParameterParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER ACCOUNT UNSET "+p.Key)
+	return err
+}
+
+// FetchObjectParameter returns the observed state of a parameter set on an
+// object itself, or ErrNotFound if the object inherits it.
+func (c ClientInfo) FetchObjectParameter(ctx context.Context, p *parameterv1alpha1.ObjectParameterParameters) (parameterv1alpha1.ObjectParameterObservation, error) {
+	row, err := c.showParameter(ctx, p.Key, parameterObject(p))
+	if err != nil {
+		return parameterv1alpha1.ObjectParameterObservation{}, err
+	}
+
+	if !strings.EqualFold(row["level"], p.ObjectType) {
+		return parameterv1alpha1.ObjectParameterObservation{}, ErrNotFound
+	}
+
+	return parameterv1alpha1.ObjectParameterObservation{
+		Value:       row["value"],
+		Level:       row["level"],
+		Default:     row["default"],
+		Description: row["description"],
+	}, nil
+}
+
+// SetObjectParameter sets a parameter on an object.
+func (c ClientInfo) SetObjectParameter(ctx context.Context, p *parameterv1alpha1.ObjectParameterParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER "+parameterObject(p)+" SET "+p.Key+" = "+parameterValue(p.Value))
+	return err
+}
+
+// UnsetObjectParameter resets a parameter of an object to the value it
+// inherits.
+func (c ClientInfo) UnsetObjectParameter(ctx context.Context, p *parameterv1alpha1.ObjectParameterParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER "+parameterObject(p)+" UNSET "+p.Key)
+	return err
+}
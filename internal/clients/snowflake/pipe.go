@@ -0,0 +1,90 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	pipev1alpha1 "github.com/allenkallz/provider-snowflake/apis/pipe/v1alpha1"
+)
+
+func pipeName(p *pipev1alpha1.PipeParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchPipe returns the observed state of a pipe, or ErrNotFound.
+func (c ClientInfo) FetchPipe(ctx context.Context, p *pipev1alpha1.PipeParameters) (pipev1alpha1.PipeObservation, error) {
+	row, err := c.showObject(ctx, "PIPES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return pipev1alpha1.PipeObservation{}, err
+	}
+
+	return pipev1alpha1.PipeObservation{
+		NotificationChannel: row["notification_channel"],
+		Definition:          row["definition"],
+		ErrorIntegration:    row["error_integration"],
+		Comment:             row["comment"],
+		Owner:               row["owner"],
+		CreatedOn:           row["created_on"],
+	}, nil
+}
+
+// CreatePipe creates a pipe. With replace set an existing pipe is replaced,
+// which is the only way to change its COPY statement.
+func (c ClientInfo) CreatePipe(ctx context.Context, p *pipev1alpha1.PipeParameters, replace bool) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE ")
+	if replace {
+		b.WriteString("OR REPLACE ")
+	}
+	b.WriteString("PIPE " + pipeName(p))
+
+	if p.AutoIngest != nil {
+		b.WriteString(" AUTO_INGEST = " + FormatBool(*p.AutoIngest))
+	}
+	if p.AWSSNSTopic != nil {
+		b.WriteString(" AWS_SNS_TOPIC = " + QuoteString(*p.AWSSNSTopic))
+	}
+	if p.Integration != nil {
+		b.WriteString(" INTEGRATION = " + QuoteString(*p.Integration))
+	}
+	if p.ErrorIntegration != nil {
+		b.WriteString(" ERROR_INTEGRATION = " + QuoteIdentifier(*p.ErrorIntegration))
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+	b.WriteString(" AS " + p.CopyStatement)
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// UpdatePipe sets the alterable properties of a pipe.
+func (c ClientInfo) UpdatePipe(ctx context.Context, p *pipev1alpha1.PipeParameters) error {
+	var set []string
+	if p.ErrorIntegration != nil {
+		set = append(set, "ERROR_INTEGRATION = "+QuoteIdentifier(*p.ErrorIntegration))
+	}
+	if p.Comment != nil {
+		set = append(set, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	_, err := c.ExecuteStatement(ctx, "ALTER PIPE "+pipeName(p)+" SET "+strings.Join(set, " "))
+	return err
+}
+
+// RefreshPipe queues staged files that were not loaded yet.
+func (c ClientInfo) RefreshPipe(ctx context.Context, p *pipev1alpha1.PipeParameters) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER PIPE "+pipeName(p)+" REFRESH")
+	return err
+}
+
+// DeletePipe drops a pipe.
+func (c ClientInfo) DeletePipe(ctx context.Context, p *pipev1alpha1.PipeParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP PIPE IF EXISTS "+pipeName(p))
+	return err
+}
@@ -0,0 +1,267 @@
+package snowflake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	policyv1alpha1 "github.com/allenkallz/provider-snowflake/apis/policy/v1alpha1"
+)
+
+// policy kinds as reported by POLICY_REFERENCES
+const (
+	policyKindMasking   = "MASKING_POLICY"
+	policyKindRowAccess = "ROW_ACCESS_POLICY"
+)
+
+// policySignature renders the argument list of a policy, e.g. (val VARCHAR).
+func policySignature(args []policyv1alpha1.PolicyArgument) string {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = QuoteIdentifier(a.Name) + " " + a.Type
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// describePolicy returns the single row of DESC <objectType> <name>.
+func (c ClientInfo) describePolicy(ctx context.Context, objectType, name string) (Row, error) {
+	rows, err := c.ExecuteStatement(ctx, "DESC "+objectType+" "+name)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	return rows[0], nil
+}
+
+// updatePolicy sets the body of a policy if it changed, and its comment.
+func (c ClientInfo) updatePolicy(ctx context.Context, objectType, name, body, observedBody string, comment *string) error {
+	if NormalizeSQL(body) != NormalizeSQL(observedBody) {
+		if _, err := c.ExecuteStatement(ctx, "ALTER "+objectType+" "+name+" SET BODY -> "+body); err != nil {
+			return err
+		}
+	}
+	if comment != nil {
+		_, err := c.ExecuteStatement(ctx, "ALTER "+objectType+" "+name+" SET COMMENT = "+QuoteString(*comment))
+		return err
+	}
+	return nil
+}
+
+func maskingPolicyName(p *policyv1alpha1.MaskingPolicyParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchMaskingPolicy returns the observed state of a masking policy, or
+// ErrNotFound.
+func (c ClientInfo) FetchMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters) (policyv1alpha1.MaskingPolicyObservation, error) {
+	row, err := c.showObject(ctx, "MASKING POLICIES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return policyv1alpha1.MaskingPolicyObservation{}, err
+	}
+
+	// the signature and body are only returned by DESC
+	desc, err := c.describePolicy(ctx, "MASKING POLICY", maskingPolicyName(p))
+	if err != nil {
+		return policyv1alpha1.MaskingPolicyObservation{}, err
+	}
+
+	// options is a JSON object such as {"EXEMPT_OTHER_POLICIES": "TRUE"}
+	options := map[string]interface{}{}
+	if o := row["options"]; o != "" {
+		_ = json.Unmarshal([]byte(o), &options)
+	}
+
+	return policyv1alpha1.MaskingPolicyObservation{
+		Signature:           desc["signature"],
+		ReturnType:          desc["return_type"],
+		Body:                desc["body"],
+		ExemptOtherPolicies: strings.EqualFold(fmt.Sprint(options["EXEMPT_OTHER_POLICIES"]), "true"),
+		Comment:             row["comment"],
+		Owner:               row["owner"],
+		CreatedOn:           row["created_on"],
+	}, nil
+}
+
+// CreateMaskingPolicy creates a masking policy.
+func (c ClientInfo) CreateMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters) error {
+	stmt := "CREATE MASKING POLICY " + maskingPolicyName(p) + " AS " + policySignature(p.Signature) + " RETURNS " + p.ReturnType + " -> " + p.Body
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+	if p.ExemptOtherPolicies != nil {
+		stmt += " EXEMPT_OTHER_POLICIES = " + FormatBool(*p.ExemptOtherPolicies)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateMaskingPolicy sets the body and comment of a masking policy.
+func (c ClientInfo) UpdateMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters, obs policyv1alpha1.MaskingPolicyObservation) error {
+	return c.updatePolicy(ctx, "MASKING POLICY", maskingPolicyName(p), p.Body, obs.Body, p.Comment)
+}
+
+// DeleteMaskingPolicy drops a masking policy. Snowflake refuses to drop a
+// policy that is still set on a column.
+func (c ClientInfo) DeleteMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP MASKING POLICY IF EXISTS "+maskingPolicyName(p))
+	return err
+}
+
+func rowAccessPolicyName(p *policyv1alpha1.RowAccessPolicyParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchRowAccessPolicy returns the observed state of a row access policy, or
+// ErrNotFound.
+func (c ClientInfo) FetchRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters) (policyv1alpha1.RowAccessPolicyObservation, error) {
+	row, err := c.showObject(ctx, "ROW ACCESS POLICIES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return policyv1alpha1.RowAccessPolicyObservation{}, err
+	}
+
+	// the signature and body are only returned by DESC
+	desc, err := c.describePolicy(ctx, "ROW ACCESS POLICY", rowAccessPolicyName(p))
+	if err != nil {
+		return policyv1alpha1.RowAccessPolicyObservation{}, err
+	}
+
+	return policyv1alpha1.RowAccessPolicyObservation{
+		Signature: desc["signature"],
+		Body:      desc["body"],
+		Comment:   row["comment"],
+		Owner:     row["owner"],
+		CreatedOn: row["created_on"],
+	}, nil
+}
+
+// CreateRowAccessPolicy creates a row access policy.
+func (c ClientInfo) CreateRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters) error {
+	stmt := "CREATE ROW ACCESS POLICY " + rowAccessPolicyName(p) + " AS " + policySignature(p.Signature) + " RETURNS BOOLEAN -> " + p.Body
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateRowAccessPolicy sets the body and comment of a row access policy.
+func (c ClientInfo) UpdateRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters, obs policyv1alpha1.RowAccessPolicyObservation) error {
+	return c.updatePolicy(ctx, "ROW ACCESS POLICY", rowAccessPolicyName(p), p.Body, obs.Body, p.Comment)
+}
+
+// DeleteRowAccessPolicy drops a row access policy. Snowflake refuses to drop
+// a policy that is still added to a table or view.
+func (c ClientInfo) DeleteRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP ROW ACCESS POLICY IF EXISTS "+rowAccessPolicyName(p))
+	return err
+}
+
+// attachedObject returns the identifier of the table or view a policy is
+// attached to.
+func attachedObject(p *policyv1alpha1.PolicyAttachmentParameters) string {
+	return QualifiedName(SplitQualifiedName(p.Object)...)
+}
+
+// attachedPolicy returns the identifier of a policy named in a
+// PolicyAttachment or observed attached to its object.
+func attachedPolicy(name string) string {
+	return QualifiedName(SplitQualifiedName(name)...)
+}
+
+// alterAttachedObject returns the ALTER statement prefix for the table or view
+// a policy is attached to.
+func alterAttachedObject(p *policyv1alpha1.PolicyAttachmentParameters) string {
+	objectType := p.ObjectType
+	if objectType == "" {
+		objectType = "TABLE"
+	}
+	return "ALTER " + objectType + " " + attachedObject(p)
+}
+
+// FetchPolicyAttachment returns the policy attached to the column or object
+// of p, or ErrNotFound if no policy of the kind of p is attached.
+func (c ClientInfo) FetchPolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters) (policyv1alpha1.PolicyAttachmentObservation, error) {
+	domain := strings.ToLower(p.ObjectType)
+	if domain == "" {
+		domain = "table"
+	}
+	database := QuoteIdentifier(SplitQualifiedName(p.Object)[0])
+
+	rows, err := c.ExecuteStatement(ctx, fmt.Sprintf(
+		"SELECT POLICY_DB, POLICY_SCHEMA, POLICY_NAME, POLICY_KIND, REF_COLUMN_NAME, REF_ARG_COLUMN_NAMES, POLICY_STATUS "+
+			"FROM TABLE(%s.INFORMATION_SCHEMA.POLICY_REFERENCES(REF_ENTITY_NAME => %s, REF_ENTITY_DOMAIN => %s))",
+		database, QuoteString(p.Object), QuoteString(domain)))
+	if err != nil {
+		return policyv1alpha1.PolicyAttachmentObservation{}, err
+	}
+
+	for _, r := range rows {
+		switch {
+		case p.Column != nil && r["POLICY_KIND"] == policyKindMasking && strings.EqualFold(r["REF_COLUMN_NAME"], *p.Column):
+		case p.Column == nil && r["POLICY_KIND"] == policyKindRowAccess:
+		default:
+			continue
+		}
+		return policyv1alpha1.PolicyAttachmentObservation{
+			Policy:  QualifiedName(r["POLICY_DB"], r["POLICY_SCHEMA"], r["POLICY_NAME"]),
+			Columns: parseJSONList(r["REF_ARG_COLUMN_NAMES"]),
+			Status:  r["POLICY_STATUS"],
+		}, nil
+	}
+	return policyv1alpha1.PolicyAttachmentObservation{}, ErrNotFound
+}
+
+// CreatePolicyAttachment sets a masking policy on a column or adds a row
+// access policy to a table or view.
+func (c ClientInfo) CreatePolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters) error {
+	if p.Column != nil {
+		return c.setMaskingPolicy(ctx, p, p.Force != nil && *p.Force)
+	}
+
+	_, err := c.ExecuteStatement(ctx, alterAttachedObject(p)+" ADD ROW ACCESS POLICY "+attachedPolicy(p.RowAccessPolicy)+" ON ("+IdentifierList(p.Columns)+")")
+	return err
+}
+
+// UpdatePolicyAttachment replaces the policy attached to the column or object
+// of p.
+func (c ClientInfo) UpdatePolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters, obs policyv1alpha1.PolicyAttachmentObservation) error {
+	if p.Column != nil {
+		// the attached policy is ours, so it can be replaced
+		return c.setMaskingPolicy(ctx, p, true)
+	}
+
+	_, err := c.ExecuteStatement(ctx, alterAttachedObject(p)+
+		" DROP ROW ACCESS POLICY "+attachedPolicy(obs.Policy)+
+		", ADD ROW ACCESS POLICY "+attachedPolicy(p.RowAccessPolicy)+" ON ("+IdentifierList(p.Columns)+")")
+	return err
+}
+
+func (c ClientInfo) setMaskingPolicy(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters, force bool) error {
+	stmt := alterAttachedObject(p) + " MODIFY COLUMN " + QuoteIdentifier(*p.Column) + " SET MASKING POLICY " + attachedPolicy(p.MaskingPolicy)
+	if len(p.Columns) > 0 {
+		stmt += " USING (" + IdentifierList(append([]string{*p.Column}, p.Columns...)) + ")"
+	}
+	if force {
+		stmt += " FORCE"
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// DeletePolicyAttachment unsets a masking policy from a column or drops a row
+// access policy from a table or view.
+func (c ClientInfo) DeletePolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters) error {
+	stmt := alterAttachedObject(p) + " DROP ROW ACCESS POLICY " + attachedPolicy(p.RowAccessPolicy)
+	if p.Column != nil {
+		stmt = alterAttachedObject(p) + " MODIFY COLUMN " + QuoteIdentifier(*p.Column) + " UNSET MASKING POLICY"
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	policyv1alpha1 "github.com/allenkallz/provider-snowflake/apis/policy/v1alpha1"
+)
+
+func TestFetchPolicyAttachment(t *testing.T) {
+	api := &fakeSQLAPI{Rows: func(string) ([]Row, error) {
+		return []Row{{
+			"POLICY_DB":            "GOVERNANCE",
+			"POLICY_SCHEMA":        "PUBLIC",
+			"POLICY_NAME":          "region.filter",
+			"POLICY_KIND":          policyKindRowAccess,
+			"REF_ARG_COLUMN_NAMES": `["REGION"]`,
+			"POLICY_STATUS":        "ACTIVE",
+		}}, nil
+	}}
+	c := newTestClient(t, api)
+
+	got, err := c.FetchPolicyAttachment(context.Background(), &policyv1alpha1.PolicyAttachmentParameters{Object: "RAW.PUBLIC.ORDERS"})
+	if err != nil {
+		t.Fatalf("c.FetchPolicyAttachment(...): %v", err)
+	}
+	want := policyv1alpha1.PolicyAttachmentObservation{Policy: `GOVERNANCE.PUBLIC."region.filter"`, Columns: []string{"REGION"}, Status: "ACTIVE"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("c.FetchPolicyAttachment(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestPolicyAttachmentStatements(t *testing.T) {
+	rowAccess := policyv1alpha1.PolicyAttachmentParameters{
+		RowAccessPolicy: "GOVERNANCE.PUBLIC.region filter",
+		Object:          "RAW.PUBLIC.ORDERS",
+		Columns:         []string{"REGION"},
+	}
+	masking := policyv1alpha1.PolicyAttachmentParameters{
+		MaskingPolicy: "GOVERNANCE.PUBLIC.ssn mask",
+		Object:        "RAW.PUBLIC.CUSTOMERS",
+		Column:        ptr.To("SSN"),
+	}
+
+	cases := map[string]struct {
+		reason string
+		run    func(c ClientInfo) error
+		want   []string
+	}{
+		"AddRowAccessPolicy": {
+			reason: "Row access policy names should be quoted so that they cannot extend the statement.",
+			run: func(c ClientInfo) error {
+				return c.CreatePolicyAttachment(context.Background(), &rowAccess)
+			},
+			want: []string{`ALTER TABLE RAW.PUBLIC.ORDERS ADD ROW ACCESS POLICY GOVERNANCE.PUBLIC."region filter" ON (REGION)`},
+		},
+		"ReplaceRowAccessPolicy": {
+			reason: "The observed and the desired row access policy should both be quoted.",
+			run: func(c ClientInfo) error {
+				return c.UpdatePolicyAttachment(context.Background(), &rowAccess, policyv1alpha1.PolicyAttachmentObservation{Policy: `GOVERNANCE.PUBLIC."region.filter"`})
+			},
+			want: []string{`ALTER TABLE RAW.PUBLIC.ORDERS DROP ROW ACCESS POLICY GOVERNANCE.PUBLIC."region.filter", ADD ROW ACCESS POLICY GOVERNANCE.PUBLIC."region filter" ON (REGION)`},
+		},
+		"DropRowAccessPolicy": {
+			reason: "Row access policy names should be quoted when dropped.",
+			run: func(c ClientInfo) error {
+				return c.DeletePolicyAttachment(context.Background(), &rowAccess)
+			},
+			want: []string{`ALTER TABLE RAW.PUBLIC.ORDERS DROP ROW ACCESS POLICY GOVERNANCE.PUBLIC."region filter"`},
+		},
+		"SetMaskingPolicy": {
+			reason: "Masking policy names should be quoted so that they cannot extend the statement.",
+			run: func(c ClientInfo) error {
+				return c.CreatePolicyAttachment(context.Background(), &masking)
+			},
+			want: []string{`ALTER TABLE RAW.PUBLIC.CUSTOMERS MODIFY COLUMN SSN SET MASKING POLICY GOVERNANCE.PUBLIC."ssn mask"`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			if err := tc.run(newTestClient(t, api)); err != nil {
+				t.Fatalf("\n%s\n%v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\n-want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
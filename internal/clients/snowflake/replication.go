@@ -0,0 +1,322 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	replicationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/replication/v1alpha1"
+)
+
+const (
+	replicationGroup = "REPLICATION"
+	failoverGroup    = "FAILOVER"
+)
+
+// groupKeyword returns the SQL keyword of a group type, e.g. FAILOVER GROUP.
+func groupKeyword(t string) string {
+	if t == "" {
+		t = replicationGroup
+	}
+	return t + " GROUP"
+}
+
+// keywords turns enum values into SQL keywords, e.g. ACCOUNT_PARAMETERS into
+// ACCOUNT PARAMETERS.
+func keywords(values []string) string {
+	words := make([]string, len(values))
+	for i, v := range values {
+		words[i] = strings.ReplaceAll(v, "_", " ")
+	}
+	return strings.Join(words, ", ")
+}
+
+// enumList parses a list of keywords of SHOW output into enum values, the
+// reverse of keywords.
+func enumList(s string) []string {
+	values := splitList(s)
+	for i, v := range values {
+		values[i] = strings.ReplaceAll(strings.ToUpper(v), " ", "_")
+	}
+	return values
+}
+
+// sameSchedule reports whether two replication schedules are the same,
+// ignoring case and spacing.
+func sameSchedule(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
+
+// currentAccount returns the name of the account connected to, as
+// organization.account.
+func (c ClientInfo) currentAccount(ctx context.Context) (string, error) {
+	rows, err := c.ExecuteStatement(ctx, "SELECT CURRENT_ORGANIZATION_NAME() || '.' || CURRENT_ACCOUNT_NAME() AS ACCOUNT")
+	if err != nil {
+		return "", err
+	}
+	if len(rows) == 0 {
+		return "", ErrNotFound
+	}
+	return rows[0]["ACCOUNT"], nil
+}
+
+// showGroup returns the SHOW output of the primary or secondary group of the
+// given type in the account connected to, and the name of that account. SHOW
+// lists the groups of every account of the organization.
+func (c ClientInfo) showGroup(ctx context.Context, t, name string, primary bool) (Row, string, error) {
+	account, err := c.currentAccount(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows, err := c.ExecuteStatement(ctx, "SHOW "+groupKeyword(t)+"S")
+	if err != nil {
+		return nil, "", err
+	}
+	for _, r := range rows {
+		if strings.EqualFold(r["name"], name) &&
+			strings.EqualFold(r["is_primary"], FormatBool(primary)) &&
+			strings.EqualFold(r["organization_name"]+"."+r["account_name"], account) {
+			return r, account, nil
+		}
+	}
+	return nil, "", ErrNotFound
+}
+
+// ReplicationGroupUpToDate reports whether a primary group matches p.
+func ReplicationGroupUpToDate(p *replicationv1alpha1.ReplicationGroupParameters, obs replicationv1alpha1.ReplicationGroupObservation) bool {
+	switch {
+	case !SameNames(p.ObjectTypes, obs.ObjectTypes),
+		!SameNames(p.Databases, obs.Databases),
+		!SameNames(p.AllowedAccounts, obs.AllowedAccounts):
+		return false
+	case len(p.AllowedIntegrationTypes) > 0 && !SameNames(p.AllowedIntegrationTypes, obs.AllowedIntegrationTypes):
+		return false
+	case p.ReplicationSchedule != nil && !sameSchedule(*p.ReplicationSchedule, obs.ReplicationSchedule):
+		return false
+	}
+	return true
+}
+
+// FailoverGroupUpToDate reports whether a primary failover group matches p.
+func FailoverGroupUpToDate(p *replicationv1alpha1.FailoverGroupParameters, obs replicationv1alpha1.FailoverGroupObservation) bool {
+	rp := replicationv1alpha1.ReplicationGroupParameters(*p)
+	return ReplicationGroupUpToDate(&rp, replicationv1alpha1.ReplicationGroupObservation(obs))
+}
+
+// fetchPrimaryGroup returns the observed state of a primary group of the given
+// type, or ErrNotFound. Failover groups share the fields of replication
+// groups.
+func (c ClientInfo) fetchPrimaryGroup(ctx context.Context, t string, p *replicationv1alpha1.ReplicationGroupParameters) (replicationv1alpha1.ReplicationGroupObservation, error) {
+	row, account, err := c.showGroup(ctx, t, p.Name, true)
+	if err != nil {
+		return replicationv1alpha1.ReplicationGroupObservation{}, err
+	}
+
+	obs := replicationv1alpha1.ReplicationGroupObservation{
+		ObjectTypes:             enumList(row["object_types"]),
+		AllowedIntegrationTypes: enumList(row["allowed_integration_types"]),
+		ReplicationSchedule:     row["replication_schedule"],
+		NextScheduledRefresh:    row["next_scheduled_refresh"],
+		Owner:                   row["owner"],
+		CreatedOn:               row["created_on"],
+	}
+
+	// the account of the primary group is always allowed
+	for _, a := range splitList(row["allowed_accounts"]) {
+		if !strings.EqualFold(a, account) {
+			obs.AllowedAccounts = append(obs.AllowedAccounts, a)
+		}
+	}
+
+	dbs, err := c.ExecuteStatement(ctx, "SHOW DATABASES IN "+groupKeyword(t)+" "+QuoteIdentifier(p.Name))
+	if err != nil {
+		return replicationv1alpha1.ReplicationGroupObservation{}, err
+	}
+	for _, db := range dbs {
+		obs.Databases = append(obs.Databases, db["name"])
+	}
+	return obs, nil
+}
+
+func (c ClientInfo) createPrimaryGroup(ctx context.Context, t string, p *replicationv1alpha1.ReplicationGroupParameters) error {
+	stmt := "CREATE " + groupKeyword(t) + " " + QuoteIdentifier(p.Name) + " OBJECT_TYPES = " + keywords(p.ObjectTypes)
+	if len(p.Databases) > 0 {
+		stmt += " ALLOWED_DATABASES = " + IdentifierList(p.Databases)
+	}
+	if len(p.AllowedIntegrationTypes) > 0 {
+		stmt += " ALLOWED_INTEGRATION_TYPES = " + keywords(p.AllowedIntegrationTypes)
+	}
+	stmt += " ALLOWED_ACCOUNTS = " + strings.Join(p.AllowedAccounts, ", ")
+	if p.ReplicationSchedule != nil {
+		stmt += " REPLICATION_SCHEDULE = " + QuoteString(*p.ReplicationSchedule)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// updatePrimaryGroup sets the object types, integration types and schedule of
+// a primary group, and adds and removes its databases and allowed accounts.
+func (c ClientInfo) updatePrimaryGroup(ctx context.Context, t string, p *replicationv1alpha1.ReplicationGroupParameters, obs replicationv1alpha1.ReplicationGroupObservation) error {
+	alter := "ALTER " + groupKeyword(t) + " " + QuoteIdentifier(p.Name)
+
+	var set []string
+	if !SameNames(p.ObjectTypes, obs.ObjectTypes) {
+		set = append(set, "OBJECT_TYPES = "+keywords(p.ObjectTypes))
+	}
+	if len(p.AllowedIntegrationTypes) > 0 && !SameNames(p.AllowedIntegrationTypes, obs.AllowedIntegrationTypes) {
+		set = append(set, "ALLOWED_INTEGRATION_TYPES = "+keywords(p.AllowedIntegrationTypes))
+	}
+	if p.ReplicationSchedule != nil && !sameSchedule(*p.ReplicationSchedule, obs.ReplicationSchedule) {
+		set = append(set, "REPLICATION_SCHEDULE = "+QuoteString(*p.ReplicationSchedule))
+	}
+
+	var stmts []string
+	if len(set) > 0 {
+		stmts = append(stmts, alter+" SET "+strings.Join(set, " "))
+	}
+
+	added, removed := NameDiff(p.Databases, obs.Databases)
+	if len(added) > 0 {
+		stmts = append(stmts, alter+" ADD "+IdentifierList(added)+" TO ALLOWED_DATABASES")
+	}
+	if len(removed) > 0 {
+		stmts = append(stmts, alter+" REMOVE "+IdentifierList(removed)+" FROM ALLOWED_DATABASES")
+	}
+
+	added, removed = NameDiff(p.AllowedAccounts, obs.AllowedAccounts)
+	if len(added) > 0 {
+		stmts = append(stmts, alter+" ADD "+strings.Join(added, ", ")+" TO ALLOWED_ACCOUNTS")
+	}
+	if len(removed) > 0 {
+		stmts = append(stmts, alter+" REMOVE "+strings.Join(removed, ", ")+" FROM ALLOWED_ACCOUNTS")
+	}
+
+	for _, s := range stmts {
+		if _, err := c.ExecuteStatement(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c ClientInfo) dropGroup(ctx context.Context, t, name string) error {
+	_, err := c.ExecuteStatement(ctx, "DROP "+groupKeyword(t)+" IF EXISTS "+QuoteIdentifier(name))
+	return err
+}
+
+// FetchReplicationGroup returns the observed state of a primary replication
+// group, or ErrNotFound.
+func (c ClientInfo) FetchReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters) (replicationv1alpha1.ReplicationGroupObservation, error) {
+	return c.fetchPrimaryGroup(ctx, replicationGroup, p)
+}
+
+// CreateReplicationGroup creates a primary replication group.
+func (c ClientInfo) CreateReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters) error {
+	return c.createPrimaryGroup(ctx, replicationGroup, p)
+}
+
+// UpdateReplicationGroup updates a primary replication group to match p.
+func (c ClientInfo) UpdateReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters, obs replicationv1alpha1.ReplicationGroupObservation) error {
+	return c.updatePrimaryGroup(ctx, replicationGroup, p, obs)
+}
+
+// DeleteReplicationGroup drops a primary replication group.
+func (c ClientInfo) DeleteReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters) error {
+	return c.dropGroup(ctx, replicationGroup, p.Name)
+}
+
+// FetchFailoverGroup returns the observed state of a primary failover group,
+// or ErrNotFound.
+func (c ClientInfo) FetchFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters) (replicationv1alpha1.FailoverGroupObservation, error) {
+	rp := replicationv1alpha1.ReplicationGroupParameters(*p)
+	obs, err := c.fetchPrimaryGroup(ctx, failoverGroup, &rp)
+	return replicationv1alpha1.FailoverGroupObservation(obs), err
+}
+
+// CreateFailoverGroup creates a primary failover group.
+func (c ClientInfo) CreateFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters) error {
+	rp := replicationv1alpha1.ReplicationGroupParameters(*p)
+	return c.createPrimaryGroup(ctx, failoverGroup, &rp)
+}
+
+// UpdateFailoverGroup updates a primary failover group to match p.
+func (c ClientInfo) UpdateFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters, obs replicationv1alpha1.FailoverGroupObservation) error {
+	rp := replicationv1alpha1.ReplicationGroupParameters(*p)
+	return c.updatePrimaryGroup(ctx, failoverGroup, &rp, replicationv1alpha1.ReplicationGroupObservation(obs))
+}
+
+// DeleteFailoverGroup drops a primary failover group.
+func (c ClientInfo) DeleteFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters) error {
+	return c.dropGroup(ctx, failoverGroup, p.Name)
+}
+
+// FetchSecondaryGroup returns the observed state of a secondary group, or
+// ErrNotFound, along with its last completed refresh.
+func (c ClientInfo) FetchSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) (replicationv1alpha1.SecondaryGroupObservation, error) {
+	row, _, err := c.showGroup(ctx, p.Type, p.Name, false)
+	if err != nil {
+		return replicationv1alpha1.SecondaryGroupObservation{}, err
+	}
+
+	obs := replicationv1alpha1.SecondaryGroupObservation{
+		Primary:              row["primary"],
+		SecondaryState:       row["secondary_state"],
+		NextScheduledRefresh: row["next_scheduled_refresh"],
+		Owner:                row["owner"],
+		CreatedOn:            row["created_on"],
+	}
+
+	rows, err := c.ExecuteStatement(ctx, fmt.Sprintf(
+		"SELECT END_TIME, DATEDIFF('second', PRIMARY_SNAPSHOT_TIMESTAMP, CURRENT_TIMESTAMP()) AS LAG "+
+			"FROM TABLE(SNOWFLAKE.INFORMATION_SCHEMA.REPLICATION_GROUP_REFRESH_HISTORY(%s)) "+
+			"WHERE PHASE_NAME = 'COMPLETED' ORDER BY END_TIME DESC LIMIT 1",
+		QuoteString(p.Name)))
+	if err != nil {
+		return replicationv1alpha1.SecondaryGroupObservation{}, err
+	}
+	if len(rows) > 0 {
+		obs.LastRefreshedOn = rows[0]["END_TIME"]
+		if lag, err := strconv.ParseInt(rows[0]["LAG"], 10, 64); err == nil {
+			obs.LagSeconds = &lag
+		}
+	}
+	return obs, nil
+}
+
+// CreateSecondaryGroup creates a secondary group replicating its primary
+// group. Secondaries of groups with a replication schedule are refreshed once
+// created.
+func (c ClientInfo) CreateSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) error {
+	stmt := "CREATE " + groupKeyword(p.Type) + " " + QuoteIdentifier(p.Name) + " AS REPLICA OF " + p.Primary
+	if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+		return err
+	}
+
+	if p.Suspended != nil && *p.Suspended {
+		return c.UpdateSecondaryGroup(ctx, p)
+	}
+	return nil
+}
+
+// UpdateSecondaryGroup suspends or resumes the scheduled refreshes of a
+// secondary group.
+func (c ClientInfo) UpdateSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) error {
+	if p.Suspended == nil {
+		return nil
+	}
+
+	action := "RESUME"
+	if *p.Suspended {
+		action = "SUSPEND"
+	}
+	_, err := c.ExecuteStatement(ctx, "ALTER "+groupKeyword(p.Type)+" "+QuoteIdentifier(p.Name)+" "+action)
+	return err
+}
+
+// DeleteSecondaryGroup drops a secondary group.
+func (c ClientInfo) DeleteSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) error {
+	return c.dropGroup(ctx, p.Type, p.Name)
+}
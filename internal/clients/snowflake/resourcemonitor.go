@@ -0,0 +1,187 @@
+package snowflake
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	rmv1alpha1 "github.com/allenkallz/provider-snowflake/apis/resourcemonitor/v1alpha1"
+)
+
+// ResourceMonitorTriggersUpToDate reports whether the observed thresholds of
+// a monitor match the triggers in p.
+func ResourceMonitorTriggersUpToDate(p *rmv1alpha1.ResourceMonitorParameters, obs rmv1alpha1.ResourceMonitorObservation) bool {
+	want := map[string][]int{}
+	for _, t := range p.Triggers {
+		want[t.Action] = append(want[t.Action], t.Threshold)
+	}
+
+	observed := map[string]string{
+		"NOTIFY":            obs.NotifyAt,
+		"SUSPEND":           obs.SuspendAt,
+		"SUSPEND_IMMEDIATE": obs.SuspendImmediatelyAt,
+	}
+	for action, s := range observed {
+		if !sameThresholds(want[action], s) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameThresholds compares thresholds with a list such as 50%,75%.
+func sameThresholds(want []int, observed string) bool {
+	var have []int
+	for _, t := range splitList(observed) {
+		n, err := strconv.Atoi(strings.TrimSuffix(t, "%"))
+		if err != nil {
+			return false
+		}
+		have = append(have, n)
+	}
+	if len(want) != len(have) {
+		return false
+	}
+
+	want = append([]int{}, want...)
+	sort.Ints(want)
+	sort.Ints(have)
+	for i := range want {
+		if want[i] != have[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceMonitorTriggers(triggers []rmv1alpha1.ResourceMonitorTrigger) string {
+	defs := make([]string, len(triggers))
+	for i, t := range triggers {
+		defs[i] = "ON " + strconv.Itoa(t.Threshold) + " PERCENT DO " + t.Action
+	}
+	return strings.Join(defs, " ")
+}
+
+// FetchResourceMonitor returns the observed state of a resource monitor, or
+// ErrNotFound. Only the warehouses of p and the given known warehouses, such
+// as those observed before, are checked for the monitor, so that observing it
+// does not list every warehouse of the account.
+func (c ClientInfo) FetchResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters, known []string) (rmv1alpha1.ResourceMonitorObservation, error) {
+	row, err := c.showObject(ctx, "RESOURCE MONITORS", p.Name, "")
+	if err != nil {
+		return rmv1alpha1.ResourceMonitorObservation{}, err
+	}
+
+	obs := rmv1alpha1.ResourceMonitorObservation{
+		CreditQuota:          nullable(row["credit_quota"]),
+		UsedCredits:          nullable(row["used_credits"]),
+		RemainingCredits:     nullable(row["remaining_credits"]),
+		Level:                nullable(row["level"]),
+		Frequency:            row["frequency"],
+		StartTime:            nullable(row["start_time"]),
+		EndTime:              nullable(row["end_time"]),
+		NotifyAt:             nullable(row["notify_at"]),
+		SuspendAt:            nullable(row["suspend_at"]),
+		SuspendImmediatelyAt: nullable(row["suspend_immediately_at"]),
+		NotifyUsers:          splitList(row["notify_users"]),
+		Owner:                row["owner"],
+		CreatedOn:            row["created_on"],
+	}
+
+	added, _ := NameDiff(known, p.Warehouses)
+	for _, name := range append(append([]string{}, p.Warehouses...), added...) {
+		w, err := c.showObject(ctx, "WAREHOUSES", name, "")
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return rmv1alpha1.ResourceMonitorObservation{}, err
+		}
+		if strings.EqualFold(w["resource_monitor"], p.Name) {
+			obs.Warehouses = append(obs.Warehouses, w["name"])
+		}
+	}
+	return obs, nil
+}
+
+// resourceMonitorProperties renders the properties of p. The frequency and
+// start timestamp are only included if withSchedule is set.
+func resourceMonitorProperties(p *rmv1alpha1.ResourceMonitorParameters, withSchedule bool) []string {
+	var props []string
+	if p.CreditQuota != nil {
+		props = append(props, "CREDIT_QUOTA = "+strconv.Itoa(*p.CreditQuota))
+	}
+	if withSchedule && p.Frequency != nil && p.StartTimestamp != nil {
+		props = append(props, "FREQUENCY = "+*p.Frequency, "START_TIMESTAMP = "+QuoteString(*p.StartTimestamp))
+	}
+	if p.EndTimestamp != nil {
+		props = append(props, "END_TIMESTAMP = "+QuoteString(*p.EndTimestamp))
+	}
+	if p.NotifyUsers != nil {
+		props = append(props, "NOTIFY_USERS = ("+IdentifierList(p.NotifyUsers)+")")
+	}
+	return props
+}
+
+// CreateResourceMonitor creates a resource monitor and assigns it to its
+// warehouses.
+func (c ClientInfo) CreateResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters) error {
+	stmt := "CREATE RESOURCE MONITOR " + QuoteIdentifier(p.Name)
+	if props := resourceMonitorProperties(p, true); len(props) > 0 {
+		stmt += " WITH " + strings.Join(props, " ")
+	}
+	if len(p.Triggers) > 0 {
+		stmt += " TRIGGERS " + resourceMonitorTriggers(p.Triggers)
+	}
+
+	if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+		return err
+	}
+	return c.assignResourceMonitor(ctx, p.Name, p.Warehouses, nil)
+}
+
+// UpdateResourceMonitor sets the properties and triggers of a resource monitor
+// and moves it to the desired warehouses.
+func (c ClientInfo) UpdateResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters, obs rmv1alpha1.ResourceMonitorObservation) error {
+	frequencyChanged := p.Frequency != nil && !strings.EqualFold(*p.Frequency, obs.Frequency)
+
+	stmt := ""
+	if props := resourceMonitorProperties(p, frequencyChanged); len(props) > 0 {
+		stmt += " SET " + strings.Join(props, " ")
+	}
+	if len(p.Triggers) > 0 && !ResourceMonitorTriggersUpToDate(p, obs) {
+		stmt += " TRIGGERS " + resourceMonitorTriggers(p.Triggers)
+	}
+	if stmt != "" {
+		if _, err := c.ExecuteStatement(ctx, "ALTER RESOURCE MONITOR "+QuoteIdentifier(p.Name)+stmt); err != nil {
+			return err
+		}
+	}
+
+	added, removed := NameDiff(p.Warehouses, obs.Warehouses)
+	return c.assignResourceMonitor(ctx, p.Name, added, removed)
+}
+
+func (c ClientInfo) assignResourceMonitor(ctx context.Context, name string, assign, unassign []string) error {
+	for _, w := range assign {
+		if _, err := c.ExecuteStatement(ctx, "ALTER WAREHOUSE "+QuoteIdentifier(w)+" SET RESOURCE_MONITOR = "+QuoteIdentifier(name)); err != nil {
+			return err
+		}
+	}
+	for _, w := range unassign {
+		if _, err := c.ExecuteStatement(ctx, "ALTER WAREHOUSE "+QuoteIdentifier(w)+" UNSET RESOURCE_MONITOR"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteResourceMonitor drops a resource monitor, which also unassigns it from
+// its warehouses.
+func (c ClientInfo) DeleteResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP RESOURCE MONITOR IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	rmv1alpha1 "github.com/allenkallz/provider-snowflake/apis/resourcemonitor/v1alpha1"
+)
+
+func TestFetchResourceMonitorWarehouses(t *testing.T) {
+	rows := map[string]Row{
+		"SHOW RESOURCE MONITORS LIKE 'budget'": {"name": "BUDGET", "frequency": "MONTHLY"},
+		"SHOW WAREHOUSES LIKE 'etl'":           {"name": "ETL", "resource_monitor": "BUDGET"},
+		"SHOW WAREHOUSES LIKE 'bi'":            {"name": "BI", "resource_monitor": "null"},
+		"SHOW WAREHOUSES LIKE 'ADHOC'":         {"name": "ADHOC", "resource_monitor": "BUDGET"},
+	}
+
+	cases := map[string]struct {
+		reason     string
+		warehouses []string
+		known      []string
+		want       []string
+		statements []string
+	}{
+		"Scoped": {
+			reason:     "Only the warehouses of the spec and those observed before should be shown.",
+			warehouses: []string{"etl", "bi"},
+			known:      []string{"ETL", "ADHOC"},
+			want:       []string{"ETL", "ADHOC"},
+			statements: []string{
+				"SHOW RESOURCE MONITORS LIKE 'budget'",
+				"SHOW WAREHOUSES LIKE 'etl'",
+				"SHOW WAREHOUSES LIKE 'bi'",
+				"SHOW WAREHOUSES LIKE 'ADHOC'",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{Rows: func(stmt string) ([]Row, error) {
+				if r, ok := rows[stmt]; ok {
+					return []Row{r}, nil
+				}
+				return nil, nil
+			}}
+			c := newTestClient(t, api)
+			p := &rmv1alpha1.ResourceMonitorParameters{Name: "budget", Warehouses: tc.warehouses}
+			got, err := c.FetchResourceMonitor(context.Background(), p, tc.known)
+			if err != nil {
+				t.Fatalf("\n%s\nc.FetchResourceMonitor(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got.Warehouses); diff != "" {
+				t.Errorf("\n%s\nc.FetchResourceMonitor(...): -want warehouses, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.statements, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.FetchResourceMonitor(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
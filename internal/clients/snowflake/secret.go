@@ -0,0 +1,107 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	secretv1alpha1 "github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1"
+)
+
+// SecretValues holds the values of a secret read from Kubernetes Secrets.
+// Only those matching the type of the secret are set.
+type SecretValues struct {
+	SecretString string
+	Password     string
+	RefreshToken string
+}
+
+func secretName(p *secretv1alpha1.SecretParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchSecret returns the observed state of a secret, or ErrNotFound. The
+// secret values themselves cannot be read back.
+func (c ClientInfo) FetchSecret(ctx context.Context, p *secretv1alpha1.SecretParameters) (secretv1alpha1.SecretObservation, error) {
+	if _, err := c.showObject(ctx, "SECRETS", p.Name, schemaScope(p.Database, p.Schema)); err != nil {
+		return secretv1alpha1.SecretObservation{}, err
+	}
+
+	// the user name, integration and token expiry are only returned by DESC
+	rows, err := c.ExecuteStatement(ctx, "DESC SECRET "+secretName(p))
+	if err != nil {
+		return secretv1alpha1.SecretObservation{}, err
+	}
+	if len(rows) == 0 {
+		return secretv1alpha1.SecretObservation{}, ErrNotFound
+	}
+
+	r := rows[0]
+	return secretv1alpha1.SecretObservation{
+		Type:                        r["secret_type"],
+		Username:                    r["username"],
+		APIAuthentication:           r["integration_name"],
+		OAuthScopes:                 splitList(r["oauth_scopes"]),
+		OAuthRefreshTokenExpiryTime: r["oauth_refresh_token_expiry_time"],
+		Comment:                     r["comment"],
+		Owner:                       r["owner"],
+		CreatedOn:                   r["created_on"],
+	}, nil
+}
+
+// secretProperties renders the properties of p and v that can be set on
+// creation as well as altered.
+func secretProperties(p *secretv1alpha1.SecretParameters, v SecretValues) []string {
+	var props []string
+	switch p.Type {
+	case secretv1alpha1.SecretTypeGenericString:
+		props = append(props, "SECRET_STRING = "+QuoteString(v.SecretString))
+	case secretv1alpha1.SecretTypePassword:
+		if p.Username != nil {
+			props = append(props, "USERNAME = "+QuoteString(*p.Username))
+		}
+		props = append(props, "PASSWORD = "+QuoteString(v.Password))
+	case secretv1alpha1.SecretTypeOAuth2:
+		if p.OAuthScopes != nil {
+			props = append(props, "OAUTH_SCOPES = "+QuoteStringList(p.OAuthScopes))
+		}
+		if p.OAuthRefreshTokenSecretRef != nil {
+			props = append(props, "OAUTH_REFRESH_TOKEN = "+QuoteString(v.RefreshToken))
+		}
+		if p.OAuthRefreshTokenExpiryTime != nil {
+			props = append(props, "OAUTH_REFRESH_TOKEN_EXPIRY_TIME = "+QuoteString(*p.OAuthRefreshTokenExpiryTime))
+		}
+	}
+	if p.Comment != nil {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// CreateSecret creates a secret holding the given values.
+func (c ClientInfo) CreateSecret(ctx context.Context, p *secretv1alpha1.SecretParameters, v SecretValues) error {
+	props := []string{"TYPE = " + p.Type}
+	if p.APIAuthentication != nil {
+		props = append(props, "API_AUTHENTICATION = "+QuoteIdentifier(*p.APIAuthentication))
+	}
+	props = append(props, secretProperties(p, v)...)
+
+	_, err := c.ExecuteStatement(ctx, "CREATE SECRET "+secretName(p)+" "+strings.Join(props, " "))
+	return err
+}
+
+// UpdateSecret sets the values and properties of a secret.
+func (c ClientInfo) UpdateSecret(ctx context.Context, p *secretv1alpha1.SecretParameters, v SecretValues) error {
+	props := secretProperties(p, v)
+	if len(props) == 0 {
+		return nil
+	}
+
+	_, err := c.ExecuteStatement(ctx, "ALTER SECRET "+secretName(p)+" SET "+strings.Join(props, " "))
+	return err
+}
+
+// DeleteSecret drops a secret.
+func (c ClientInfo) DeleteSecret(ctx context.Context, p *secretv1alpha1.SecretParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP SECRET IF EXISTS "+secretName(p))
+	return err
+}
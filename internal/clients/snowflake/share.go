@@ -0,0 +1,169 @@
+package snowflake
+
+import (
+	"context"
+	"strings"
+
+	sharev1alpha1 "github.com/allenkallz/provider-snowflake/apis/share/v1alpha1"
+)
+
+// shareGrants returns the privileges p grants to the share.
+func shareGrants(p *sharev1alpha1.ShareParameters) []objectGrant {
+	if p.Database == "" {
+		return nil
+	}
+
+	grants := []objectGrant{{Privilege: "USAGE", ObjectType: "DATABASE", Name: p.Database}}
+	for _, s := range p.Schemas {
+		grants = append(grants, objectGrant{Privilege: "USAGE", ObjectType: "SCHEMA", Name: QualifiedName(p.Database, s)})
+	}
+	for _, o := range p.Objects {
+		t := o.Type
+		if t == "" {
+			t = "TABLE"
+		}
+		grants = append(grants, objectGrant{Privilege: "SELECT", ObjectType: t, Name: o.Name})
+	}
+	return grants
+}
+
+// ShareGrantsUpToDate reports whether the privileges granted to a share match
+// those of p.
+func ShareGrantsUpToDate(p *sharev1alpha1.ShareParameters, obs sharev1alpha1.ShareObservation) bool {
+	var want []string
+	for _, g := range shareGrants(p) {
+		want = append(want, g.key())
+	}
+	return SameNames(want, obs.Grants)
+}
+
+// FetchShare returns the observed state of an outbound share, or ErrNotFound.
+func (c ClientInfo) FetchShare(ctx context.Context, p *sharev1alpha1.ShareParameters) (sharev1alpha1.ShareObservation, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW SHARES LIKE "+QuoteString(p.Name))
+	if err != nil {
+		return sharev1alpha1.ShareObservation{}, err
+	}
+
+	// outbound shares are named by the account owning them, e.g. ORG.ACCT.NAME
+	var row Row
+	for _, r := range rows {
+		parts := SplitQualifiedName(r["name"])
+		if r["kind"] == "OUTBOUND" && strings.EqualFold(parts[len(parts)-1], p.Name) {
+			row = r
+			break
+		}
+	}
+	if row == nil {
+		return sharev1alpha1.ShareObservation{}, ErrNotFound
+	}
+
+	obs := sharev1alpha1.ShareObservation{
+		Database:  row["database_name"],
+		Accounts:  splitList(row["to"]),
+		Comment:   row["comment"],
+		Owner:     row["owner"],
+		CreatedOn: row["created_on"],
+	}
+
+	grants, err := c.shareGrantsOf(ctx, p.Name)
+	if err != nil {
+		return sharev1alpha1.ShareObservation{}, err
+	}
+	for _, g := range grants {
+		obs.Grants = append(obs.Grants, g.key())
+	}
+	return obs, nil
+}
+
+// shareGrantsOf returns the privileges granted to a share, in the order
+// Snowflake lists them.
+func (c ClientInfo) shareGrantsOf(ctx context.Context, share string) ([]objectGrant, error) {
+	rows, err := c.ExecuteStatement(ctx, "SHOW GRANTS TO SHARE "+QuoteIdentifier(share))
+	if err != nil {
+		return nil, err
+	}
+	grants := make([]objectGrant, 0, len(rows))
+	for _, g := range rows {
+		grants = append(grants, objectGrant{Privilege: g["privilege"], ObjectType: g["granted_on"], Name: g["name"]})
+	}
+	return grants, nil
+}
+
+// CreateShare creates a share, grants it the privileges of p and adds its
+// consumer accounts.
+func (c ClientInfo) CreateShare(ctx context.Context, p *sharev1alpha1.ShareParameters) error {
+	stmt := "CREATE SHARE " + QuoteIdentifier(p.Name)
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+	if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+		return err
+	}
+
+	return c.UpdateShare(ctx, p, sharev1alpha1.ShareObservation{})
+}
+
+// UpdateShare grants and revokes privileges of a share, adds and removes its
+// consumer accounts and sets its comment. Privileges are granted before
+// accounts are added and revoked after accounts are removed, as Snowflake
+// only lets accounts be added to a share with a database.
+func (c ClientInfo) UpdateShare(ctx context.Context, p *sharev1alpha1.ShareParameters, obs sharev1alpha1.ShareObservation) error {
+	name := QuoteIdentifier(p.Name)
+
+	have := map[string]bool{}
+	for _, k := range obs.Grants {
+		have[k] = true
+	}
+	var stmts []string
+	want := map[string]bool{}
+	for _, g := range shareGrants(p) {
+		want[g.key()] = true
+		if !have[g.key()] {
+			stmts = append(stmts, "GRANT "+g.on()+" TO SHARE "+name)
+		}
+	}
+
+	added, removed := NameDiff(p.Accounts, obs.Accounts)
+	if len(added) > 0 {
+		stmts = append(stmts, "ALTER SHARE "+name+" ADD ACCOUNTS = "+strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		stmts = append(stmts, "ALTER SHARE "+name+" REMOVE ACCOUNTS = "+strings.Join(removed, ", "))
+	}
+
+	// observed grants are keyed in upper case, so the grants to revoke are
+	// read again to keep the case of quoted names
+	stale := false
+	for _, k := range obs.Grants {
+		stale = stale || !want[k]
+	}
+	if stale {
+		grants, err := c.shareGrantsOf(ctx, p.Name)
+		if err != nil {
+			return err
+		}
+		// revoke objects before the schemas and database holding them
+		for i := len(grants) - 1; i >= 0; i-- {
+			if g := grants[i]; !want[g.key()] {
+				stmts = append(stmts, "REVOKE "+g.on()+" FROM SHARE "+name)
+			}
+		}
+	}
+
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		stmts = append(stmts, "ALTER SHARE "+name+" SET COMMENT = "+QuoteString(*p.Comment))
+	}
+
+	for _, s := range stmts {
+		if _, err := c.ExecuteStatement(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteShare drops a share, revoking access of its consumer accounts.
+func (c ClientInfo) DeleteShare(ctx context.Context, p *sharev1alpha1.ShareParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP SHARE IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	sharev1alpha1 "github.com/allenkallz/provider-snowflake/apis/share/v1alpha1"
+)
+
+func TestUpdateShare(t *testing.T) {
+	granted := []Row{
+		{"privilege": "USAGE", "granted_on": "DATABASE", "name": "SALES"},
+		{"privilege": "USAGE", "granted_on": "SCHEMA", "name": "SALES.PUBLIC"},
+		{"privilege": "SELECT", "granted_on": "TABLE", "name": `SALES.PUBLIC."Orders"`},
+		{"privilege": "SELECT", "granted_on": "TABLE", "name": "SALES.PUBLIC.CUSTOMERS"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      sharev1alpha1.ShareParameters
+		want   []string
+	}{
+		"UpToDate": {
+			reason: "Nothing should be granted or revoked when the grants match.",
+			p: sharev1alpha1.ShareParameters{
+				Name:     "sales_share",
+				Database: "SALES",
+				Schemas:  []string{"PUBLIC"},
+				Objects: []sharev1alpha1.ShareObject{
+					{Name: `SALES.PUBLIC."Orders"`},
+					{Name: "SALES.PUBLIC.CUSTOMERS"},
+				},
+			},
+			want: []string{},
+		},
+		"RevokeQuoted": {
+			reason: "Grants should be revoked by their observed names, keeping the case of quoted names.",
+			p: sharev1alpha1.ShareParameters{
+				Name:     "sales_share",
+				Database: "SALES",
+				Schemas:  []string{"PUBLIC"},
+				Objects:  []sharev1alpha1.ShareObject{{Name: "SALES.PUBLIC.CUSTOMERS"}},
+			},
+			want: []string{`REVOKE SELECT ON TABLE SALES.PUBLIC."Orders" FROM SHARE sales_share`},
+		},
+		"RevokeAll": {
+			reason: "Objects should be revoked before the schemas and database holding them.",
+			p:      sharev1alpha1.ShareParameters{Name: "sales_share"},
+			want: []string{
+				"REVOKE SELECT ON TABLE SALES.PUBLIC.CUSTOMERS FROM SHARE sales_share",
+				`REVOKE SELECT ON TABLE SALES.PUBLIC."Orders" FROM SHARE sales_share`,
+				"REVOKE USAGE ON SCHEMA SALES.PUBLIC FROM SHARE sales_share",
+				"REVOKE USAGE ON DATABASE SALES FROM SHARE sales_share",
+			},
+		},
+	}
+
+	var obs sharev1alpha1.ShareObservation
+	for _, g := range granted {
+		obs.Grants = append(obs.Grants, objectGrant{Privilege: g["privilege"], ObjectType: g["granted_on"], Name: g["name"]}.key())
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{Rows: func(s string) ([]Row, error) {
+				if strings.HasPrefix(s, "SHOW GRANTS") {
+					return granted, nil
+				}
+				return nil, nil
+			}}
+			c := newTestClient(t, api)
+			if err := c.UpdateShare(context.Background(), &tc.p, obs); err != nil {
+				t.Fatalf("\n%s\nc.UpdateShare(...): %v\n", tc.reason, err)
+			}
+			got := []string{}
+			for _, s := range api.statements() {
+				if !strings.HasPrefix(s, "SHOW ") {
+					got = append(got, s)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nc.UpdateShare(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
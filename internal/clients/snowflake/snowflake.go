@@ -8,9 +8,31 @@ import (
 	"strings"
 	"time"
 
+	accountv1alpha1 "github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	alertv1alpha1 "github.com/allenkallz/provider-snowflake/apis/alert/v1alpha1"
 	dbv1alpha1 "github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	externalvolumev1alpha1 "github.com/allenkallz/provider-snowflake/apis/externalvolume/v1alpha1"
+	ffv1alpha1 "github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1"
+	functionv1alpha1 "github.com/allenkallz/provider-snowflake/apis/function/v1alpha1"
+	grantv1alpha1 "github.com/allenkallz/provider-snowflake/apis/grant/v1alpha1"
+	integrationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	networkv1alpha1 "github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	parameterv1alpha1 "github.com/allenkallz/provider-snowflake/apis/parameter/v1alpha1"
+	pipev1alpha1 "github.com/allenkallz/provider-snowflake/apis/pipe/v1alpha1"
+	policyv1alpha1 "github.com/allenkallz/provider-snowflake/apis/policy/v1alpha1"
+	replicationv1alpha1 "github.com/allenkallz/provider-snowflake/apis/replication/v1alpha1"
+	rmv1alpha1 "github.com/allenkallz/provider-snowflake/apis/resourcemonitor/v1alpha1"
+	secretv1alpha1 "github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1"
+	sharev1alpha1 "github.com/allenkallz/provider-snowflake/apis/share/v1alpha1"
+	stagev1alpha1 "github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1"
+	streamv1alpha1 "github.com/allenkallz/provider-snowflake/apis/stream/v1alpha1"
+	tablev1alpha1 "github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+	tagv1alpha1 "github.com/allenkallz/provider-snowflake/apis/tag/v1alpha1"
+	taskv1alpha1 "github.com/allenkallz/provider-snowflake/apis/task/v1alpha1"
+	whv1alpha1 "github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1"
 
 	"github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	corev1 "k8s.io/api/core/v1"
@@ -33,6 +55,43 @@ var ErrBadRequest = errors.New("Bad request")
 type Client interface {
 	// TableClient
 	DatabaseClient
+	StageClient
+	FileFormatClient
+	PipeClient
+	StreamClient
+	TaskClient
+	ResourceMonitorClient
+	WarehouseClient
+	NetworkRuleClient
+	NetworkPolicyClient
+	NetworkPolicyAttachmentClient
+	StorageIntegrationClient
+	ApiIntegrationClient
+	NotificationIntegrationClient
+	ExternalAccessIntegrationClient
+	SecretClient
+	MaskingPolicyClient
+	RowAccessPolicyClient
+	PolicyAttachmentClient
+	TagClient
+	TagAssociationClient
+	ShareClient
+	ReplicationGroupClient
+	FailoverGroupClient
+	SecondaryGroupClient
+	FunctionClient
+	ProcedureClient
+	AlertClient
+	AccountParameterClient
+	ObjectParameterClient
+	AccountClient
+	DynamicTableClient
+	ExternalTableClient
+	IcebergTableClient
+	ExternalVolumeClient
+	CatalogIntegrationClient
+	DatabaseRoleClient
+	GrantOwnershipClient
 }
 
 type DatabaseClient interface {
@@ -41,6 +100,272 @@ type DatabaseClient interface {
 	CreateDatabase(ctx context.Context, db *dbv1alpha1.DatabaseParameters) (string, error)
 	DeleteDatabase(ctx context.Context, db *dbv1alpha1.DatabaseParameters) error
 	UpdateDatabase(ctx context.Context, dbinfo DbInfo)
+	FetchTags(ctx context.Context, objectType, object string, tags []string) (map[string]string, error)
+	SetTags(ctx context.Context, objectType, object string, set map[string]string, unset []string) error
+}
+
+type StageClient interface {
+	FetchStage(ctx context.Context, p *stagev1alpha1.StageParameters) (stagev1alpha1.StageObservation, error)
+	CreateStage(ctx context.Context, p *stagev1alpha1.StageParameters, s StageSecrets) error
+	UpdateStage(ctx context.Context, p *stagev1alpha1.StageParameters, s StageSecrets) error
+	DeleteStage(ctx context.Context, p *stagev1alpha1.StageParameters) error
+}
+
+type FileFormatClient interface {
+	FetchFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters) (FileFormatInfo, error)
+	CreateFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters) error
+	UpdateFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters, nonDefault []string) error
+	DeleteFileFormat(ctx context.Context, p *ffv1alpha1.FileFormatParameters) error
+}
+
+type PipeClient interface {
+	FetchPipe(ctx context.Context, p *pipev1alpha1.PipeParameters) (pipev1alpha1.PipeObservation, error)
+	CreatePipe(ctx context.Context, p *pipev1alpha1.PipeParameters, replace bool) error
+	UpdatePipe(ctx context.Context, p *pipev1alpha1.PipeParameters) error
+	RefreshPipe(ctx context.Context, p *pipev1alpha1.PipeParameters) error
+	DeletePipe(ctx context.Context, p *pipev1alpha1.PipeParameters) error
+}
+
+type StreamClient interface {
+	FetchStream(ctx context.Context, p *streamv1alpha1.StreamParameters) (streamv1alpha1.StreamObservation, error)
+	CreateStream(ctx context.Context, p *streamv1alpha1.StreamParameters) error
+	UpdateStream(ctx context.Context, p *streamv1alpha1.StreamParameters) error
+	DeleteStream(ctx context.Context, p *streamv1alpha1.StreamParameters) error
+}
+
+type TaskClient interface {
+	FetchTask(ctx context.Context, p *taskv1alpha1.TaskParameters) (taskv1alpha1.TaskObservation, error)
+	CreateTask(ctx context.Context, p *taskv1alpha1.TaskParameters) error
+	UpdateTask(ctx context.Context, p *taskv1alpha1.TaskParameters, obs taskv1alpha1.TaskObservation) error
+	SuspendTask(ctx context.Context, name string) error
+	ResumeTask(ctx context.Context, name string) error
+	RootTasks(ctx context.Context, p *taskv1alpha1.TaskParameters, predecessors []string) ([]RootTask, error)
+	DeleteTask(ctx context.Context, p *taskv1alpha1.TaskParameters) error
+}
+
+type ResourceMonitorClient interface {
+	FetchResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters, known []string) (rmv1alpha1.ResourceMonitorObservation, error)
+	CreateResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters) error
+	UpdateResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters, obs rmv1alpha1.ResourceMonitorObservation) error
+	DeleteResourceMonitor(ctx context.Context, p *rmv1alpha1.ResourceMonitorParameters) error
+}
+
+type WarehouseClient interface {
+	FetchWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters) (whv1alpha1.WarehouseObservation, error)
+	CreateWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters) error
+	UpdateWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters, obs whv1alpha1.WarehouseObservation) error
+	DeleteWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters) error
+}
+
+type NetworkRuleClient interface {
+	FetchNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) (networkv1alpha1.NetworkRuleObservation, error)
+	CreateNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) error
+	UpdateNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) error
+	DeleteNetworkRule(ctx context.Context, p *networkv1alpha1.NetworkRuleParameters) error
+}
+
+type NetworkPolicyClient interface {
+	FetchNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) (networkv1alpha1.NetworkPolicyObservation, error)
+	CreateNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) error
+	UpdateNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) error
+	DeleteNetworkPolicy(ctx context.Context, p *networkv1alpha1.NetworkPolicyParameters) error
+}
+
+type NetworkPolicyAttachmentClient interface {
+	FetchNetworkPolicyAttachment(ctx context.Context, p *networkv1alpha1.NetworkPolicyAttachmentParameters, users []string) (networkv1alpha1.NetworkPolicyAttachmentObservation, error)
+	SetNetworkPolicy(ctx context.Context, policy string, account bool, users []string) error
+	UnsetNetworkPolicy(ctx context.Context, account bool, users []string) error
+}
+
+type StorageIntegrationClient interface {
+	FetchStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters) (integrationv1alpha1.StorageIntegrationObservation, error)
+	CreateStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters) error
+	UpdateStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters, obs integrationv1alpha1.StorageIntegrationObservation) error
+	DeleteStorageIntegration(ctx context.Context, p *integrationv1alpha1.StorageIntegrationParameters) error
+}
+
+type ApiIntegrationClient interface {
+	FetchApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters) (integrationv1alpha1.ApiIntegrationObservation, error)
+	CreateApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters, apiKey string) error
+	UpdateApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters, obs integrationv1alpha1.ApiIntegrationObservation, apiKey string) error
+	DeleteApiIntegration(ctx context.Context, p *integrationv1alpha1.ApiIntegrationParameters) error
+}
+
+type NotificationIntegrationClient interface {
+	FetchNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) (integrationv1alpha1.NotificationIntegrationObservation, error)
+	CreateNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) error
+	UpdateNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) error
+	DeleteNotificationIntegration(ctx context.Context, p *integrationv1alpha1.NotificationIntegrationParameters) error
+}
+
+type ExternalAccessIntegrationClient interface {
+	FetchExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) (integrationv1alpha1.ExternalAccessIntegrationObservation, error)
+	CreateExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) error
+	UpdateExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) error
+	DeleteExternalAccessIntegration(ctx context.Context, p *integrationv1alpha1.ExternalAccessIntegrationParameters) error
+}
+
+type SecretClient interface {
+	FetchSecret(ctx context.Context, p *secretv1alpha1.SecretParameters) (secretv1alpha1.SecretObservation, error)
+	CreateSecret(ctx context.Context, p *secretv1alpha1.SecretParameters, v SecretValues) error
+	UpdateSecret(ctx context.Context, p *secretv1alpha1.SecretParameters, v SecretValues) error
+	DeleteSecret(ctx context.Context, p *secretv1alpha1.SecretParameters) error
+}
+
+type MaskingPolicyClient interface {
+	FetchMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters) (policyv1alpha1.MaskingPolicyObservation, error)
+	CreateMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters) error
+	UpdateMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters, obs policyv1alpha1.MaskingPolicyObservation) error
+	DeleteMaskingPolicy(ctx context.Context, p *policyv1alpha1.MaskingPolicyParameters) error
+}
+
+type RowAccessPolicyClient interface {
+	FetchRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters) (policyv1alpha1.RowAccessPolicyObservation, error)
+	CreateRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters) error
+	UpdateRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters, obs policyv1alpha1.RowAccessPolicyObservation) error
+	DeleteRowAccessPolicy(ctx context.Context, p *policyv1alpha1.RowAccessPolicyParameters) error
+}
+
+type PolicyAttachmentClient interface {
+	FetchPolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters) (policyv1alpha1.PolicyAttachmentObservation, error)
+	CreatePolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters) error
+	UpdatePolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters, obs policyv1alpha1.PolicyAttachmentObservation) error
+	DeletePolicyAttachment(ctx context.Context, p *policyv1alpha1.PolicyAttachmentParameters) error
+}
+
+type TagClient interface {
+	FetchTag(ctx context.Context, p *tagv1alpha1.TagParameters) (tagv1alpha1.TagObservation, error)
+	CreateTag(ctx context.Context, p *tagv1alpha1.TagParameters) error
+	UpdateTag(ctx context.Context, p *tagv1alpha1.TagParameters, obs tagv1alpha1.TagObservation) error
+	DeleteTag(ctx context.Context, p *tagv1alpha1.TagParameters) error
+}
+
+type TagAssociationClient interface {
+	FetchTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) (tagv1alpha1.TagAssociationObservation, error)
+	CreateTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) error
+	UpdateTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) error
+	DeleteTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) error
+}
+
+type ShareClient interface {
+	FetchShare(ctx context.Context, p *sharev1alpha1.ShareParameters) (sharev1alpha1.ShareObservation, error)
+	CreateShare(ctx context.Context, p *sharev1alpha1.ShareParameters) error
+	UpdateShare(ctx context.Context, p *sharev1alpha1.ShareParameters, obs sharev1alpha1.ShareObservation) error
+	DeleteShare(ctx context.Context, p *sharev1alpha1.ShareParameters) error
+}
+
+type ReplicationGroupClient interface {
+	FetchReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters) (replicationv1alpha1.ReplicationGroupObservation, error)
+	CreateReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters) error
+	UpdateReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters, obs replicationv1alpha1.ReplicationGroupObservation) error
+	DeleteReplicationGroup(ctx context.Context, p *replicationv1alpha1.ReplicationGroupParameters) error
+}
+
+type FailoverGroupClient interface {
+	FetchFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters) (replicationv1alpha1.FailoverGroupObservation, error)
+	CreateFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters) error
+	UpdateFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters, obs replicationv1alpha1.FailoverGroupObservation) error
+	DeleteFailoverGroup(ctx context.Context, p *replicationv1alpha1.FailoverGroupParameters) error
+}
+
+type SecondaryGroupClient interface {
+	FetchSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) (replicationv1alpha1.SecondaryGroupObservation, error)
+	CreateSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) error
+	UpdateSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) error
+	DeleteSecondaryGroup(ctx context.Context, p *replicationv1alpha1.SecondaryGroupParameters) error
+}
+
+type FunctionClient interface {
+	FetchFunction(ctx context.Context, identifier string) (functionv1alpha1.FunctionObservation, error)
+	CreateFunction(ctx context.Context, p *functionv1alpha1.FunctionParameters) error
+	ReplaceFunction(ctx context.Context, p *functionv1alpha1.FunctionParameters, previous string) error
+	DeleteFunction(ctx context.Context, identifier string) error
+}
+
+type ProcedureClient interface {
+	FetchProcedure(ctx context.Context, identifier string) (functionv1alpha1.ProcedureObservation, error)
+	CreateProcedure(ctx context.Context, p *functionv1alpha1.ProcedureParameters) error
+	ReplaceProcedure(ctx context.Context, p *functionv1alpha1.ProcedureParameters, previous string) error
+	DeleteProcedure(ctx context.Context, identifier string) error
+}
+
+type AlertClient interface {
+	FetchAlert(ctx context.Context, p *alertv1alpha1.AlertParameters) (alertv1alpha1.AlertObservation, error)
+	CreateAlert(ctx context.Context, p *alertv1alpha1.AlertParameters) error
+	UpdateAlert(ctx context.Context, p *alertv1alpha1.AlertParameters, obs alertv1alpha1.AlertObservation) error
+	SuspendAlert(ctx context.Context, name string) error
+	ResumeAlert(ctx context.Context, name string) error
+	DeleteAlert(ctx context.Context, p *alertv1alpha1.AlertParameters) error
+}
+
+type AccountParameterClient interface {
+	FetchAccountParameter(ctx context.Context, p *parameterv1alpha1.AccountParameterParameters) (parameterv1alpha1.AccountParameterObservation, error)
+	SetAccountParameter(ctx context.Context, p *parameterv1alpha1.AccountParameterParameters) error
+	UnsetAccountParameter(ctx context.Context, p *parameterv1alpha1.AccountParameterParameters) error
+}
+
+type ObjectParameterClient interface {
+	FetchObjectParameter(ctx context.Context, p *parameterv1alpha1.ObjectParameterParameters) (parameterv1alpha1.ObjectParameterObservation, error)
+	SetObjectParameter(ctx context.Context, p *parameterv1alpha1.ObjectParameterParameters) error
+	UnsetObjectParameter(ctx context.Context, p *parameterv1alpha1.ObjectParameterParameters) error
+}
+
+type AccountClient interface {
+	FetchAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) (accountv1alpha1.AccountObservation, error)
+	CreateAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) error
+	UpdateAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) error
+	DeleteAccount(ctx context.Context, p *accountv1alpha1.AccountParameters) error
+}
+
+type DynamicTableClient interface {
+	FetchDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) (tablev1alpha1.DynamicTableObservation, error)
+	CreateDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) error
+	ReplaceDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) error
+	UpdateDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters, obs tablev1alpha1.DynamicTableObservation) error
+	SuspendDynamicTable(ctx context.Context, name string) error
+	ResumeDynamicTable(ctx context.Context, name string) error
+	DeleteDynamicTable(ctx context.Context, p *tablev1alpha1.DynamicTableParameters) error
+}
+
+type ExternalTableClient interface {
+	FetchExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) (tablev1alpha1.ExternalTableObservation, error)
+	CreateExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) error
+	RefreshExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) error
+	DeleteExternalTable(ctx context.Context, p *tablev1alpha1.ExternalTableParameters) error
+}
+
+type IcebergTableClient interface {
+	FetchIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) (tablev1alpha1.IcebergTableObservation, error)
+	CreateIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) error
+	RefreshIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) error
+	DeleteIcebergTable(ctx context.Context, p *tablev1alpha1.IcebergTableParameters) error
+}
+
+type ExternalVolumeClient interface {
+	FetchExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters) (externalvolumev1alpha1.ExternalVolumeObservation, error)
+	CreateExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters) error
+	UpdateExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters, obs externalvolumev1alpha1.ExternalVolumeObservation) error
+	DeleteExternalVolume(ctx context.Context, p *externalvolumev1alpha1.ExternalVolumeParameters) error
+}
+
+type CatalogIntegrationClient interface {
+	FetchCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters) (integrationv1alpha1.CatalogIntegrationObservation, error)
+	CreateCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters, clientSecret string) error
+	UpdateCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters, clientSecret string) error
+	DeleteCatalogIntegration(ctx context.Context, p *integrationv1alpha1.CatalogIntegrationParameters) error
+}
+
+type DatabaseRoleClient interface {
+	FetchDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters) (dbv1alpha1.DatabaseRoleObservation, error)
+	CreateDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters) error
+	UpdateDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters, obs dbv1alpha1.DatabaseRoleObservation) error
+	DeleteDatabaseRole(ctx context.Context, p *dbv1alpha1.DatabaseRoleParameters) error
+}
+
+type GrantOwnershipClient interface {
+	FetchGrantOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) (grantv1alpha1.GrantOwnershipObservation, error)
+	CreateGrantOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) error
+	DeleteGrantOwnership(ctx context.Context, p *grantv1alpha1.GrantOwnershipParameters) error
 }
 
 type ClientInfo struct {
@@ -48,6 +373,10 @@ type ClientInfo struct {
 	Username         string
 	FingerPrint      string
 	PrivateKey       string
+	Role             string
+	Warehouse        string
+	Database         string
+	Schema           string
 	httpClient       *http.Client
 }
 
@@ -92,6 +421,10 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		Username:         strings.ToUpper(pc.Spec.Username),
 		FingerPrint:      fingerPrint,
 		PrivateKey:       privateKey,
+		Role:             pc.Spec.Role,
+		Warehouse:        pc.Spec.Warehouse,
+		Database:         pc.Spec.Database,
+		Schema:           pc.Spec.Schema,
 		httpClient:       &http.Client{},
 	}, nil
 }
@@ -112,6 +445,41 @@ func authFromCredentials(ctx context.Context, c client.Client, creds v1alpha1.Pr
 	return string(s.Data[csr.Key]), nil
 }
 
+// GetSecretData returns all key/value pairs of the referenced secret.
+func GetSecretData(ctx context.Context, c client.Client, ref xpv1.SecretReference) (map[string]string, error) {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, "cannot get secret")
+	}
+
+	data := make(map[string]string, len(s.Data))
+	for k, v := range s.Data {
+		data[k] = string(v)
+	}
+	return data, nil
+}
+
+// GetSecretValue returns the value of the selected key of a secret.
+func GetSecretValue(ctx context.Context, c client.Client, sel xpv1.SecretKeySelector) (string, error) {
+	v, _, err := GetSecretValueVersion(ctx, c, sel)
+	return v, err
+}
+
+// GetSecretValueVersion returns the value of the selected key of a secret and
+// the resource version of the secret, which changes with its value.
+func GetSecretValueVersion(ctx context.Context, c client.Client, sel xpv1.SecretKeySelector) (string, string, error) {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: sel.Namespace, Name: sel.Name}, s); err != nil {
+		return "", "", errors.Wrap(err, "cannot get secret")
+	}
+
+	v, ok := s.Data[sel.Key]
+	if !ok {
+		return "", "", errors.Errorf("secret %s/%s has no key %q", sel.Namespace, sel.Name, sel.Key)
+	}
+	return string(v), s.GetResourceVersion(), nil
+}
+
 // Generate JWT Token
 // ToDo :  return active token if exist
 func generateJWT(c ClientInfo) (string, error) {
@@ -0,0 +1,190 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	stagev1alpha1 "github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1"
+)
+
+// StageSecrets are the sensitive stage values read from Kubernetes secrets.
+type StageSecrets struct {
+	// stage credentials keyed by credential name, e.g. AWS_KEY_ID
+	Credentials map[string]string
+
+	// client-side encryption master key
+	MasterKey string
+}
+
+func stageName(p *stagev1alpha1.StageParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchStage returns the observed state of a stage, or ErrNotFound.
+func (c ClientInfo) FetchStage(ctx context.Context, p *stagev1alpha1.StageParameters) (stagev1alpha1.StageObservation, error) {
+	row, err := c.showObject(ctx, "STAGES", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return stagev1alpha1.StageObservation{}, err
+	}
+
+	return stagev1alpha1.StageObservation{
+		Type:               row["type"],
+		URL:                row["url"],
+		Cloud:              row["cloud"],
+		Region:             row["region"],
+		StorageIntegration: row["storage_integration"],
+		HasCredentials:     row["has_credentials"] == "Y",
+		HasEncryptionKey:   row["has_encryption_key"] == "Y",
+		DirectoryEnabled:   row["directory_enabled"] == "Y",
+		Comment:            row["comment"],
+		Owner:              row["owner"],
+		CreatedOn:          row["created_on"],
+	}, nil
+}
+
+// CreateStage creates a stage.
+func (c ClientInfo) CreateStage(ctx context.Context, p *stagev1alpha1.StageParameters, s StageSecrets) error {
+	props, err := stageProperties(p, s)
+	if err != nil {
+		return err
+	}
+	stmt := "CREATE STAGE " + stageName(p) + props
+
+	if d := p.Directory; d != nil {
+		opts := []string{"ENABLE = " + FormatBool(d.Enable)}
+		if d.AutoRefresh != nil {
+			opts = append(opts, "AUTO_REFRESH = "+FormatBool(*d.AutoRefresh))
+		}
+		if d.RefreshOnCreate != nil {
+			opts = append(opts, "REFRESH_ON_CREATE = "+FormatBool(*d.RefreshOnCreate))
+		}
+		if d.NotificationIntegration != nil {
+			opts = append(opts, "NOTIFICATION_INTEGRATION = "+QuoteString(*d.NotificationIntegration))
+		}
+		stmt += " DIRECTORY = (" + strings.Join(opts, " ") + ")"
+	}
+
+	_, err = c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateStage alters a stage to match the given parameters. The file format
+// and the directory table options other than ENABLE are not observed and are
+// immutable.
+func (c ClientInfo) UpdateStage(ctx context.Context, p *stagev1alpha1.StageParameters, s StageSecrets) error {
+	// the encryption of an internal stage is fixed at creation
+	q := *p
+	if q.URL == nil {
+		q.Encryption = nil
+	}
+
+	props, err := stageProperties(&q, s)
+	if err != nil {
+		return err
+	}
+	if props != "" {
+		if _, err := c.ExecuteStatement(ctx, "ALTER STAGE "+stageName(p)+" SET"+props); err != nil {
+			return err
+		}
+	}
+
+	if d := p.Directory; d != nil {
+		stmt := fmt.Sprintf("ALTER STAGE %s SET DIRECTORY = (ENABLE = %s)", stageName(p), FormatBool(d.Enable))
+		if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteStage drops a stage.
+func (c ClientInfo) DeleteStage(ctx context.Context, p *stagev1alpha1.StageParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP STAGE IF EXISTS "+stageName(p))
+	return err
+}
+
+// stageProperties renders the properties shared by CREATE STAGE and
+// ALTER STAGE ... SET.
+func stageProperties(p *stagev1alpha1.StageParameters, s StageSecrets) (string, error) {
+	var b strings.Builder
+
+	if p.URL != nil {
+		b.WriteString(" URL = " + QuoteString(*p.URL))
+	}
+	if p.StorageIntegration != nil {
+		b.WriteString(" STORAGE_INTEGRATION = " + QuoteIdentifier(*p.StorageIntegration))
+	}
+	if len(s.Credentials) > 0 {
+		b.WriteString(" CREDENTIALS = (" + keyValueList(s.Credentials) + ")")
+	}
+	if e := p.Encryption; e != nil {
+		opts := []string{"TYPE = " + QuoteString(e.Type)}
+		if s.MasterKey != "" {
+			opts = append(opts, "MASTER_KEY = "+QuoteString(s.MasterKey))
+		}
+		if e.KMSKeyID != nil {
+			opts = append(opts, "KMS_KEY_ID = "+QuoteString(*e.KMSKeyID))
+		}
+		b.WriteString(" ENCRYPTION = (" + strings.Join(opts, " ") + ")")
+	}
+	if f := p.FileFormat; f != nil {
+		ff, err := stageFileFormat(f)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(" FILE_FORMAT = (" + ff + ")")
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+	return b.String(), nil
+}
+
+// stageFileFormat renders the inline file format of a stage or external
+// table. Option values are quoted unless they are numbers, booleans or
+// format keywords.
+func stageFileFormat(f *stagev1alpha1.StageFileFormat) (string, error) {
+	if f.FormatName != nil {
+		return "FORMAT_NAME = " + QuoteString(*f.FormatName), nil
+	}
+
+	opts := []string{"TYPE = " + strings.ToUpper(*f.Type)}
+	for _, k := range sortedKeys(f.Options) {
+		if !optionName.MatchString(k) {
+			return "", errors.Errorf("invalid file format option name %q", k)
+		}
+		key := strings.ToUpper(k)
+		opts = append(opts, key+" = "+formatOptionValue(key, f.Options[k]))
+	}
+	return strings.Join(opts, " "), nil
+}
+
+// formatOptionValue renders a file format option given as a string.
+func formatOptionValue(key, v string) string {
+	if isFormatKeyword(key, v) && optionName.MatchString(v) {
+		return strings.ToUpper(v)
+	}
+	return parameterValue(v)
+}
+
+// keyValueList renders values as KEY = 'value' pairs sorted by key.
+func keyValueList(values map[string]string) string {
+	pairs := make([]string, 0, len(values))
+	for _, k := range sortedKeys(values) {
+		pairs = append(pairs, k+" = "+QuoteString(values[k]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	stagev1alpha1 "github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1"
+)
+
+func TestStageFileFormat(t *testing.T) {
+	type want struct {
+		sql string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		f      stagev1alpha1.StageFileFormat
+		want   want
+	}{
+		"FormatName": {
+			reason: "A named file format should be referenced by its quoted name.",
+			f:      stagev1alpha1.StageFileFormat{FormatName: ptr.To("RAW.PUBLIC.CSV")},
+			want:   want{sql: "FORMAT_NAME = 'RAW.PUBLIC.CSV'"},
+		},
+		"Options": {
+			reason: "String options should be quoted while numbers, booleans and keywords are passed bare.",
+			f: stagev1alpha1.StageFileFormat{Type: ptr.To("CSV"), Options: map[string]string{
+				"field_delimiter": "|",
+				"SKIP_HEADER":     "1",
+				"TRIM_SPACE":      "true",
+				"COMPRESSION":     "gzip",
+				"ESCAPE":          "none",
+			}},
+			want: want{sql: "TYPE = CSV COMPRESSION = GZIP ESCAPE = NONE SKIP_HEADER = 1 TRIM_SPACE = TRUE FIELD_DELIMITER = '|'"},
+		},
+		"QuotedValue": {
+			reason: "Values should not be able to end the option list.",
+			f: stagev1alpha1.StageFileFormat{Type: ptr.To("CSV"), Options: map[string]string{
+				"FIELD_DELIMITER": "') COMMENT = 'x",
+				"COMPRESSION":     "GZIP) COMMENT = (",
+			}},
+			want: want{sql: `TYPE = CSV COMPRESSION = 'GZIP) COMMENT = (' FIELD_DELIMITER = '\') COMMENT = \'x'`},
+		},
+		"InvalidName": {
+			reason: "Option names that are not plain identifiers should be rejected.",
+			f: stagev1alpha1.StageFileFormat{Type: ptr.To("CSV"), Options: map[string]string{
+				"SKIP_HEADER = 1) COMMENT = (": "x",
+			}},
+			want: want{err: errors.New(`invalid file format option name "SKIP_HEADER = 1) COMMENT = ("`)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := stageFileFormat(&tc.f)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nstageFileFormat(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sql, got); diff != "" {
+				t.Errorf("\n%s\nstageFileFormat(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateStage(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      stagev1alpha1.StageParameters
+		want   []string
+	}{
+		"Properties": {
+			reason: "Changed properties should be set, leaving the fixed encryption of an internal stage alone.",
+			p: stagev1alpha1.StageParameters{
+				Database:   "RAW",
+				Schema:     "PUBLIC",
+				Name:       "events",
+				Encryption: &stagev1alpha1.StageEncryption{Type: "SNOWFLAKE_SSE"},
+				Comment:    ptr.To("raw events"),
+			},
+			want: []string{"ALTER STAGE RAW.PUBLIC.events SET COMMENT = 'raw events'"},
+		},
+		"Directory": {
+			reason: "The directory table should be enabled or disabled.",
+			p: stagev1alpha1.StageParameters{
+				Database:  "RAW",
+				Schema:    "PUBLIC",
+				Name:      "events",
+				Directory: &stagev1alpha1.StageDirectory{Enable: true, AutoRefresh: ptr.To(true)},
+			},
+			want: []string{"ALTER STAGE RAW.PUBLIC.events SET DIRECTORY = (ENABLE = TRUE)"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.UpdateStage(context.Background(), &tc.p, StageSecrets{}); err != nil {
+				t.Fatalf("\n%s\nc.UpdateStage(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.UpdateStage(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
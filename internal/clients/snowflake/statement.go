@@ -0,0 +1,477 @@
+package snowflake
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	statementsPath = "api/v2/statements"
+
+	// statement timeout in seconds sent with every SQL API request
+	statementTimeout = 60
+
+	// interval between status polls of an asynchronously running statement
+	statementPollInterval = 2 * time.Second
+
+	// SQL compilation error returned when an object does not exist or the
+	// current role is not authorized to see it.
+	codeObjectNotFound = "002003"
+)
+
+// Row is a single row of a statement result, keyed by column name.
+type Row map[string]string
+
+// statementRequest runs a statement in the session context of the
+// ProviderConfig; empty fields fall back to the defaults of the user.
+type statementRequest struct {
+	Statement string `json:"statement"`
+	Timeout   int    `json:"timeout,omitempty"`
+	Role      string `json:"role,omitempty"`
+	Warehouse string `json:"warehouse,omitempty"`
+	Database  string `json:"database,omitempty"`
+	Schema    string `json:"schema,omitempty"`
+}
+
+type statementResponse struct {
+	Code              string `json:"code"`
+	SQLState          string `json:"sqlState"`
+	Message           string `json:"message"`
+	StatementHandle   string `json:"statementHandle"`
+	ResultSetMetaData struct {
+		RowType []struct {
+			Name string `json:"name"`
+		} `json:"rowType"`
+	} `json:"resultSetMetaData"`
+	Data [][]*string `json:"data"`
+}
+
+// rows converts the positional result set into rows keyed by column name.
+func (r statementResponse) rows() []Row {
+	rows := make([]Row, 0, len(r.Data))
+	for _, d := range r.Data {
+		row := Row{}
+		for i, col := range r.ResultSetMetaData.RowType {
+			if i < len(d) && d[i] != nil {
+				row[col.Name] = *d[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ExecuteStatement runs a single SQL statement through the Snowflake SQL API
+// and returns the rows of its result set. Statements failing because the
+// object does not exist return an error wrapping ErrNotFound.
+func (c ClientInfo) ExecuteStatement(ctx context.Context, statement string) ([]Row, error) {
+
+	// Get token first
+	authToken, err := generateJWT(c)
+	if err != nil {
+		return nil, err
+	}
+
+	fullPath, err := url.JoinPath(getBaseUrl(c), statementsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonBody, err := json.Marshal(statementRequest{
+		Statement: statement,
+		Timeout:   statementTimeout,
+		Role:      c.Role,
+		Warehouse: c.Warehouse,
+		Database:  c.Database,
+		Schema:    c.Schema,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fullPath, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	setReqHeaders(req, authToken)
+
+	resp, running, err := c.doStatementRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// statement is still running, poll its handle until it completes
+	for running {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(statementPollInterval):
+		}
+
+		statusPath, err := url.JoinPath(fullPath, resp.StatementHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", statusPath, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		setReqHeaders(req, authToken)
+
+		if resp, running, err = c.doStatementRequest(req); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp.rows(), nil
+}
+
+// doStatementRequest sends a SQL API request and decodes its response. It
+// reports whether the statement is still running; any status code other than
+// 200 and 202 is returned as an error.
+func (c ClientInfo) doStatementRequest(req *http.Request) (statementResponse, bool, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return statementResponse{}, false, errors.Wrap(err, "Failed to make statement API request")
+	}
+	defer dclose(resp.Body)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return statementResponse{}, false, err
+	}
+
+	var sr statementResponse
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &sr); err != nil {
+			return statementResponse{}, false, errors.Wrapf(err, "cannot decode statement response with status %d", resp.StatusCode)
+		}
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return sr, false, nil
+	case resp.StatusCode == http.StatusAccepted:
+		return sr, true, nil
+	case sr.Code == codeObjectNotFound:
+		return statementResponse{}, false, errors.Wrap(ErrNotFound, sr.Message)
+	case sr.Message != "":
+		return statementResponse{}, false, errors.Errorf("statement failed with code %s: %s", sr.Code, sr.Message)
+	default:
+		return statementResponse{}, false, errors.Errorf("statement failed with status %d", resp.StatusCode)
+	}
+}
+
+// simpleIdentifier matches identifiers Snowflake accepts without quoting.
+var simpleIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// optionName matches the names of options and keywords, which are never
+// quoted.
+var optionName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// QuoteIdentifier returns name as a SQL identifier. Simple names are left
+// unquoted so that Snowflake resolves them case-insensitively, the same way
+// the REST API does.
+func QuoteIdentifier(name string) string {
+	if simpleIdentifier.MatchString(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QualifiedName joins the non-empty parts into a dotted object identifier,
+// e.g. database.schema.object.
+func QualifiedName(parts ...string) string {
+	ids := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			ids = append(ids, QuoteIdentifier(p))
+		}
+	}
+	return strings.Join(ids, ".")
+}
+
+// QuoteString returns s as a single quoted SQL string literal.
+func QuoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
+
+// QuoteStringList returns values as a parenthesized list of string literals.
+func QuoteStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = QuoteString(v)
+	}
+	return "(" + strings.Join(quoted, ", ") + ")"
+}
+
+// IdentifierList returns names as a comma separated list of identifiers.
+func IdentifierList(names []string) string {
+	ids := make([]string, len(names))
+	for i, n := range names {
+		ids[i] = QuoteIdentifier(n)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// FormatBool returns b as a SQL boolean literal.
+func FormatBool(b bool) string {
+	if b {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// showObject runs SHOW <objectType> LIKE '<name>' [scope] and returns the row
+// whose name matches exactly, or ErrNotFound.
+func (c ClientInfo) showObject(ctx context.Context, objectType, name, scope string) (Row, error) {
+	stmt := fmt.Sprintf("SHOW %s LIKE %s", objectType, QuoteString(name))
+	if scope != "" {
+		stmt += " " + scope
+	}
+
+	rows, err := c.ExecuteStatement(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		if strings.EqualFold(r["name"], name) {
+			return r, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// describeObject runs DESC <objectType> <identifier> and returns the
+// property_value of every row keyed by its property name.
+func (c ClientInfo) describeObject(ctx context.Context, objectType, identifier string) (map[string]string, error) {
+	rows, err := c.ExecuteStatement(ctx, fmt.Sprintf("DESC %s %s", objectType, identifier))
+	if err != nil {
+		return nil, err
+	}
+
+	props := make(map[string]string, len(rows))
+	for _, r := range rows {
+		props[r["property"]] = r["property_value"]
+	}
+	return props, nil
+}
+
+// schemaScope returns the IN SCHEMA clause for SHOW statements.
+func schemaScope(database, schema string) string {
+	return "IN SCHEMA " + QualifiedName(database, schema)
+}
+
+// NormalizeSQL collapses whitespace and drops a trailing semicolon so that SQL
+// bodies echoed back by Snowflake can be compared with the spec.
+func NormalizeSQL(s string) string {
+	return strings.Join(strings.Fields(strings.TrimSuffix(strings.TrimSpace(s), ";")), " ")
+}
+
+// showParameters runs SHOW PARAMETERS IN <objectType> [identifier] and returns
+// the values of the parameters set on the object itself, keyed by name.
+func (c ClientInfo) showParameters(ctx context.Context, objectType, identifier string) (map[string]string, error) {
+	rows, err := c.ExecuteStatement(ctx, strings.TrimSpace(fmt.Sprintf("SHOW PARAMETERS IN %s %s", objectType, identifier)))
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{}
+	for _, r := range rows {
+		if strings.EqualFold(r["level"], objectType) {
+			params[r["key"]] = r["value"]
+		}
+	}
+	return params, nil
+}
+
+// parameterValue renders a parameter value, leaving numbers and booleans bare
+// and quoting everything else.
+func parameterValue(v string) string {
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		return v
+	}
+	if strings.EqualFold(v, "TRUE") || strings.EqualFold(v, "FALSE") {
+		return strings.ToUpper(v)
+	}
+	return QuoteString(v)
+}
+
+// SplitQualifiedName splits a dotted object identifier into its parts,
+// removing the quotes of quoted parts.
+func SplitQualifiedName(name string) []string {
+	var (
+		parts  []string
+		part   strings.Builder
+		quoted bool
+	)
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case ch == '"' && quoted && i+1 < len(name) && name[i+1] == '"':
+			part.WriteByte('"')
+			i++
+		case ch == '"':
+			quoted = !quoted
+		case ch == '.' && !quoted:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(ch)
+		}
+	}
+	return append(parts, part.String())
+}
+
+// splitIdentifier splits a dotted SQL identifier into its parts as written,
+// keeping the quotes of quoted parts.
+func splitIdentifier(name string) []string {
+	var (
+		parts  []string
+		start  int
+		quoted bool
+	)
+	for i := 0; i < len(name); i++ {
+		switch {
+		case name[i] == '"':
+			quoted = !quoted
+		case name[i] == '.' && !quoted:
+			parts = append(parts, name[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, name[start:])
+}
+
+// quotedIdentifier matches a single double quoted identifier.
+var quotedIdentifier = regexp.MustCompile(`^"([^"]|"")+"$`)
+
+// quoteIdentifierPart quotes a part returned by splitIdentifier, keeping parts
+// that are already quoted, e.g. as returned by SHOW GRANTS.
+func quoteIdentifierPart(part string) string {
+	if quotedIdentifier.MatchString(part) {
+		return part
+	}
+	return QuoteIdentifier(part)
+}
+
+// quoteQualifiedName quotes the parts of a dotted identifier as written by a
+// user, keeping quoted parts as they are so that they keep their case.
+func quoteQualifiedName(name string) string {
+	parts := splitIdentifier(name)
+	for i, p := range parts {
+		parts[i] = quoteIdentifierPart(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// splitList parses the comma separated lists of SHOW output, optionally
+// enclosed in brackets, e.g. [A, B] or 50%,75%.
+func splitList(s string) []string {
+	s = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "["), "]")
+
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseJSONList parses the JSON string arrays of SHOW output and
+// INFORMATION_SCHEMA functions, e.g. ["A","B"], falling back to splitList.
+func parseJSONList(s string) []string {
+	var items []string
+	if err := json.Unmarshal([]byte(s), &items); err != nil {
+		return splitList(s)
+	}
+	return items
+}
+
+// SameNames reports whether a and b hold the same identifiers regardless of
+// order and case.
+func SameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int, len(a))
+	for _, n := range a {
+		count[strings.ToUpper(n)]++
+	}
+	for _, n := range b {
+		k := strings.ToUpper(n)
+		if count[k] == 0 {
+			return false
+		}
+		count[k]--
+	}
+	return true
+}
+
+// NameDiff returns the names of want missing from have and the names of have
+// missing from want, compared case-insensitively.
+func NameDiff(want, have []string) (added, removed []string) {
+	in := func(n string, list []string) bool {
+		for _, l := range list {
+			if strings.EqualFold(n, l) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, n := range want {
+		if !in(n, have) {
+			added = append(added, n)
+		}
+	}
+	for _, n := range have {
+		if !in(n, want) {
+			removed = append(removed, n)
+		}
+	}
+	return added, removed
+}
+
+// objectKey returns a comparable form of a schema object name, qualifying
+// partial names with the given database and schema.
+func objectKey(database, schema, name string) string {
+	parts := SplitQualifiedName(name)
+	switch len(parts) {
+	case 1:
+		parts = []string{database, schema, parts[0]}
+	case 2:
+		parts = []string{database, parts[0], parts[1]}
+	}
+	return strings.ToUpper(strings.Join(parts, "."))
+}
+
+// SameObjectNames reports whether a and b hold the same fully qualified
+// object names regardless of order, quoting and case.
+func SameObjectNames(a, b []string) bool {
+	return SameNames(objectKeys(a), objectKeys(b))
+}
+
+func objectKeys(names []string) []string {
+	keys := make([]string, len(names))
+	for i, n := range names {
+		keys[i] = objectKey("", "", n)
+	}
+	return keys
+}
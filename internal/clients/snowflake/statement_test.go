@@ -0,0 +1,259 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+var (
+	testKeyOnce sync.Once
+	testKey     string
+)
+
+// testPrivateKey returns a PEM encoded RSA key to sign the JWTs of test
+// clients with. It is generated once per test run.
+func testPrivateKey(t *testing.T) string {
+	t.Helper()
+	testKeyOnce.Do(func() {
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("cannot generate test key: %v", err)
+		}
+		testKey = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}))
+	})
+	return testKey
+}
+
+// fakeSQLAPI serves the Snowflake SQL API to a test client. It records the
+// statement requests and headers it receives and answers each with the rows
+// returned by Rows.
+// Returning ErrNotFound from Rows fails the statement the way Snowflake does
+// for objects that do not exist. Requests to other paths are passed to REST.
+type fakeSQLAPI struct {
+	Rows func(statement string) ([]Row, error)
+	REST http.HandlerFunc
+
+	requests []statementRequest
+	headers  []http.Header
+}
+
+func (f *fakeSQLAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	if req.URL.Path != "/"+statementsPath {
+		if f.REST == nil {
+			rec.WriteHeader(http.StatusNotFound)
+			return rec.Result(), nil
+		}
+		f.REST(rec, req)
+		return rec.Result(), nil
+	}
+
+	var sr statementRequest
+	if err := json.NewDecoder(req.Body).Decode(&sr); err != nil {
+		return nil, err
+	}
+	f.requests = append(f.requests, sr)
+	f.headers = append(f.headers, req.Header.Clone())
+
+	var rows []Row
+	var err error
+	if f.Rows != nil {
+		rows, err = f.Rows(sr.Statement)
+	}
+
+	var resp statementResponse
+	switch {
+	case errors.Is(err, ErrNotFound):
+		rec.WriteHeader(http.StatusUnprocessableEntity)
+		resp.Code, resp.Message = codeObjectNotFound, "Object does not exist or not authorized."
+	case err != nil:
+		rec.WriteHeader(http.StatusUnprocessableEntity)
+		resp.Code, resp.Message = "000001", err.Error()
+	default:
+		cols := map[string]bool{}
+		for _, r := range rows {
+			for c := range r {
+				cols[c] = true
+			}
+		}
+		names := make([]string, 0, len(cols))
+		for c := range cols {
+			names = append(names, c)
+		}
+		sort.Strings(names)
+		for _, c := range names {
+			resp.ResultSetMetaData.RowType = append(resp.ResultSetMetaData.RowType, struct {
+				Name string `json:"name"`
+			}{Name: c})
+		}
+		for _, r := range rows {
+			d := make([]*string, len(names))
+			for i, c := range names {
+				if v, ok := r[c]; ok {
+					d[i] = &v
+				}
+			}
+			resp.Data = append(resp.Data, d)
+		}
+	}
+	if err := json.NewEncoder(rec).Encode(resp); err != nil {
+		return nil, err
+	}
+	return rec.Result(), nil
+}
+
+// statements returns the statements the API received, in order.
+func (f *fakeSQLAPI) statements() []string {
+	s := make([]string, 0, len(f.requests))
+	for _, r := range f.requests {
+		s = append(s, r.Statement)
+	}
+	return s
+}
+
+// newTestClient returns a client sending its requests to api.
+func newTestClient(t *testing.T, api *fakeSQLAPI) ClientInfo {
+	t.Helper()
+	return ClientInfo{
+		SnowflakeAccount: "TEST",
+		Username:         "PROVIDER",
+		FingerPrint:      "test",
+		PrivateKey:       testPrivateKey(t),
+		httpClient:       &http.Client{Transport: api},
+	}
+}
+
+func TestExecuteStatement(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		rows []Row
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		rows   func(string) ([]Row, error)
+		want   want
+	}{
+		"Rows": {
+			reason: "The result set should be returned keyed by column name.",
+			rows: func(string) ([]Row, error) {
+				return []Row{{"name": "RAW", "comment": "events"}, {"name": "CURATED"}}, nil
+			},
+			want: want{rows: []Row{{"name": "RAW", "comment": "events"}, {"name": "CURATED"}}},
+		},
+		"NotFound": {
+			reason: "Statements on objects that do not exist should return ErrNotFound.",
+			rows: func(string) ([]Row, error) {
+				return nil, ErrNotFound
+			},
+			want: want{err: errors.Wrap(ErrNotFound, "Object does not exist or not authorized.")},
+		},
+		"Failed": {
+			reason: "The message of a failed statement should be returned.",
+			rows: func(string) ([]Row, error) {
+				return nil, errBoom
+			},
+			want: want{err: errors.Errorf("statement failed with code 000001: %s", errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, &fakeSQLAPI{Rows: tc.rows})
+			got, err := c.ExecuteStatement(context.Background(), "SHOW STAGES")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.ExecuteStatement(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.rows, got); diff != "" {
+				t.Errorf("\n%s\nc.ExecuteStatement(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestExecuteStatementSession(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		c      func(ClientInfo) ClientInfo
+		want   statementRequest
+	}{
+		"Defaults": {
+			reason: "Without a session context the defaults of the user should apply.",
+			c:      func(c ClientInfo) ClientInfo { return c },
+			want:   statementRequest{Statement: "SHOW STAGES", Timeout: statementTimeout},
+		},
+		"Session": {
+			reason: "The role, warehouse, database and schema of the ProviderConfig should be sent in the request body.",
+			c: func(c ClientInfo) ClientInfo {
+				c.Role, c.Warehouse, c.Database, c.Schema = "PROVISIONER", "ADMIN_WH", "RAW", "PUBLIC"
+				return c
+			},
+			want: statementRequest{
+				Statement: "SHOW STAGES",
+				Timeout:   statementTimeout,
+				Role:      "PROVISIONER",
+				Warehouse: "ADMIN_WH",
+				Database:  "RAW",
+				Schema:    "PUBLIC",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := tc.c(newTestClient(t, api))
+			if _, err := c.ExecuteStatement(context.Background(), "SHOW STAGES"); err != nil {
+				t.Fatalf("\n%s\nc.ExecuteStatement(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff([]statementRequest{tc.want}, api.requests); diff != "" {
+				t.Errorf("\n%s\nc.ExecuteStatement(...): -want request, +got:\n%s\n", tc.reason, diff)
+			}
+			for _, h := range []string{"X-Snowflake-Role", "X-Snowflake-Warehouse"} {
+				if v := api.headers[0].Get(h); v != "" {
+					t.Errorf("\n%s\nc.ExecuteStatement(...): unexpected header %s: %s\n", tc.reason, h, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	streamv1alpha1 "github.com/allenkallz/provider-snowflake/apis/stream/v1alpha1"
+)
+
+func streamName(p *streamv1alpha1.StreamParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchStream returns the observed state of a stream, or ErrNotFound.
+func (c ClientInfo) FetchStream(ctx context.Context, p *streamv1alpha1.StreamParameters) (streamv1alpha1.StreamObservation, error) {
+	row, err := c.showObject(ctx, "STREAMS", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return streamv1alpha1.StreamObservation{}, err
+	}
+
+	obs := streamv1alpha1.StreamObservation{
+		Stale:      strings.EqualFold(row["stale"], "true"),
+		StaleAfter: row["stale_after"],
+		Mode:       row["mode"],
+		SourceType: row["source_type"],
+		TableName:  row["table_name"],
+		Comment:    row["comment"],
+		Owner:      row["owner"],
+		CreatedOn:  row["created_on"],
+	}
+	if r := row["invalid_reason"]; r != "N/A" {
+		obs.InvalidReason = r
+	}
+	return obs, nil
+}
+
+// CreateStream creates a stream.
+func (c ClientInfo) CreateStream(ctx context.Context, p *streamv1alpha1.StreamParameters) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE STREAM " + streamName(p))
+	b.WriteString(" ON " + strings.ReplaceAll(p.SourceType, "_", " ") + " " + quoteQualifiedName(p.Source))
+
+	if t := p.TimeTravel; t != nil {
+		b.WriteString(" " + streamTimeTravel(t))
+	}
+	if p.AppendOnly != nil {
+		b.WriteString(" APPEND_ONLY = " + FormatBool(*p.AppendOnly))
+	}
+	if p.InsertOnly != nil {
+		b.WriteString(" INSERT_ONLY = " + FormatBool(*p.InsertOnly))
+	}
+	if p.ShowInitialRows != nil {
+		b.WriteString(" SHOW_INITIAL_ROWS = " + FormatBool(*p.ShowInitialRows))
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+func streamTimeTravel(t *streamv1alpha1.StreamTimeTravel) string {
+	clause := t.Clause
+	if clause == "" {
+		clause = "AT"
+	}
+
+	var point string
+	switch {
+	case t.Timestamp != nil:
+		point = "TIMESTAMP => TO_TIMESTAMP_TZ(" + QuoteString(*t.Timestamp) + ")"
+	case t.Offset != nil:
+		point = "OFFSET => " + strconv.Itoa(*t.Offset)
+	case t.Statement != nil:
+		point = "STATEMENT => " + QuoteString(*t.Statement)
+	case t.Stream != nil:
+		point = "STREAM => " + QuoteString(*t.Stream)
+	}
+	return fmt.Sprintf("%s (%s)", clause, point)
+}
+
+// UpdateStream sets the comment of a stream, the only property Snowflake
+// allows to alter.
+func (c ClientInfo) UpdateStream(ctx context.Context, p *streamv1alpha1.StreamParameters) error {
+	if p.Comment == nil {
+		return nil
+	}
+
+	_, err := c.ExecuteStatement(ctx, "ALTER STREAM "+streamName(p)+" SET COMMENT = "+QuoteString(*p.Comment))
+	return err
+}
+
+// DeleteStream drops a stream.
+func (c ClientInfo) DeleteStream(ctx context.Context, p *streamv1alpha1.StreamParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP STREAM IF EXISTS "+streamName(p))
+	return err
+}
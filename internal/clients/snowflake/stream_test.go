@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	streamv1alpha1 "github.com/allenkallz/provider-snowflake/apis/stream/v1alpha1"
+)
+
+func TestCreateStream(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		source string
+		want   []string
+	}{
+		"Source": {
+			reason: "Simple source names should be left unquoted.",
+			source: "RAW.PUBLIC.EVENTS",
+			want:   []string{"CREATE STREAM RAW.PUBLIC.events_stream ON TABLE RAW.PUBLIC.EVENTS"},
+		},
+		"QuotedSource": {
+			reason: "Quoted parts of the source should keep their quotes and case.",
+			source: `RAW."Landing"."Events"`,
+			want:   []string{`CREATE STREAM RAW.PUBLIC.events_stream ON TABLE RAW."Landing"."Events"`},
+		},
+		"InjectedSource": {
+			reason: "Source names should be quoted so that they cannot extend the statement.",
+			source: "RAW.PUBLIC.EVENTS; DROP TABLE RAW.PUBLIC.EVENTS",
+			want:   []string{`CREATE STREAM RAW.PUBLIC.events_stream ON TABLE RAW.PUBLIC."EVENTS; DROP TABLE RAW".PUBLIC.EVENTS`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			p := &streamv1alpha1.StreamParameters{
+				Database:   "RAW",
+				Schema:     "PUBLIC",
+				Name:       "events_stream",
+				SourceType: "TABLE",
+				Source:     tc.source,
+			}
+			if err := c.CreateStream(context.Background(), p); err != nil {
+				t.Fatalf("\n%s\nc.CreateStream(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.CreateStream(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
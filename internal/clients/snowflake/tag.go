@@ -0,0 +1,245 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tagv1alpha1 "github.com/allenkallz/provider-snowflake/apis/tag/v1alpha1"
+)
+
+func tagName(p *tagv1alpha1.TagParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// FetchTag returns the observed state of a tag, or ErrNotFound.
+func (c ClientInfo) FetchTag(ctx context.Context, p *tagv1alpha1.TagParameters) (tagv1alpha1.TagObservation, error) {
+	row, err := c.showObject(ctx, "TAGS", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return tagv1alpha1.TagObservation{}, err
+	}
+
+	return tagv1alpha1.TagObservation{
+		AllowedValues: parseJSONList(row["allowed_values"]),
+		Comment:       row["comment"],
+		Owner:         row["owner"],
+		CreatedOn:     row["created_on"],
+	}, nil
+}
+
+// CreateTag creates a tag.
+func (c ClientInfo) CreateTag(ctx context.Context, p *tagv1alpha1.TagParameters) error {
+	stmt := "CREATE TAG " + tagName(p)
+	if len(p.AllowedValues) > 0 {
+		stmt += " ALLOWED_VALUES " + quoteStrings(p.AllowedValues)
+	}
+	if p.Comment != nil {
+		stmt += " COMMENT = " + QuoteString(*p.Comment)
+	}
+
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateTag adds and drops allowed values of a tag and sets its comment.
+// Allowed values are case-sensitive.
+func (c ClientInfo) UpdateTag(ctx context.Context, p *tagv1alpha1.TagParameters, obs tagv1alpha1.TagObservation) error {
+	var stmts []string
+	switch added, removed := valueDiff(p.AllowedValues, obs.AllowedValues); {
+	case len(p.AllowedValues) == 0 && len(obs.AllowedValues) > 0:
+		stmts = append(stmts, "UNSET ALLOWED_VALUES")
+	default:
+		if len(added) > 0 {
+			stmts = append(stmts, "ADD ALLOWED_VALUES "+quoteStrings(added))
+		}
+		if len(removed) > 0 {
+			stmts = append(stmts, "DROP ALLOWED_VALUES "+quoteStrings(removed))
+		}
+	}
+	if p.Comment != nil {
+		stmts = append(stmts, "SET COMMENT = "+QuoteString(*p.Comment))
+	}
+
+	for _, s := range stmts {
+		if _, err := c.ExecuteStatement(ctx, "ALTER TAG "+tagName(p)+" "+s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteTag drops a tag, unsetting it from every object.
+func (c ClientInfo) DeleteTag(ctx context.Context, p *tagv1alpha1.TagParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP TAG IF EXISTS "+tagName(p))
+	return err
+}
+
+// TagAllowedValuesUpToDate reports whether the allowed values of a tag match,
+// regardless of order but respecting case.
+func TagAllowedValuesUpToDate(want, have []string) bool {
+	added, removed := valueDiff(want, have)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// valueDiff returns the values of want missing from have and the values of
+// have missing from want, compared case-sensitively.
+func valueDiff(want, have []string) (added, removed []string) {
+	in := func(v string, list []string) bool {
+		for _, l := range list {
+			if v == l {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range want {
+		if !in(v, have) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range have {
+		if !in(v, want) {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// quoteStrings returns values as a comma separated list of string literals.
+func quoteStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = QuoteString(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// alterTagged returns the ALTER statement prefix for a tagged object. Columns
+// are altered through their table.
+func alterTagged(objectType, object string) string {
+	parts := SplitQualifiedName(object)
+	if strings.EqualFold(objectType, "COLUMN") && len(parts) > 1 {
+		return "ALTER TABLE " + QualifiedName(parts[:len(parts)-1]...) + " MODIFY COLUMN " + QuoteIdentifier(parts[len(parts)-1])
+	}
+	return "ALTER " + strings.ToUpper(objectType) + " " + QualifiedName(parts...)
+}
+
+// FetchTags returns the values of the given tags set on an object, keyed by
+// tag. Tags that are not set on the object are left out.
+func (c ClientInfo) FetchTags(ctx context.Context, objectType, object string, tags []string) (map[string]string, error) {
+	values := map[string]string{}
+	if len(tags) == 0 {
+		return values, nil
+	}
+
+	cols := make([]string, len(tags))
+	for i, t := range tags {
+		cols[i] = fmt.Sprintf("SYSTEM$GET_TAG(%s, %s, %s) AS T%d", QuoteString(t), QuoteString(object), QuoteString(strings.ToLower(objectType)), i)
+	}
+	rows, err := c.ExecuteStatement(ctx, "SELECT "+strings.Join(cols, ", "))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return values, nil
+	}
+
+	for i, t := range tags {
+		// unset tags are returned as NULL, which leaves the column out
+		if v, ok := rows[0][fmt.Sprintf("T%d", i)]; ok {
+			values[t] = v
+		}
+	}
+	return values, nil
+}
+
+// SetTags sets and unsets tags on an object.
+func (c ClientInfo) SetTags(ctx context.Context, objectType, object string, set map[string]string, unset []string) error {
+	if len(set) > 0 {
+		pairs := make([]string, 0, len(set))
+		for _, t := range sortedKeys(set) {
+			pairs = append(pairs, QualifiedName(SplitQualifiedName(t)...)+" = "+QuoteString(set[t]))
+		}
+		if _, err := c.ExecuteStatement(ctx, alterTagged(objectType, object)+" SET TAG "+strings.Join(pairs, ", ")); err != nil {
+			return err
+		}
+	}
+	if len(unset) > 0 {
+		names := make([]string, len(unset))
+		for i, t := range unset {
+			names[i] = QualifiedName(SplitQualifiedName(t)...)
+		}
+		sort.Strings(names)
+		if _, err := c.ExecuteStatement(ctx, alterTagged(objectType, object)+" UNSET TAG "+strings.Join(names, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TagChanges returns the tags of want that are not set to their value in have,
+// and the tags of have missing from want.
+func TagChanges(want, have map[string]string) (set map[string]string, unset []string) {
+	set = map[string]string{}
+	for t, v := range want {
+		if hv, ok := have[t]; !ok || hv != v {
+			set[t] = v
+		}
+	}
+	for t := range have {
+		if _, ok := want[t]; !ok {
+			unset = append(unset, t)
+		}
+	}
+	return set, unset
+}
+
+// TagKeys returns the tags of want and have, i.e. those set in the spec and
+// those observed before that may have to be unset.
+func TagKeys(want, have map[string]string) []string {
+	keys := map[string]bool{}
+	for t := range want {
+		keys[t] = true
+	}
+	for t := range have {
+		keys[t] = true
+	}
+
+	tags := make([]string, 0, len(keys))
+	for t := range keys {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// FetchTagAssociation returns the value a tag is set to on an object, or
+// ErrNotFound if it is not set.
+func (c ClientInfo) FetchTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) (tagv1alpha1.TagAssociationObservation, error) {
+	values, err := c.FetchTags(ctx, p.ObjectType, p.ObjectName, []string{p.Tag})
+	if err != nil {
+		return tagv1alpha1.TagAssociationObservation{}, err
+	}
+
+	v, ok := values[p.Tag]
+	if !ok {
+		return tagv1alpha1.TagAssociationObservation{}, ErrNotFound
+	}
+	return tagv1alpha1.TagAssociationObservation{Value: v}, nil
+}
+
+// CreateTagAssociation sets a tag on an object.
+func (c ClientInfo) CreateTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) error {
+	return c.SetTags(ctx, p.ObjectType, p.ObjectName, map[string]string{p.Tag: p.Value}, nil)
+}
+
+// UpdateTagAssociation sets a tag on an object to another value.
+func (c ClientInfo) UpdateTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) error {
+	return c.SetTags(ctx, p.ObjectType, p.ObjectName, map[string]string{p.Tag: p.Value}, nil)
+}
+
+// DeleteTagAssociation unsets a tag from an object.
+func (c ClientInfo) DeleteTagAssociation(ctx context.Context, p *tagv1alpha1.TagAssociationParameters) error {
+	return c.SetTags(ctx, p.ObjectType, p.ObjectName, nil, []string{p.Tag})
+}
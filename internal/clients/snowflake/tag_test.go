@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSetTags(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		set    map[string]string
+		unset  []string
+		want   []string
+	}{
+		"Set": {
+			reason: "Tags should be set by their qualified names, keeping quoted parts quoted.",
+			set: map[string]string{
+				"GOVERNANCE.PUBLIC.COST_CENTER":   "finance",
+				`GOVERNANCE.PUBLIC."Cost Center"`: "sales",
+			},
+			want: []string{`ALTER DATABASE analytics SET TAG GOVERNANCE.PUBLIC."Cost Center" = 'sales', GOVERNANCE.PUBLIC.COST_CENTER = 'finance'`},
+		},
+		"Unset": {
+			reason: "Tags should be unset by their qualified names.",
+			unset:  []string{"GOVERNANCE.PUBLIC.OWNER", `GOVERNANCE.PUBLIC."Cost Center"`},
+			want:   []string{`ALTER DATABASE analytics UNSET TAG GOVERNANCE.PUBLIC."Cost Center", GOVERNANCE.PUBLIC.OWNER`},
+		},
+		"Injection": {
+			reason: "Tag names should not be able to end the statement.",
+			set:    map[string]string{"GOVERNANCE.PUBLIC.OWNER = 'x'; DROP DATABASE analytics; --": "y"},
+			unset:  []string{"GOVERNANCE.PUBLIC.X; DROP DATABASE analytics"},
+			want: []string{
+				`ALTER DATABASE analytics SET TAG GOVERNANCE.PUBLIC."OWNER = 'x'; DROP DATABASE analytics; --" = 'y'`,
+				`ALTER DATABASE analytics UNSET TAG GOVERNANCE.PUBLIC."X; DROP DATABASE analytics"`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			if err := c.SetTags(context.Background(), "DATABASE", "analytics", tc.set, tc.unset); err != nil {
+				t.Fatalf("\n%s\nc.SetTags(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.SetTags(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
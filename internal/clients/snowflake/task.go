@@ -0,0 +1,349 @@
+package snowflake
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	taskv1alpha1 "github.com/allenkallz/provider-snowflake/apis/task/v1alpha1"
+)
+
+// RootTask is the root task of a DAG.
+type RootTask struct {
+	// Name is the fully qualified name of the root task.
+	Name    string
+	Started bool
+}
+
+// TaskName returns the fully qualified identifier of a task.
+func TaskName(p *taskv1alpha1.TaskParameters) string {
+	return QualifiedName(p.Database, p.Schema, p.Name)
+}
+
+// TaskSchedule returns s in the form Snowflake reports it, e.g. 60 MINUTE or
+// USING CRON 0 9 * * * UTC.
+func TaskSchedule(s *taskv1alpha1.TaskSchedule) string {
+	switch {
+	case s == nil:
+		return ""
+	case s.Minutes != nil:
+		return strconv.Itoa(*s.Minutes) + " MINUTE"
+	case s.Cron != nil:
+		return "USING CRON " + strings.Join(strings.Fields(*s.Cron), " ")
+	}
+	return ""
+}
+
+// TaskParameters returns the session and task parameters set in p, keyed by
+// their upper case name.
+func TaskParameters(p *taskv1alpha1.TaskParameters) map[string]string {
+	params := make(map[string]string, len(p.SessionParameters)+3)
+	for k, v := range p.SessionParameters {
+		params[strings.ToUpper(k)] = v
+	}
+	if p.UserTaskManagedInitialWarehouseSize != nil {
+		params["USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE"] = *p.UserTaskManagedInitialWarehouseSize
+	}
+	if p.UserTaskTimeoutMs != nil {
+		params["USER_TASK_TIMEOUT_MS"] = strconv.Itoa(*p.UserTaskTimeoutMs)
+	}
+	if p.SuspendTaskAfterNumFailures != nil {
+		params["SUSPEND_TASK_AFTER_NUM_FAILURES"] = strconv.Itoa(*p.SuspendTaskAfterNumFailures)
+	}
+	return params
+}
+
+// TaskParametersUpToDate reports whether exactly the parameters of p are set
+// on the task, with matching values.
+func TaskParametersUpToDate(p *taskv1alpha1.TaskParameters, observed map[string]string) bool {
+	want := TaskParameters(p)
+	if len(want) != len(observed) {
+		return false
+	}
+	for k, v := range want {
+		if got, ok := observed[k]; !ok || !strings.EqualFold(got, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// TaskPredecessorsUpToDate reports whether the observed predecessors of a task
+// are the ones in p, regardless of order, quoting and qualification.
+func TaskPredecessorsUpToDate(p *taskv1alpha1.TaskParameters, observed []string) bool {
+	added, removed := taskPredecessorChanges(p, observed)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// taskPredecessorChanges returns the predecessors of p that are missing from
+// the observed ones, and the observed ones no longer in p.
+func taskPredecessorChanges(p *taskv1alpha1.TaskParameters, observed []string) (added, removed []string) {
+	want := make(map[string]bool, len(p.After))
+	for _, n := range p.After {
+		want[taskKey(p, n)] = true
+	}
+
+	have := make(map[string]bool, len(observed))
+	for _, n := range observed {
+		k := taskKey(p, n)
+		have[k] = true
+		if !want[k] {
+			removed = append(removed, n)
+		}
+	}
+
+	for _, n := range p.After {
+		if !have[taskKey(p, n)] {
+			added = append(added, n)
+		}
+	}
+	return added, removed
+}
+
+// taskKey returns a comparable form of a task name, qualifying partial names
+// with the database and schema of p.
+func taskKey(p *taskv1alpha1.TaskParameters, name string) string {
+	return objectKey(p.Database, p.Schema, name)
+}
+
+func parsePredecessors(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var preds []string
+	if err := json.Unmarshal([]byte(s), &preds); err != nil {
+		return nil, errors.Wrap(err, "cannot decode task predecessors")
+	}
+	return preds, nil
+}
+
+// FetchTask returns the observed state of a task, or ErrNotFound.
+func (c ClientInfo) FetchTask(ctx context.Context, p *taskv1alpha1.TaskParameters) (taskv1alpha1.TaskObservation, error) {
+	row, err := c.showObject(ctx, "TASKS", p.Name, schemaScope(p.Database, p.Schema))
+	if err != nil {
+		return taskv1alpha1.TaskObservation{}, err
+	}
+
+	preds, err := parsePredecessors(row["predecessors"])
+	if err != nil {
+		return taskv1alpha1.TaskObservation{}, err
+	}
+
+	params, err := c.showParameters(ctx, "TASK", TaskName(p))
+	if err != nil {
+		return taskv1alpha1.TaskObservation{}, err
+	}
+
+	return taskv1alpha1.TaskObservation{
+		State:                     row["state"],
+		Schedule:                  row["schedule"],
+		Warehouse:                 row["warehouse"],
+		Definition:                row["definition"],
+		Condition:                 row["condition"],
+		Predecessors:              preds,
+		AllowOverlappingExecution: strings.EqualFold(row["allow_overlapping_execution"], "true"),
+		ErrorIntegration:          nullable(row["error_integration"]),
+		Parameters:                params,
+		Comment:                   row["comment"],
+		Owner:                     row["owner"],
+		CreatedOn:                 row["created_on"],
+	}, nil
+}
+
+// nullable maps the null placeholder of SHOW output to an empty string.
+func nullable(s string) string {
+	if s == "null" {
+		return ""
+	}
+	return s
+}
+
+// CreateTask creates a task. New tasks are always suspended.
+func (c ClientInfo) CreateTask(ctx context.Context, p *taskv1alpha1.TaskParameters) error {
+	var b strings.Builder
+
+	b.WriteString("CREATE TASK " + TaskName(p))
+	if p.Warehouse != nil {
+		b.WriteString(" WAREHOUSE = " + QuoteIdentifier(*p.Warehouse))
+	}
+	if s := TaskSchedule(p.Schedule); s != "" {
+		b.WriteString(" SCHEDULE = " + QuoteString(s))
+	}
+	if p.AllowOverlappingExecution != nil {
+		b.WriteString(" ALLOW_OVERLAPPING_EXECUTION = " + FormatBool(*p.AllowOverlappingExecution))
+	}
+	if params := TaskParameters(p); len(params) > 0 {
+		b.WriteString(" " + parameterList(params))
+	}
+	if p.ErrorIntegration != nil {
+		b.WriteString(" ERROR_INTEGRATION = " + QuoteIdentifier(*p.ErrorIntegration))
+	}
+	if p.Comment != nil {
+		b.WriteString(" COMMENT = " + QuoteString(*p.Comment))
+	}
+	if len(p.After) > 0 {
+		b.WriteString(" AFTER " + strings.Join(p.After, ", "))
+	}
+	if p.When != nil {
+		b.WriteString(" WHEN " + *p.When)
+	}
+	b.WriteString(" AS " + p.SQL)
+
+	_, err := c.ExecuteStatement(ctx, b.String())
+	return err
+}
+
+// parameterList renders params as KEY = value pairs sorted by key.
+func parameterList(params map[string]string) string {
+	pairs := make([]string, 0, len(params))
+	for _, k := range sortedKeys(params) {
+		pairs = append(pairs, k+" = "+parameterValue(params[k]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// UpdateTask brings a suspended task in line with p. Snowflake requires one
+// ALTER TASK statement per kind of change.
+func (c ClientInfo) UpdateTask(ctx context.Context, p *taskv1alpha1.TaskParameters, obs taskv1alpha1.TaskObservation) error { //nolint:gocyclo // flat list of changes
+	name := TaskName(p)
+	var stmts []string
+
+	var set, unset []string
+	switch {
+	case p.Warehouse != nil:
+		set = append(set, "WAREHOUSE = "+QuoteIdentifier(*p.Warehouse))
+	case obs.Warehouse != "":
+		unset = append(unset, "WAREHOUSE")
+	}
+	switch s := TaskSchedule(p.Schedule); {
+	case s != "":
+		set = append(set, "SCHEDULE = "+QuoteString(s))
+	case obs.Schedule != "":
+		unset = append(unset, "SCHEDULE")
+	}
+	if p.AllowOverlappingExecution != nil {
+		set = append(set, "ALLOW_OVERLAPPING_EXECUTION = "+FormatBool(*p.AllowOverlappingExecution))
+	}
+	params := TaskParameters(p)
+	if len(params) > 0 {
+		set = append(set, parameterList(params))
+	}
+	for _, k := range sortedKeys(obs.Parameters) {
+		if _, ok := params[k]; !ok {
+			unset = append(unset, k)
+		}
+	}
+	if p.ErrorIntegration != nil {
+		set = append(set, "ERROR_INTEGRATION = "+QuoteIdentifier(*p.ErrorIntegration))
+	}
+	if p.Comment != nil {
+		set = append(set, "COMMENT = "+QuoteString(*p.Comment))
+	}
+
+	if len(set) > 0 {
+		stmts = append(stmts, "ALTER TASK "+name+" SET "+strings.Join(set, " "))
+	}
+	if len(unset) > 0 {
+		stmts = append(stmts, "ALTER TASK "+name+" UNSET "+strings.Join(unset, ", "))
+	}
+
+	added, removed := taskPredecessorChanges(p, obs.Predecessors)
+	if len(removed) > 0 {
+		stmts = append(stmts, "ALTER TASK "+name+" REMOVE AFTER "+strings.Join(removed, ", "))
+	}
+	if len(added) > 0 {
+		stmts = append(stmts, "ALTER TASK "+name+" ADD AFTER "+strings.Join(added, ", "))
+	}
+
+	switch {
+	case p.When != nil && NormalizeSQL(*p.When) != NormalizeSQL(obs.Condition):
+		stmts = append(stmts, "ALTER TASK "+name+" MODIFY WHEN "+*p.When)
+	case p.When == nil && obs.Condition != "":
+		stmts = append(stmts, "ALTER TASK "+name+" REMOVE WHEN")
+	}
+
+	if NormalizeSQL(p.SQL) != NormalizeSQL(obs.Definition) {
+		stmts = append(stmts, "ALTER TASK "+name+" MODIFY AS "+p.SQL)
+	}
+
+	for _, stmt := range stmts {
+		if _, err := c.ExecuteStatement(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SuspendTask suspends the task with the given fully qualified name.
+func (c ClientInfo) SuspendTask(ctx context.Context, name string) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER TASK "+name+" SUSPEND")
+	return err
+}
+
+// ResumeTask resumes the task with the given fully qualified name.
+func (c ClientInfo) ResumeTask(ctx context.Context, name string) error {
+	_, err := c.ExecuteStatement(ctx, "ALTER TASK "+name+" RESUME")
+	return err
+}
+
+// RootTasks walks up the given predecessors of a task and returns the root
+// tasks of the DAGs they belong to. Predecessors that do not exist are
+// skipped.
+func (c ClientInfo) RootTasks(ctx context.Context, p *taskv1alpha1.TaskParameters, predecessors []string) ([]RootTask, error) {
+	var roots []RootTask
+	seen := map[string]bool{}
+
+	queue := append([]string{}, predecessors...)
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		k := taskKey(p, n)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+
+		// the key only de-duplicates, the SHOW keeps the quoting of the name
+		ids := splitIdentifier(n)
+		for i, id := range ids {
+			ids[i] = quoteIdentifierPart(id)
+		}
+		switch len(ids) {
+		case 1:
+			ids = []string{QuoteIdentifier(p.Database), QuoteIdentifier(p.Schema), ids[0]}
+		case 2:
+			ids = []string{QuoteIdentifier(p.Database), ids[0], ids[1]}
+		}
+		row, err := c.showObject(ctx, "TASKS", SplitQualifiedName(ids[2])[0], "IN SCHEMA "+ids[0]+"."+ids[1])
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		preds, err := parsePredecessors(row["predecessors"])
+		if err != nil {
+			return nil, err
+		}
+		if len(preds) == 0 {
+			roots = append(roots, RootTask{
+				Name:    strings.Join(ids, "."),
+				Started: strings.EqualFold(row["state"], "started"),
+			})
+			continue
+		}
+		queue = append(queue, preds...)
+	}
+	return roots, nil
+}
+
+// DeleteTask drops a task.
+func (c ClientInfo) DeleteTask(ctx context.Context, p *taskv1alpha1.TaskParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP TASK IF EXISTS "+TaskName(p))
+	return err
+}
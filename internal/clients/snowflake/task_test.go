@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	taskv1alpha1 "github.com/allenkallz/provider-snowflake/apis/task/v1alpha1"
+)
+
+func TestRootTasks(t *testing.T) {
+	tasks := map[string]Row{
+		`SHOW TASKS LIKE 'load' IN SCHEMA ETL.PUBLIC`: {
+			"name": "load", "state": "suspended", "predecessors": `["\"ETL\".\"Staging\".\"extract.v2\""]`,
+		},
+		`SHOW TASKS LIKE 'extract.v2' IN SCHEMA "ETL"."Staging"`: {
+			"name": "extract.v2", "state": "started",
+		},
+	}
+
+	cases := map[string]struct {
+		reason       string
+		predecessors []string
+		want         []RootTask
+		statements   []string
+	}{
+		"QuotedNames": {
+			reason:       "Predecessors should be shown once each, keeping the quoting of case-sensitive and dotted names.",
+			predecessors: []string{"load", "ETL.PUBLIC.LOAD", "missing"},
+			want:         []RootTask{{Name: `"ETL"."Staging"."extract.v2"`, Started: true}},
+			statements: []string{
+				`SHOW TASKS LIKE 'load' IN SCHEMA ETL.PUBLIC`,
+				`SHOW TASKS LIKE 'missing' IN SCHEMA ETL.PUBLIC`,
+				`SHOW TASKS LIKE 'extract.v2' IN SCHEMA "ETL"."Staging"`,
+			},
+		},
+		"UnquotedNames": {
+			reason:       "Unquoted name parts that are not plain identifiers should be quoted.",
+			predecessors: []string{`ETL.PUBLIC; DROP TABLE orders.load`},
+			statements: []string{
+				`SHOW TASKS LIKE 'load' IN SCHEMA ETL."PUBLIC; DROP TABLE orders"`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{Rows: func(stmt string) ([]Row, error) {
+				if r, ok := tasks[stmt]; ok {
+					return []Row{r}, nil
+				}
+				return nil, nil
+			}}
+			c := newTestClient(t, api)
+			p := &taskv1alpha1.TaskParameters{Database: "ETL", Schema: "PUBLIC", Name: "report"}
+			got, err := c.RootTasks(context.Background(), p, tc.predecessors)
+			if err != nil {
+				t.Fatalf("\n%s\nc.RootTasks(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nc.RootTasks(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.statements, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.RootTasks(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	whv1alpha1 "github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1"
+)
+
+// warehouseSizes maps the sizes reported by SHOW WAREHOUSES, with dashes
+// removed, to the sizes accepted by CREATE and ALTER WAREHOUSE.
+var warehouseSizes = map[string]string{
+	"2XLARGE": "XXLARGE",
+	"3XLARGE": "XXXLARGE",
+	"4XLARGE": "X4LARGE",
+	"5XLARGE": "X5LARGE",
+	"6XLARGE": "X6LARGE",
+}
+
+// WarehouseSize normalizes a warehouse size such as X-Small or 2X-Large to
+// the form used in the spec, e.g. XSMALL or XXLARGE.
+func WarehouseSize(size string) string {
+	s := strings.ToUpper(strings.ReplaceAll(size, "-", ""))
+	if n, ok := warehouseSizes[s]; ok {
+		return n
+	}
+	return s
+}
+
+// FetchWarehouse returns the observed state of a warehouse, or ErrNotFound.
+func (c ClientInfo) FetchWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters) (whv1alpha1.WarehouseObservation, error) {
+	row, err := c.showObject(ctx, "WAREHOUSES", p.Name, "")
+	if err != nil {
+		return whv1alpha1.WarehouseObservation{}, err
+	}
+
+	return whv1alpha1.WarehouseObservation{
+		State:           row["state"],
+		Type:            row["type"],
+		Size:            row["size"],
+		MinClusterCount: row["min_cluster_count"],
+		MaxClusterCount: row["max_cluster_count"],
+		ScalingPolicy:   row["scaling_policy"],
+		AutoSuspend:     nullable(row["auto_suspend"]),
+		AutoResume:      row["auto_resume"],
+		ResourceMonitor: nullable(row["resource_monitor"]),
+		Comment:         row["comment"],
+		Owner:           row["owner"],
+		CreatedOn:       row["created_on"],
+	}, nil
+}
+
+// warehouseProperties renders the properties of p that differ from obs. All
+// set properties are rendered if obs is nil.
+func warehouseProperties(p *whv1alpha1.WarehouseParameters, obs *whv1alpha1.WarehouseObservation) []string {
+	var props []string
+	if p.WarehouseType != nil && (obs == nil || !strings.EqualFold(*p.WarehouseType, obs.Type)) {
+		props = append(props, "WAREHOUSE_TYPE = "+QuoteString(*p.WarehouseType))
+	}
+	if p.WarehouseSize != nil && (obs == nil || *p.WarehouseSize != WarehouseSize(obs.Size)) {
+		props = append(props, "WAREHOUSE_SIZE = "+*p.WarehouseSize)
+	}
+	if p.MinClusterCount != nil && (obs == nil || strconv.Itoa(*p.MinClusterCount) != obs.MinClusterCount) {
+		props = append(props, "MIN_CLUSTER_COUNT = "+strconv.Itoa(*p.MinClusterCount))
+	}
+	if p.MaxClusterCount != nil && (obs == nil || strconv.Itoa(*p.MaxClusterCount) != obs.MaxClusterCount) {
+		props = append(props, "MAX_CLUSTER_COUNT = "+strconv.Itoa(*p.MaxClusterCount))
+	}
+	if p.ScalingPolicy != nil && (obs == nil || !strings.EqualFold(*p.ScalingPolicy, obs.ScalingPolicy)) {
+		props = append(props, "SCALING_POLICY = "+*p.ScalingPolicy)
+	}
+	if p.AutoSuspend != nil && (obs == nil || !sameAutoSuspend(*p.AutoSuspend, obs.AutoSuspend)) {
+		props = append(props, "AUTO_SUSPEND = "+strconv.Itoa(*p.AutoSuspend))
+	}
+	if p.AutoResume != nil && (obs == nil || !strings.EqualFold(FormatBool(*p.AutoResume), obs.AutoResume)) {
+		props = append(props, "AUTO_RESUME = "+FormatBool(*p.AutoResume))
+	}
+	if p.Comment != nil && (obs == nil || *p.Comment != obs.Comment) {
+		props = append(props, "COMMENT = "+QuoteString(*p.Comment))
+	}
+	return props
+}
+
+// sameAutoSuspend compares a desired auto suspension with the observed one,
+// which Snowflake reports as empty when the suspension is disabled.
+func sameAutoSuspend(want int, observed string) bool {
+	if want == 0 {
+		return observed == "" || observed == "0"
+	}
+	return strconv.Itoa(want) == observed
+}
+
+// WarehouseUpToDate reports whether the observed warehouse matches p.
+func WarehouseUpToDate(p *whv1alpha1.WarehouseParameters, obs whv1alpha1.WarehouseObservation) bool {
+	return len(warehouseProperties(p, &obs)) == 0
+}
+
+// CreateWarehouse creates a warehouse.
+func (c ClientInfo) CreateWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters) error {
+	props := warehouseProperties(p, nil)
+	if p.InitiallySuspended != nil {
+		props = append(props, "INITIALLY_SUSPENDED = "+FormatBool(*p.InitiallySuspended))
+	}
+
+	stmt := "CREATE WAREHOUSE " + QuoteIdentifier(p.Name)
+	if len(props) > 0 {
+		stmt += " WITH " + strings.Join(props, " ")
+	}
+	_, err := c.ExecuteStatement(ctx, stmt)
+	return err
+}
+
+// UpdateWarehouse sets the properties of a warehouse that differ from obs.
+// The resource monitor of the warehouse is left to ResourceMonitors.
+func (c ClientInfo) UpdateWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters, obs whv1alpha1.WarehouseObservation) error {
+	props := warehouseProperties(p, &obs)
+	if len(props) == 0 {
+		return nil
+	}
+	_, err := c.ExecuteStatement(ctx, "ALTER WAREHOUSE "+QuoteIdentifier(p.Name)+" SET "+strings.Join(props, " "))
+	return err
+}
+
+// DeleteWarehouse drops a warehouse.
+func (c ClientInfo) DeleteWarehouse(ctx context.Context, p *whv1alpha1.WarehouseParameters) error {
+	_, err := c.ExecuteStatement(ctx, "DROP WAREHOUSE IF EXISTS "+QuoteIdentifier(p.Name))
+	return err
+}
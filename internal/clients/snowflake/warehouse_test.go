@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	whv1alpha1 "github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1"
+)
+
+func TestWarehouseSize(t *testing.T) {
+	cases := map[string]struct {
+		size string
+		want string
+	}{
+		"XSmall":   {size: "X-Small", want: "XSMALL"},
+		"Medium":   {size: "Medium", want: "MEDIUM"},
+		"XXLarge":  {size: "2X-Large", want: "XXLARGE"},
+		"XXXLarge": {size: "3X-Large", want: "XXXLARGE"},
+		"X6Large":  {size: "6X-Large", want: "X6LARGE"},
+		"Spec":     {size: "X4LARGE", want: "X4LARGE"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := WarehouseSize(tc.size); got != tc.want {
+				t.Errorf("WarehouseSize(%q): want %q, got %q", tc.size, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCreateWarehouse(t *testing.T) {
+	size := "XSMALL"
+	suspend := 60
+	suspended := true
+
+	api := &fakeSQLAPI{}
+	c := newTestClient(t, api)
+	p := &whv1alpha1.WarehouseParameters{
+		Name:               "transform_wh",
+		WarehouseSize:      &size,
+		AutoSuspend:        &suspend,
+		InitiallySuspended: &suspended,
+	}
+	if err := c.CreateWarehouse(context.Background(), p); err != nil {
+		t.Fatalf("c.CreateWarehouse(...): %v", err)
+	}
+	want := []string{"CREATE WAREHOUSE transform_wh WITH WAREHOUSE_SIZE = XSMALL AUTO_SUSPEND = 60 INITIALLY_SUSPENDED = TRUE"}
+	if diff := cmp.Diff(want, api.statements()); diff != "" {
+		t.Errorf("c.CreateWarehouse(...): -want statements, +got:\n%s\n", diff)
+	}
+}
+
+func TestUpdateWarehouse(t *testing.T) {
+	size := "LARGE"
+	suspend := 0
+	comment := "dbt runs"
+
+	observed := whv1alpha1.WarehouseObservation{
+		Size:        "Large",
+		AutoSuspend: "600",
+		Comment:     "dbt runs",
+	}
+
+	cases := map[string]struct {
+		reason string
+		obs    whv1alpha1.WarehouseObservation
+		want   []string
+	}{
+		"Changed": {
+			reason: "Only the properties differing from the observed warehouse should be set.",
+			obs:    observed,
+			want:   []string{"ALTER WAREHOUSE transform_wh SET AUTO_SUSPEND = 0"},
+		},
+		"UpToDate": {
+			reason: "No statement should be run for a warehouse matching the spec.",
+			obs: whv1alpha1.WarehouseObservation{
+				Size:    "Large",
+				Comment: "dbt runs",
+			},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeSQLAPI{}
+			c := newTestClient(t, api)
+			p := &whv1alpha1.WarehouseParameters{
+				Name:          "transform_wh",
+				WarehouseSize: &size,
+				AutoSuspend:   &suspend,
+				Comment:       &comment,
+			}
+			if err := c.UpdateWarehouse(context.Background(), p, tc.obs); err != nil {
+				t.Fatalf("\n%s\nc.UpdateWarehouse(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, api.statements()); diff != "" {
+				t.Errorf("\n%s\nc.UpdateWarehouse(...): -want statements, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/account/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.AccountClient
+
+	MockFetchAccount func(ctx context.Context, p *v1alpha1.AccountParameters) (v1alpha1.AccountObservation, error)
+}
+
+func (m *mockClient) FetchAccount(ctx context.Context, p *v1alpha1.AccountParameters) (v1alpha1.AccountObservation, error) {
+	return m.MockFetchAccount(ctx, p)
+}
+
+func account(p v1alpha1.AccountParameters) *v1alpha1.Account {
+	return &v1alpha1.Account{Spec: v1alpha1.AccountSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.AccountParameters{
+		Name:              "team_analytics",
+		AdminName:         "admin",
+		AdminRSAPublicKey: "MIIBIjANBgkqh...",
+		Email:             "analytics@example.com",
+		Edition:           "ENTERPRISE",
+		GracePeriodInDays: 3,
+		Comment:           ptr.To("analytics team"),
+	}
+
+	observed := v1alpha1.AccountObservation{
+		OrganizationName:  "ACME",
+		AccountLocator:    "XY12345",
+		AccountURL:        "https://acme-team_analytics.snowflakecomputing.com",
+		AccountLocatorURL: "https://xy12345.eu-central-1.snowflakecomputing.com",
+		Edition:           "ENTERPRISE",
+		Region:            "AWS_EU_CENTRAL_1",
+		Comment:           "analytics team",
+	}
+
+	details := managed.ConnectionDetails{
+		v1alpha1.ConnectionDetailAccountIdentifier: []byte("ACME-TEAM_ANALYTICS"),
+		v1alpha1.ConnectionDetailAccountLocator:    []byte("XY12345"),
+		v1alpha1.ConnectionDetailAccountURL:        []byte("https://acme-team_analytics.snowflakecomputing.com"),
+		v1alpha1.ConnectionDetailAccountLocatorURL: []byte("https://xy12345.eu-central-1.snowflakecomputing.com"),
+		v1alpha1.ConnectionDetailAdminName:         []byte("admin"),
+	}
+
+	found := func(obs v1alpha1.AccountObservation) func(context.Context, *v1alpha1.AccountParameters) (v1alpha1.AccountObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.AccountParameters) (v1alpha1.AccountObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.AccountClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "An account that does not exist should be reported as such.",
+			client: &mockClient{MockFetchAccount: func(_ context.Context, _ *v1alpha1.AccountParameters) (v1alpha1.AccountObservation, error) {
+				return v1alpha1.AccountObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: account(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the account should be returned.",
+			client: &mockClient{MockFetchAccount: func(_ context.Context, _ *v1alpha1.AccountParameters) (v1alpha1.AccountObservation, error) {
+				return v1alpha1.AccountObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: account(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "An existing account should be up to date and publish its identifier, locator and URLs.",
+			client: &mockClient{MockFetchAccount: found(observed)},
+			args:   args{ctx: context.Background(), mg: account(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details}},
+		},
+		"CommentChanged": {
+			reason: "An account with another comment should need an update.",
+			client: &mockClient{MockFetchAccount: found(v1alpha1.AccountObservation{
+				OrganizationName:  observed.OrganizationName,
+				AccountLocator:    observed.AccountLocator,
+				AccountURL:        observed.AccountURL,
+				AccountLocatorURL: observed.AccountLocatorURL,
+				Comment:           "data team",
+			})},
+			args: args{ctx: context.Background(), mg: account(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: details}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
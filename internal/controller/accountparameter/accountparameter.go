@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountparameter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/parameter/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotAccountParameter = "managed resource is not an AccountParameter custom resource"
+	errTrackPCUsage        = "cannot track ProviderConfig usage"
+	errGetPC               = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed = "cannot set account parameter"
+	errUpdateFailed = "cannot update account parameter"
+	errDeleteFailed = "cannot unset account parameter"
+	errGetFailed    = "cannot retrieve account parameter"
+)
+
+// Setup adds a controller that reconciles AccountParameter managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AccountParameterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AccountParameterGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.AccountParameter{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AccountParameter)
+	if !ok {
+		return nil, errors.New(errNotAccountParameter)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.AccountParameterClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccountParameter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccountParameter)
+	}
+
+	obs, err := e.client.FetchAccountParameter(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, obs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccountParameter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccountParameter)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, errors.Wrap(e.client.SetAccountParameter(ctx, &cr.Spec.ForProvider), errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AccountParameter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccountParameter)
+	}
+
+	err := e.client.SetAccountParameter(ctx, &cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AccountParameter)
+	if !ok {
+		return errors.New(errNotAccountParameter)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return errors.Wrap(e.client.UnsetAccountParameter(ctx, &cr.Spec.ForProvider), errDeleteFailed)
+}
+
+func isUpToDate(p v1alpha1.AccountParameterParameters, obs v1alpha1.AccountParameterObservation) bool {
+	// booleans and keywords are reported in upper case
+	return strings.EqualFold(p.Value, obs.Value)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountparameter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/parameter/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.AccountParameterClient
+
+	MockFetchAccountParameter func(ctx context.Context, p *v1alpha1.AccountParameterParameters) (v1alpha1.AccountParameterObservation, error)
+}
+
+func (m *mockClient) FetchAccountParameter(ctx context.Context, p *v1alpha1.AccountParameterParameters) (v1alpha1.AccountParameterObservation, error) {
+	return m.MockFetchAccountParameter(ctx, p)
+}
+
+func accountParameter(p v1alpha1.AccountParameterParameters) *v1alpha1.AccountParameter {
+	return &v1alpha1.AccountParameter{Spec: v1alpha1.AccountParameterSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.AccountParameterParameters{
+		Key:   "STATEMENT_TIMEOUT_IN_SECONDS",
+		Value: "3600",
+	}
+
+	found := func(value string) func(context.Context, *v1alpha1.AccountParameterParameters) (v1alpha1.AccountParameterObservation, error) {
+		return func(_ context.Context, p *v1alpha1.AccountParameterParameters) (v1alpha1.AccountParameterObservation, error) {
+			return v1alpha1.AccountParameterObservation{Value: value, Level: "ACCOUNT"}, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.AccountParameterClient
+		args   args
+		want   want
+	}{
+		"NotSet": {
+			reason: "A parameter not set at the managed level should be reported as not existing.",
+			client: &mockClient{MockFetchAccountParameter: func(_ context.Context, _ *v1alpha1.AccountParameterParameters) (v1alpha1.AccountParameterObservation, error) {
+				return v1alpha1.AccountParameterObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: accountParameter(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the parameter should be returned.",
+			client: &mockClient{MockFetchAccountParameter: func(_ context.Context, _ *v1alpha1.AccountParameterParameters) (v1alpha1.AccountParameterObservation, error) {
+				return v1alpha1.AccountParameterObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: accountParameter(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A parameter set to the desired value should be up to date.",
+			client: &mockClient{MockFetchAccountParameter: found("3600")},
+			args:   args{ctx: context.Background(), mg: accountParameter(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Drifted": {
+			reason: "A parameter set to another value at the managed level should need an update.",
+			client: &mockClient{MockFetchAccountParameter: found("172800")},
+			args:   args{ctx: context.Background(), mg: accountParameter(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alert
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/alert/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotAlert     = "managed resource is not an Alert custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed = "cannot create alert"
+	errUpdateFailed = "cannot update alert"
+	errDeleteFailed = "cannot delete alert"
+	errGetFailed    = "cannot retrieve alert"
+
+	errSuspendFailed = "cannot suspend alert"
+	errResumeFailed  = "cannot resume alert"
+)
+
+// Setup adds a controller that reconciles Alert managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AlertGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AlertGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Alert{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Alert)
+	if !ok {
+		return nil, errors.New(errNotAlert)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.AlertClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Alert)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAlert)
+	}
+
+	obs, err := e.client.FetchAlert(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(&cr.Spec.ForProvider, obs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Alert)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAlert)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := &cr.Spec.ForProvider
+	if err := e.client.CreateAlert(ctx, p); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	if !started(p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, errors.Wrap(e.client.ResumeAlert(ctx, snowflake.AlertName(p)), errResumeFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Alert)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAlert)
+	}
+
+	p := &cr.Spec.ForProvider
+	obs := cr.Status.AtProvider
+	name := snowflake.AlertName(p)
+
+	// alerts are altered while suspended, and resumed afterwards if desired
+	if strings.EqualFold(obs.State, "started") {
+		if err := e.client.SuspendAlert(ctx, name); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSuspendFailed)
+		}
+	}
+	if err := e.client.UpdateAlert(ctx, p, obs); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if !started(p) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.client.ResumeAlert(ctx, name), errResumeFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Alert)
+	if !ok {
+		return errors.New(errNotAlert)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return errors.Wrap(e.client.DeleteAlert(ctx, &cr.Spec.ForProvider), errDeleteFailed)
+}
+
+// started reports whether the alert should be resumed.
+func started(p *v1alpha1.AlertParameters) bool {
+	return p.State != v1alpha1.AlertStateSuspended
+}
+
+func isUpToDate(p *v1alpha1.AlertParameters, obs v1alpha1.AlertObservation) bool {
+	if started(p) != strings.EqualFold(obs.State, "started") {
+		return false
+	}
+	if snowflake.AlertSchedule(p.Schedule) != strings.Join(strings.Fields(obs.Schedule), " ") {
+		return false
+	}
+	if snowflake.NormalizeSQL(p.Condition) != snowflake.NormalizeSQL(obs.Condition) || snowflake.NormalizeSQL(p.Action) != snowflake.NormalizeSQL(obs.Action) {
+		return false
+	}
+	if p.Warehouse != nil && !strings.EqualFold(*p.Warehouse, obs.Warehouse) {
+		return false
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		return false
+	}
+	return true
+}
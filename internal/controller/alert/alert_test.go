@@ -0,0 +1,344 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/alert/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.AlertClient
+
+	MockFetchAlert   func(ctx context.Context, p *v1alpha1.AlertParameters) (v1alpha1.AlertObservation, error)
+	MockCreateAlert  func(ctx context.Context, p *v1alpha1.AlertParameters) error
+	MockUpdateAlert  func(ctx context.Context, p *v1alpha1.AlertParameters, obs v1alpha1.AlertObservation) error
+	MockSuspendAlert func(ctx context.Context, name string) error
+	MockResumeAlert  func(ctx context.Context, name string) error
+}
+
+func (m *mockClient) FetchAlert(ctx context.Context, p *v1alpha1.AlertParameters) (v1alpha1.AlertObservation, error) {
+	return m.MockFetchAlert(ctx, p)
+}
+
+func (m *mockClient) CreateAlert(ctx context.Context, p *v1alpha1.AlertParameters) error {
+	return m.MockCreateAlert(ctx, p)
+}
+
+func (m *mockClient) UpdateAlert(ctx context.Context, p *v1alpha1.AlertParameters, obs v1alpha1.AlertObservation) error {
+	return m.MockUpdateAlert(ctx, p, obs)
+}
+
+func (m *mockClient) SuspendAlert(ctx context.Context, name string) error {
+	return m.MockSuspendAlert(ctx, name)
+}
+
+func (m *mockClient) ResumeAlert(ctx context.Context, name string) error {
+	return m.MockResumeAlert(ctx, name)
+}
+
+// recorder returns a client that records the operations it is called with,
+// failing the one named fail with err.
+func recorder(calls *[]string, fail string, err error) *mockClient {
+	record := func(op string) error {
+		*calls = append(*calls, op)
+		if op == fail {
+			return err
+		}
+		return nil
+	}
+	return &mockClient{
+		MockCreateAlert: func(_ context.Context, _ *v1alpha1.AlertParameters) error { return record("create") },
+		MockUpdateAlert: func(_ context.Context, _ *v1alpha1.AlertParameters, _ v1alpha1.AlertObservation) error {
+			return record("update")
+		},
+		MockSuspendAlert: func(_ context.Context, name string) error { return record("suspend " + name) },
+		MockResumeAlert:  func(_ context.Context, name string) error { return record("resume " + name) },
+	}
+}
+
+func alert(p v1alpha1.AlertParameters) *v1alpha1.Alert {
+	return &v1alpha1.Alert{Spec: v1alpha1.AlertSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.AlertParameters{
+		Name:      "negative_amounts",
+		Database:  "sales",
+		Schema:    "public",
+		Warehouse: ptr.To("alerting"),
+		Schedule:  v1alpha1.AlertSchedule{Cron: ptr.To("0  * * * * UTC")},
+		Condition: "SELECT 1 FROM orders WHERE amount < 0",
+		Action:    "CALL notify('negative amounts')",
+	}
+
+	observed := func(state, condition string) v1alpha1.AlertObservation {
+		return v1alpha1.AlertObservation{
+			State:              state,
+			Schedule:           "USING CRON 0 * * * * UTC",
+			Warehouse:          "ALERTING",
+			Condition:          condition,
+			Action:             "CALL notify('negative amounts')",
+			LastExecutionState: "CONDITION_FALSE",
+		}
+	}
+
+	found := func(obs v1alpha1.AlertObservation) func(context.Context, *v1alpha1.AlertParameters) (v1alpha1.AlertObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.AlertParameters) (v1alpha1.AlertObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.AlertClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "An alert that does not exist should be reported as such.",
+			client: &mockClient{MockFetchAlert: func(_ context.Context, _ *v1alpha1.AlertParameters) (v1alpha1.AlertObservation, error) {
+				return v1alpha1.AlertObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: alert(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the alert should be returned.",
+			client: &mockClient{MockFetchAlert: func(_ context.Context, _ *v1alpha1.AlertParameters) (v1alpha1.AlertObservation, error) {
+				return v1alpha1.AlertObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: alert(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A started alert matching the spec should be up to date.",
+			client: &mockClient{MockFetchAlert: found(observed("started", "SELECT 1\nFROM orders\nWHERE amount < 0"))},
+			args:   args{ctx: context.Background(), mg: alert(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Suspended": {
+			reason: "A suspended alert desired to be started should be resumed.",
+			client: &mockClient{MockFetchAlert: found(observed("suspended", "SELECT 1 FROM orders WHERE amount < 0"))},
+			args:   args{ctx: context.Background(), mg: alert(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"ConditionChanged": {
+			reason: "An alert whose condition differs from the spec should need an update.",
+			client: &mockClient{MockFetchAlert: found(observed("started", "SELECT 1 FROM orders WHERE amount <= 0"))},
+			args:   args{ctx: context.Background(), mg: alert(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.AlertParameters{
+		Name:      "negative_amounts",
+		Database:  "sales",
+		Schema:    "public",
+		Schedule:  v1alpha1.AlertSchedule{Minutes: ptr.To(60)},
+		Condition: "SELECT 1 FROM orders WHERE amount < 0",
+		Action:    "CALL notify('negative amounts')",
+	}
+	suspended := params
+	suspended.State = v1alpha1.AlertStateSuspended
+
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.AlertParameters
+		fail   string
+		want   want
+	}{
+		"Started": {
+			reason: "An alert should be resumed once created.",
+			p:      params,
+			want:   want{calls: []string{"create", "resume sales.public.negative_amounts"}},
+		},
+		"Suspended": {
+			reason: "An alert that should be suspended should be left as created.",
+			p:      suspended,
+			want:   want{calls: []string{"create"}},
+		},
+		"CreateError": {
+			reason: "Errors creating the alert should be returned without resuming it.",
+			p:      params,
+			fail:   "create",
+			want:   want{calls: []string{"create"}, err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{client: recorder(&calls, tc.fail, errBoom)}
+			_, err := e.Create(context.Background(), alert(tc.p))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want calls, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.AlertParameters{
+		Name:      "negative_amounts",
+		Database:  "sales",
+		Schema:    "public",
+		Schedule:  v1alpha1.AlertSchedule{Minutes: ptr.To(60)},
+		Condition: "SELECT 1 FROM orders WHERE amount < 0",
+		Action:    "CALL notify('negative amounts')",
+	}
+	suspended := params
+	suspended.State = v1alpha1.AlertStateSuspended
+
+	// withState returns an alert observed in the given state
+	withState := func(p v1alpha1.AlertParameters, state string) *v1alpha1.Alert {
+		cr := alert(p)
+		cr.Status.AtProvider.State = state
+		return cr
+	}
+
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.Alert
+		fail   string
+		want   want
+	}{
+		"Started": {
+			reason: "A started alert should be suspended while it is altered and resumed afterwards.",
+			mg:     withState(params, "started"),
+			want: want{calls: []string{
+				"suspend sales.public.negative_amounts",
+				"update",
+				"resume sales.public.negative_amounts",
+			}},
+		},
+		"Suspend": {
+			reason: "A started alert that should be suspended should be left suspended once altered.",
+			mg:     withState(suspended, "started"),
+			want:   want{calls: []string{"suspend sales.public.negative_amounts", "update"}},
+		},
+		"Resume": {
+			reason: "A suspended alert should be altered without suspending it and resumed if it should be started.",
+			mg:     withState(params, "suspended"),
+			want:   want{calls: []string{"update", "resume sales.public.negative_amounts"}},
+		},
+		"SuspendError": {
+			reason: "An alert that cannot be suspended should not be altered.",
+			mg:     withState(params, "started"),
+			fail:   "suspend sales.public.negative_amounts",
+			want: want{
+				calls: []string{"suspend sales.public.negative_amounts"},
+				err:   errors.Wrap(errBoom, errSuspendFailed),
+			},
+		},
+		"UpdateError": {
+			reason: "Errors altering the alert should be returned.",
+			mg:     withState(params, "started"),
+			fail:   "update",
+			want: want{
+				calls: []string{"suspend sales.public.negative_amounts", "update"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"ResumeError": {
+			reason: "Errors resuming the alert should be returned.",
+			mg:     withState(params, "started"),
+			fail:   "resume sales.public.negative_amounts",
+			want: want{
+				calls: []string{"suspend sales.public.negative_amounts", "update", "resume sales.public.negative_amounts"},
+				err:   errors.Wrap(errBoom, errResumeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{client: recorder(&calls, tc.fail, errBoom)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiintegration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotApiIntegration = "managed resource is not a ApiIntegration custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed = "cannot create API integration"
+	errUpdateFailed = "cannot update API integration"
+	errDeleteFailed = "cannot delete API integration"
+	errGetFailed    = "cannot retrieve API integration"
+	errGetAPIKey    = "cannot get API key"
+)
+
+// Setup adds a controller that reconciles ApiIntegration managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApiIntegrationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ApiIntegrationGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.ApiIntegration{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApiIntegration)
+	if !ok {
+		return nil, errors.New(errNotApiIntegration)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.ApiIntegrationClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApiIntegration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApiIntegration)
+	}
+
+	obs, err := e.client.FetchApiIntegration(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(cr.Spec.ForProvider, obs),
+		ConnectionDetails: connectionDetails(obs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApiIntegration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApiIntegration)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	key, err := e.apiKey(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, errors.Wrap(e.client.CreateApiIntegration(ctx, &cr.Spec.ForProvider, key), errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ApiIntegration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApiIntegration)
+	}
+
+	key, err := e.apiKey(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	err = e.client.UpdateApiIntegration(ctx, &cr.Spec.ForProvider, cr.Status.AtProvider, key)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ApiIntegration)
+	if !ok {
+		return errors.New(errNotApiIntegration)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return errors.Wrap(e.client.DeleteApiIntegration(ctx, &cr.Spec.ForProvider), errDeleteFailed)
+}
+
+// apiKey reads the API key referenced by an API integration, if any.
+func (e *external) apiKey(ctx context.Context, p *v1alpha1.ApiIntegrationParameters) (string, error) {
+	if p.APIKeySecretRef == nil {
+		return "", nil
+	}
+	key, err := snowflake.GetSecretValue(ctx, e.kube, *p.APIKeySecretRef)
+	return key, errors.Wrap(err, errGetAPIKey)
+}
+
+// connectionDetails publishes the identities the API provider has to trust.
+// Only those of the provider of the integration are set.
+func connectionDetails(obs v1alpha1.ApiIntegrationObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for k, v := range map[string]string{
+		v1alpha1.ConnectionDetailAPIAWSIAMUserARN:        obs.APIAWSIAMUserARN,
+		v1alpha1.ConnectionDetailAPIAWSExternalID:        obs.APIAWSExternalID,
+		v1alpha1.ConnectionDetailAPIGCPServiceAccount:    obs.APIGCPServiceAccount,
+		v1alpha1.ConnectionDetailAzureConsentURL:         obs.AzureConsentURL,
+		v1alpha1.ConnectionDetailAzureMultiTenantAppName: obs.AzureMultiTenantAppName,
+	} {
+		if v != "" {
+			cd[k] = []byte(v)
+		}
+	}
+	return cd
+}
+
+// isUpToDate compares the properties Snowflake reports back. The API key
+// cannot be observed and is only applied on create or when another property
+// drifts.
+func isUpToDate(p v1alpha1.ApiIntegrationParameters, obs v1alpha1.ApiIntegrationObservation) bool {
+	if p.Enabled != nil && *p.Enabled != obs.Enabled {
+		return false
+	}
+	if !snowflake.SameNames(p.APIAllowedPrefixes, obs.APIAllowedPrefixes) ||
+		!snowflake.SameNames(p.APIBlockedPrefixes, obs.APIBlockedPrefixes) {
+		return false
+	}
+	if p.APIAWSRoleARN != nil && *p.APIAWSRoleARN != obs.APIAWSRoleARN {
+		return false
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		return false
+	}
+	return true
+}
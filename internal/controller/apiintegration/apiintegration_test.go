@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiintegration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ApiIntegrationClient
+
+	MockFetchApiIntegration func(ctx context.Context, p *v1alpha1.ApiIntegrationParameters) (v1alpha1.ApiIntegrationObservation, error)
+}
+
+func (m *mockClient) FetchApiIntegration(ctx context.Context, p *v1alpha1.ApiIntegrationParameters) (v1alpha1.ApiIntegrationObservation, error) {
+	return m.MockFetchApiIntegration(ctx, p)
+}
+
+func integration(p v1alpha1.ApiIntegrationParameters) *v1alpha1.ApiIntegration {
+	return &v1alpha1.ApiIntegration{Spec: v1alpha1.ApiIntegrationSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ApiIntegrationParameters{
+		Name:               "gateway",
+		APIProvider:        "aws_api_gateway",
+		APIAWSRoleARN:      ptr.To("arn:aws:iam::123456789012:role/snowflake-api"),
+		APIAllowedPrefixes: []string{"https://abc.execute-api.us-west-2.amazonaws.com/prod/"},
+		Enabled:            ptr.To(true),
+	}
+
+	observed := v1alpha1.ApiIntegrationObservation{
+		Enabled:            true,
+		APIProvider:        "AWS_API_GATEWAY",
+		APIAWSRoleARN:      "arn:aws:iam::123456789012:role/snowflake-api",
+		APIAWSIAMUserARN:   "arn:aws:iam::999999999999:user/abc",
+		APIAWSExternalID:   "ACCOUNT_SFCRole=2_abc",
+		APIAllowedPrefixes: []string{"https://abc.execute-api.us-west-2.amazonaws.com/prod/"},
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ApiIntegrationClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "An API integration that does not exist should be reported as such.",
+			client: &mockClient{MockFetchApiIntegration: func(_ context.Context, _ *v1alpha1.ApiIntegrationParameters) (v1alpha1.ApiIntegrationObservation, error) {
+				return v1alpha1.ApiIntegrationObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the API integration should be returned.",
+			client: &mockClient{MockFetchApiIntegration: func(_ context.Context, _ *v1alpha1.ApiIntegrationParameters) (v1alpha1.ApiIntegrationObservation, error) {
+				return v1alpha1.ApiIntegrationObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "An up to date API integration should publish the IAM user ARN and external ID.",
+			client: &mockClient{MockFetchApiIntegration: func(_ context.Context, _ *v1alpha1.ApiIntegrationParameters) (v1alpha1.ApiIntegrationObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionDetailAPIAWSIAMUserARN: []byte("arn:aws:iam::999999999999:user/abc"),
+					v1alpha1.ConnectionDetailAPIAWSExternalID: []byte("ACCOUNT_SFCRole=2_abc"),
+				},
+			}},
+		},
+		"PrefixBlocked": {
+			reason: "An API integration missing a blocked prefix should need an update.",
+			client: &mockClient{MockFetchApiIntegration: func(_ context.Context, _ *v1alpha1.ApiIntegrationParameters) (v1alpha1.ApiIntegrationObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(func() v1alpha1.ApiIntegrationParameters {
+				p := params
+				p.APIBlockedPrefixes = []string{"https://abc.execute-api.us-west-2.amazonaws.com/prod/admin"}
+				return p
+			}())},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionDetailAPIAWSIAMUserARN: []byte("arn:aws:iam::999999999999:user/abc"),
+					v1alpha1.ConnectionDetailAPIAWSExternalID: []byte("ACCOUNT_SFCRole=2_abc"),
+				},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
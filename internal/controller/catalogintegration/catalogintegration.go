@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogintegration
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotCatalogIntegration = "managed resource is not a CatalogIntegration custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed = "cannot create catalog integration"
+	errUpdateFailed = "cannot update catalog integration"
+	errDeleteFailed = "cannot delete catalog integration"
+	errGetFailed    = "cannot retrieve catalog integration"
+	errGetSecret    = "cannot get OAuth client secret"
+)
+
+// Setup adds a controller that reconciles CatalogIntegration managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CatalogIntegrationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CatalogIntegrationGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.CatalogIntegration{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CatalogIntegration)
+	if !ok {
+		return nil, errors.New(errNotCatalogIntegration)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.CatalogIntegrationClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CatalogIntegration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCatalogIntegration)
+	}
+
+	obs, err := e.client.FetchCatalogIntegration(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(cr.Spec.ForProvider, obs),
+		ConnectionDetails: connectionDetails(obs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CatalogIntegration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCatalogIntegration)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	secret, err := e.clientSecret(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, errors.Wrap(e.client.CreateCatalogIntegration(ctx, &cr.Spec.ForProvider, secret), errCreateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CatalogIntegration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCatalogIntegration)
+	}
+
+	secret, err := e.clientSecret(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	err = e.client.UpdateCatalogIntegration(ctx, &cr.Spec.ForProvider, secret)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CatalogIntegration)
+	if !ok {
+		return errors.New(errNotCatalogIntegration)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return errors.Wrap(e.client.DeleteCatalogIntegration(ctx, &cr.Spec.ForProvider), errDeleteFailed)
+}
+
+// clientSecret reads the OAuth client secret of a Polaris catalog, if any.
+func (e *external) clientSecret(ctx context.Context, p *v1alpha1.CatalogIntegrationParameters) (string, error) {
+	if p.Polaris == nil {
+		return "", nil
+	}
+	secret, err := snowflake.GetSecretValue(ctx, e.kube, p.Polaris.OAuthClientSecretSecretRef)
+	return secret, errors.Wrap(err, errGetSecret)
+}
+
+// connectionDetails publishes the identity the AWS role of a Glue catalog has
+// to trust.
+func connectionDetails(obs v1alpha1.CatalogIntegrationObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for k, v := range map[string]string{
+		v1alpha1.ConnectionDetailGlueAWSIAMUserARN: obs.GlueAWSIAMUserARN,
+		v1alpha1.ConnectionDetailGlueAWSExternalID: obs.GlueAWSExternalID,
+	} {
+		if v != "" {
+			cd[k] = []byte(v)
+		}
+	}
+	return cd
+}
+
+// isUpToDate compares the properties Snowflake reports back. The OAuth client
+// secret cannot be observed and is only applied on create or when another
+// property drifts.
+func isUpToDate(p v1alpha1.CatalogIntegrationParameters, obs v1alpha1.CatalogIntegrationObservation) bool {
+	if p.Enabled != nil && *p.Enabled != obs.Enabled {
+		return false
+	}
+	if p.RefreshIntervalSeconds != nil && strconv.Itoa(*p.RefreshIntervalSeconds) != obs.RefreshIntervalSeconds {
+		return false
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		return false
+	}
+	return true
+}
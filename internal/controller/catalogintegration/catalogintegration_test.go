@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogintegration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+type mockClient struct {
+	snowflake.CatalogIntegrationClient
+
+	MockFetchCatalogIntegration func(ctx context.Context, p *v1alpha1.CatalogIntegrationParameters) (v1alpha1.CatalogIntegrationObservation, error)
+}
+
+func (m *mockClient) FetchCatalogIntegration(ctx context.Context, p *v1alpha1.CatalogIntegrationParameters) (v1alpha1.CatalogIntegrationObservation, error) {
+	return m.MockFetchCatalogIntegration(ctx, p)
+}
+
+func integration(p v1alpha1.CatalogIntegrationParameters) *v1alpha1.CatalogIntegration {
+	return &v1alpha1.CatalogIntegration{Spec: v1alpha1.CatalogIntegrationSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.CatalogIntegrationParameters{
+		Name:             "glue",
+		CatalogSource:    "GLUE",
+		TableFormat:      "ICEBERG",
+		CatalogNamespace: ptr.To("lake"),
+		Glue: &v1alpha1.GlueCatalog{
+			AWSRoleARN: "arn:aws:iam::123456789012:role/snowflake",
+			CatalogID:  "123456789012",
+		},
+		Enabled:                ptr.To(true),
+		RefreshIntervalSeconds: ptr.To(60),
+	}
+
+	observed := v1alpha1.CatalogIntegrationObservation{
+		Enabled:                true,
+		CatalogSource:          "GLUE",
+		TableFormat:            "ICEBERG",
+		CatalogNamespace:       "lake",
+		GlueAWSRoleARN:         "arn:aws:iam::123456789012:role/snowflake",
+		GlueAWSIAMUserARN:      "arn:aws:iam::999999999999:user/abc",
+		GlueAWSExternalID:      "ACCOUNT_SFCRole=2_abc",
+		RefreshIntervalSeconds: "60",
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.CatalogIntegrationClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A catalog integration that does not exist should be reported as such.",
+			client: &mockClient{MockFetchCatalogIntegration: func(_ context.Context, _ *v1alpha1.CatalogIntegrationParameters) (v1alpha1.CatalogIntegrationObservation, error) {
+				return v1alpha1.CatalogIntegrationObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the catalog integration should be returned.",
+			client: &mockClient{MockFetchCatalogIntegration: func(_ context.Context, _ *v1alpha1.CatalogIntegrationParameters) (v1alpha1.CatalogIntegrationObservation, error) {
+				return v1alpha1.CatalogIntegrationObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "An up to date Glue integration should publish the IAM user ARN and external ID.",
+			client: &mockClient{MockFetchCatalogIntegration: func(_ context.Context, _ *v1alpha1.CatalogIntegrationParameters) (v1alpha1.CatalogIntegrationObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionDetailGlueAWSIAMUserARN: []byte("arn:aws:iam::999999999999:user/abc"),
+					v1alpha1.ConnectionDetailGlueAWSExternalID: []byte("ACCOUNT_SFCRole=2_abc"),
+				},
+			}},
+		},
+		"RefreshIntervalChanged": {
+			reason: "An integration with a different refresh interval should need an update.",
+			client: &mockClient{MockFetchCatalogIntegration: func(_ context.Context, _ *v1alpha1.CatalogIntegrationParameters) (v1alpha1.CatalogIntegrationObservation, error) {
+				return v1alpha1.CatalogIntegrationObservation{
+					Enabled:                true,
+					CatalogSource:          "OBJECT_STORE",
+					TableFormat:            "ICEBERG",
+					RefreshIntervalSeconds: "30",
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(v1alpha1.CatalogIntegrationParameters{
+				Name:                   "object_store",
+				CatalogSource:          "OBJECT_STORE",
+				TableFormat:            "ICEBERG",
+				RefreshIntervalSeconds: ptr.To(300),
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -52,6 +52,10 @@ const (
 	errUpdateFailed = "cannot update database"
 	errDeleteFailed = "cannot delete database"
 	errGetFailed    = "cannot retrieve database"
+	errGetTags      = "cannot retrieve database tags"
+	errSetTags      = "cannot set database tags"
+
+	errDeletionProtected = "database has deletion protection enabled; set deletionProtection to false to delete it"
 )
 
 // // A NoOpService does nothing.
@@ -174,6 +178,18 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	fmt.Println("response fetch db: ", dbinfo)
 
+	// tags removed from the spec are only known from the last observation
+	tags := map[string]string{}
+	if keys := snowflake.TagKeys(cr.Spec.ForProvider.Tags, cr.Status.AtProvider.Tags); len(keys) > 0 {
+		if tags, err = e.client.FetchTags(ctx, "DATABASE", cr.Spec.ForProvider.Name, keys); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetTags)
+		}
+	}
+	cr.Status.AtProvider.Name = dbinfo.Name
+	cr.Status.AtProvider.Tags = tags
+
+	set, unset := snowflake.TagChanges(cr.Spec.ForProvider.Tags, tags)
+
 	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
@@ -185,7 +201,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// Return false when the external resource exists, but it not up to date
 		// with the desired managed resource state. This lets the managed
 		// resource reconciler know that it needs to call Update.
-		ResourceUpToDate: true,
+		ResourceUpToDate: len(set) == 0 && len(unset) == 0,
 
 		// Return any details that may be required to connect to the external
 		// resource. These will be stored as the connection secret.
@@ -212,6 +228,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
+	if tags := cr.Spec.ForProvider.Tags; len(tags) > 0 {
+		if err := e.client.SetTags(ctx, "DATABASE", cr.Spec.ForProvider.Name, tags, nil); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errSetTags)
+		}
+	}
+
 	return managed.ExternalCreation{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -227,6 +249,11 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	fmt.Printf("Updating: %+v", cr)
 
+	set, unset := snowflake.TagChanges(cr.Spec.ForProvider.Tags, cr.Status.AtProvider.Tags)
+	if err := e.client.SetTags(ctx, "DATABASE", cr.Spec.ForProvider.Name, set, unset); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSetTags)
+	}
+
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -242,10 +269,13 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	fmt.Printf("Deleting: %+v", cr)
 
-	cr.SetConditions(xpv1.Deleting())
+	// keep the database and tell why the resource is stuck deleting
+	if p := cr.Spec.ForProvider.DeletionProtection; p != nil && *p {
+		cr.SetConditions(xpv1.Deleting().WithMessage(errDeletionProtected))
+		return errors.New(errDeletionProtected)
+	}
 
-	err := e.client.DeleteDatabase(ctx, &cr.Spec.ForProvider)
+	cr.SetConditions(xpv1.Deleting())
 
-	fmt.Println("err: ", err)
-	return err
+	return errors.Wrap(e.client.DeleteDatabase(ctx, &cr.Spec.ForProvider), errDeleteFailed)
 }
@@ -20,8 +20,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
 	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -36,7 +39,45 @@ import (
 // https://github.com/golang/go/wiki/TestComments
 // https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
 
+type mockClient struct {
+	snowflake.DatabaseClient
+
+	MockFetchDatabase  func(ctx context.Context, db *v1alpha1.DatabaseParameters) (snowflake.DbInfo, error)
+	MockFetchTags      func(ctx context.Context, objectType, object string, tags []string) (map[string]string, error)
+	MockDeleteDatabase func(ctx context.Context, db *v1alpha1.DatabaseParameters) error
+}
+
+func (m *mockClient) FetchDatabase(ctx context.Context, db *v1alpha1.DatabaseParameters) (snowflake.DbInfo, error) {
+	return m.MockFetchDatabase(ctx, db)
+}
+
+func (m *mockClient) FetchTags(ctx context.Context, objectType, object string, tags []string) (map[string]string, error) {
+	return m.MockFetchTags(ctx, objectType, object, tags)
+}
+
+func (m *mockClient) DeleteDatabase(ctx context.Context, db *v1alpha1.DatabaseParameters) error {
+	return m.MockDeleteDatabase(ctx, db)
+}
+
+func database(p v1alpha1.DatabaseParameters, observedTags map[string]string) *v1alpha1.Database {
+	return &v1alpha1.Database{
+		Spec:   v1alpha1.DatabaseSpec{ForProvider: p},
+		Status: v1alpha1.DatabaseStatus{AtProvider: v1alpha1.DatabaseObservation{Tags: observedTags}},
+	}
+}
+
 func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	found := func(_ context.Context, db *v1alpha1.DatabaseParameters) (snowflake.DbInfo, error) {
+		return snowflake.DbInfo{Name: db.Name, Kind: "PERMANENT"}, nil
+	}
+
+	tagged := v1alpha1.DatabaseParameters{
+		Name: "analytics",
+		Tags: map[string]string{"GOVERNANCE.PUBLIC.COST_CENTER": "data-platform"},
+	}
+
 	// type fields struct {
 	// 	service interface{}
 	// }
@@ -57,7 +98,50 @@ func TestObserve(t *testing.T) {
 		args   args
 		want   want
 	}{
-		// TODO: Add test cases.
+		"NotFound": {
+			reason: "A database that does not exist should be reported as such.",
+			client: &mockClient{MockFetchDatabase: func(_ context.Context, _ *v1alpha1.DatabaseParameters) (snowflake.DbInfo, error) {
+				return snowflake.DbInfo{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: database(tagged, nil)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"Untagged": {
+			reason: "A database without tags should not look them up and be up to date.",
+			client: &mockClient{MockFetchDatabase: found},
+			args:   args{ctx: context.Background(), mg: database(v1alpha1.DatabaseParameters{Name: "analytics"}, nil)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+		"FetchTagsError": {
+			reason: "Errors fetching the tags of the database should be returned.",
+			client: &mockClient{MockFetchDatabase: found, MockFetchTags: func(_ context.Context, _, _ string, _ []string) (map[string]string, error) {
+				return nil, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: database(tagged, nil)},
+			want: want{err: errors.Wrap(errBoom, errGetTags)},
+		},
+		"TagsUpToDate": {
+			reason: "A database carrying the tag values of its spec should be up to date.",
+			client: &mockClient{MockFetchDatabase: found, MockFetchTags: func(_ context.Context, _, _ string, _ []string) (map[string]string, error) {
+				return map[string]string{"GOVERNANCE.PUBLIC.COST_CENTER": "data-platform"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: database(tagged, nil)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+		"TagRemovedFromSpec": {
+			reason: "A tag removed from the spec but still set should need an update.",
+			client: &mockClient{MockFetchDatabase: found, MockFetchTags: func(_ context.Context, _, _ string, tags []string) (map[string]string, error) {
+				if len(tags) != 2 {
+					return nil, errors.Errorf("want the tags of spec and status, got %v", tags)
+				}
+				return map[string]string{
+					"GOVERNANCE.PUBLIC.COST_CENTER": "data-platform",
+					"GOVERNANCE.PUBLIC.OWNER":       "analytics-team",
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: database(tagged, map[string]string{"GOVERNANCE.PUBLIC.OWNER": "analytics-team"})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
 	}
 
 	for name, tc := range cases {
@@ -73,3 +157,62 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.DatabaseClient
+		mg     resource.Managed
+		want   want
+	}{
+		"Protected": {
+			reason: "A database with deletion protection should not be dropped.",
+			client: &mockClient{MockDeleteDatabase: func(_ context.Context, _ *v1alpha1.DatabaseParameters) error {
+				return errors.New("database dropped")
+			}},
+			mg:   database(v1alpha1.DatabaseParameters{Name: "analytics", DeletionProtection: ptr.To(true)}, nil),
+			want: want{err: errors.New(errDeletionProtected)},
+		},
+		"Unprotected": {
+			reason: "A database without deletion protection should be dropped.",
+			client: &mockClient{MockDeleteDatabase: func(_ context.Context, _ *v1alpha1.DatabaseParameters) error {
+				return nil
+			}},
+			mg: database(v1alpha1.DatabaseParameters{Name: "analytics", DeletionProtection: ptr.To(false)}, nil),
+		},
+		"Cascade": {
+			reason: "The drop behavior of the database should be passed to the client.",
+			client: &mockClient{MockDeleteDatabase: func(_ context.Context, db *v1alpha1.DatabaseParameters) error {
+				if db.DropBehavior != "CASCADE" {
+					return errors.Errorf("database dropped with %q", db.DropBehavior)
+				}
+				return nil
+			}},
+			mg: database(v1alpha1.DatabaseParameters{Name: "analytics", DropBehavior: "CASCADE"}, nil),
+		},
+		"DeleteError": {
+			reason: "Errors dropping the database should be returned.",
+			client: &mockClient{MockDeleteDatabase: func(_ context.Context, _ *v1alpha1.DatabaseParameters) error {
+				return errBoom
+			}},
+			mg:   database(v1alpha1.DatabaseParameters{Name: "analytics"}, nil),
+			want: want{err: errors.Wrap(errBoom, errDeleteFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
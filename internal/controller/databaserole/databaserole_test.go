@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaserole
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/database/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.DatabaseRoleClient
+
+	MockFetchDatabaseRole func(ctx context.Context, p *v1alpha1.DatabaseRoleParameters) (v1alpha1.DatabaseRoleObservation, error)
+}
+
+func (m *mockClient) FetchDatabaseRole(ctx context.Context, p *v1alpha1.DatabaseRoleParameters) (v1alpha1.DatabaseRoleObservation, error) {
+	return m.MockFetchDatabaseRole(ctx, p)
+}
+
+func role(p v1alpha1.DatabaseRoleParameters) *v1alpha1.DatabaseRole {
+	return &v1alpha1.DatabaseRole{Spec: v1alpha1.DatabaseRoleSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.DatabaseRoleParameters{
+		Name:     "sales_reader",
+		Database: "sales",
+		Grants: []v1alpha1.DatabaseRoleGrant{
+			{Privileges: []string{"USAGE"}, ObjectType: "SCHEMA", ObjectName: "sales.public"},
+			{Privileges: []string{"SELECT", "REFERENCES"}, ObjectType: "VIEW", ObjectName: "sales.public.orders_v"},
+		},
+		GrantedToRoles: []string{"analyst"},
+	}
+
+	grants := []string{
+		"USAGE ON DATABASE SALES",
+		"USAGE ON SCHEMA SALES.PUBLIC",
+		"SELECT ON VIEW SALES.PUBLIC.ORDERS_V",
+		"REFERENCES ON VIEW SALES.PUBLIC.ORDERS_V",
+	}
+
+	found := func(obs v1alpha1.DatabaseRoleObservation) func(context.Context, *v1alpha1.DatabaseRoleParameters) (v1alpha1.DatabaseRoleObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.DatabaseRoleParameters) (v1alpha1.DatabaseRoleObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.DatabaseRoleClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A database role that does not exist should be reported as such.",
+			client: &mockClient{MockFetchDatabaseRole: func(_ context.Context, _ *v1alpha1.DatabaseRoleParameters) (v1alpha1.DatabaseRoleObservation, error) {
+				return v1alpha1.DatabaseRoleObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: role(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the database role should be returned.",
+			client: &mockClient{MockFetchDatabaseRole: func(_ context.Context, _ *v1alpha1.DatabaseRoleParameters) (v1alpha1.DatabaseRoleObservation, error) {
+				return v1alpha1.DatabaseRoleObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: role(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A database role holding the desired privileges and granted to the desired roles should be up to date, whether or not USAGE on its database is listed.",
+			client: &mockClient{MockFetchDatabaseRole: found(v1alpha1.DatabaseRoleObservation{
+				Grants:         grants,
+				GrantedToRoles: []string{"ANALYST"},
+			})},
+			args: args{ctx: context.Background(), mg: role(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"PrivilegeNotGranted": {
+			reason: "A database role missing a privilege should need an update.",
+			client: &mockClient{MockFetchDatabaseRole: found(v1alpha1.DatabaseRoleObservation{
+				Grants:         grants[:3],
+				GrantedToRoles: []string{"ANALYST"},
+			})},
+			args: args{ctx: context.Background(), mg: role(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"RoleNotGranted": {
+			reason: "A database role not granted to an account role yet should need an update.",
+			client: &mockClient{MockFetchDatabaseRole: found(v1alpha1.DatabaseRoleObservation{Grants: grants})},
+			args:   args{ctx: context.Background(), mg: role(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
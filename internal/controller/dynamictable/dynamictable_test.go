@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamictable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.DynamicTableClient
+
+	MockFetchDynamicTable func(ctx context.Context, p *v1alpha1.DynamicTableParameters) (v1alpha1.DynamicTableObservation, error)
+}
+
+func (m *mockClient) FetchDynamicTable(ctx context.Context, p *v1alpha1.DynamicTableParameters) (v1alpha1.DynamicTableObservation, error) {
+	return m.MockFetchDynamicTable(ctx, p)
+}
+
+func dynamicTable(p v1alpha1.DynamicTableParameters) *v1alpha1.DynamicTable {
+	return &v1alpha1.DynamicTable{Spec: v1alpha1.DynamicTableSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.DynamicTableParameters{
+		Name:      "daily_revenue",
+		Database:  "ANALYTICS",
+		Schema:    "PUBLIC",
+		Query:     "SELECT order_date, SUM(amount) AS revenue FROM orders GROUP BY order_date",
+		TargetLag: "60 minutes",
+		Warehouse: "transform_wh",
+		State:     v1alpha1.DynamicTableStateStarted,
+	}
+
+	text := "create dynamic table daily_revenue target_lag = '60 minutes' warehouse = transform_wh as\n" +
+		"SELECT order_date, SUM(amount) AS revenue\nFROM orders\nGROUP BY order_date;"
+
+	found := func(obs v1alpha1.DynamicTableObservation) func(context.Context, *v1alpha1.DynamicTableParameters) (v1alpha1.DynamicTableObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.DynamicTableParameters) (v1alpha1.DynamicTableObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.DynamicTableClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A dynamic table that does not exist should be reported as such.",
+			client: &mockClient{MockFetchDynamicTable: func(_ context.Context, _ *v1alpha1.DynamicTableParameters) (v1alpha1.DynamicTableObservation, error) {
+				return v1alpha1.DynamicTableObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: dynamicTable(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the dynamic table should be returned.",
+			client: &mockClient{MockFetchDynamicTable: func(_ context.Context, _ *v1alpha1.DynamicTableParameters) (v1alpha1.DynamicTableObservation, error) {
+				return v1alpha1.DynamicTableObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: dynamicTable(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "An active dynamic table with the desired query should be up to date, even if its lag is reported in another unit.",
+			client: &mockClient{MockFetchDynamicTable: found(v1alpha1.DynamicTableObservation{
+				SchedulingState: "ACTIVE",
+				TargetLag:       "1 hour",
+				Warehouse:       "TRANSFORM_WH",
+				Text:            text,
+			})},
+			args: args{ctx: context.Background(), mg: dynamicTable(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"QueryChanged": {
+			reason: "A dynamic table created with another query should need an update.",
+			client: &mockClient{MockFetchDynamicTable: found(v1alpha1.DynamicTableObservation{
+				SchedulingState: "ACTIVE",
+				TargetLag:       "1 hour",
+				Warehouse:       "TRANSFORM_WH",
+				Text:            "create dynamic table daily_revenue target_lag = '1 hour' warehouse = transform_wh as SELECT * FROM orders",
+			})},
+			args: args{ctx: context.Background(), mg: dynamicTable(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"Suspended": {
+			reason: "A suspended dynamic table that should be refreshed should need an update.",
+			client: &mockClient{MockFetchDynamicTable: found(v1alpha1.DynamicTableObservation{
+				SchedulingState: "SUSPENDED",
+				TargetLag:       "1 hour",
+				Warehouse:       "TRANSFORM_WH",
+				Text:            text,
+			})},
+			args: args{ctx: context.Background(), mg: dynamicTable(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalaccessintegration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ExternalAccessIntegrationClient
+
+	MockFetchExternalAccessIntegration func(ctx context.Context, p *v1alpha1.ExternalAccessIntegrationParameters) (v1alpha1.ExternalAccessIntegrationObservation, error)
+}
+
+func (m *mockClient) FetchExternalAccessIntegration(ctx context.Context, p *v1alpha1.ExternalAccessIntegrationParameters) (v1alpha1.ExternalAccessIntegrationObservation, error) {
+	return m.MockFetchExternalAccessIntegration(ctx, p)
+}
+
+func integration(p v1alpha1.ExternalAccessIntegrationParameters) *v1alpha1.ExternalAccessIntegration {
+	return &v1alpha1.ExternalAccessIntegration{Spec: v1alpha1.ExternalAccessIntegrationSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ExternalAccessIntegrationParameters{
+		Name:                "openai",
+		AllowedNetworkRules: []string{"analytics.public.openai_egress"},
+		Enabled:             ptr.To(true),
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ExternalAccessIntegrationClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "An external access integration that does not exist should be reported as such.",
+			client: &mockClient{MockFetchExternalAccessIntegration: func(_ context.Context, _ *v1alpha1.ExternalAccessIntegrationParameters) (v1alpha1.ExternalAccessIntegrationObservation, error) {
+				return v1alpha1.ExternalAccessIntegrationObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the external access integration should be returned.",
+			client: &mockClient{MockFetchExternalAccessIntegration: func(_ context.Context, _ *v1alpha1.ExternalAccessIntegrationParameters) (v1alpha1.ExternalAccessIntegrationObservation, error) {
+				return v1alpha1.ExternalAccessIntegrationObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "Network rules reported in another case and quoting should be up to date.",
+			client: &mockClient{MockFetchExternalAccessIntegration: func(_ context.Context, _ *v1alpha1.ExternalAccessIntegrationParameters) (v1alpha1.ExternalAccessIntegrationObservation, error) {
+				return v1alpha1.ExternalAccessIntegrationObservation{
+					Enabled:             true,
+					AllowedNetworkRules: []string{`"ANALYTICS"."PUBLIC"."OPENAI_EGRESS"`},
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"SecretRemoved": {
+			reason: "An integration allowing a secret that is no longer listed should need an update.",
+			client: &mockClient{MockFetchExternalAccessIntegration: func(_ context.Context, _ *v1alpha1.ExternalAccessIntegrationParameters) (v1alpha1.ExternalAccessIntegrationObservation, error) {
+				return v1alpha1.ExternalAccessIntegrationObservation{
+					Enabled:                      true,
+					AllowedNetworkRules:          []string{"ANALYTICS.PUBLIC.OPENAI_EGRESS"},
+					AllowedAuthenticationSecrets: []string{"ANALYTICS.PUBLIC.OPENAI_KEY"},
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
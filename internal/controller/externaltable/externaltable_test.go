@@ -0,0 +1,277 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaltable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/refresh"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	location = "s3://raw-events/events/"
+	channel  = "arn:aws:sqs:eu-west-1:123456789012:sf-snowpipe-AIDA-abc"
+)
+
+type mockClient struct {
+	snowflake.ExternalTableClient
+
+	MockFetchExternalTable   func(ctx context.Context, p *v1alpha1.ExternalTableParameters) (v1alpha1.ExternalTableObservation, error)
+	MockCreateExternalTable  func(ctx context.Context, p *v1alpha1.ExternalTableParameters) error
+	MockRefreshExternalTable func(ctx context.Context, p *v1alpha1.ExternalTableParameters) error
+}
+
+func (m *mockClient) FetchExternalTable(ctx context.Context, p *v1alpha1.ExternalTableParameters) (v1alpha1.ExternalTableObservation, error) {
+	return m.MockFetchExternalTable(ctx, p)
+}
+
+func (m *mockClient) CreateExternalTable(ctx context.Context, p *v1alpha1.ExternalTableParameters) error {
+	return m.MockCreateExternalTable(ctx, p)
+}
+
+func (m *mockClient) RefreshExternalTable(ctx context.Context, p *v1alpha1.ExternalTableParameters) error {
+	return m.MockRefreshExternalTable(ctx, p)
+}
+
+// recorder returns a client that records the operations it is called with,
+// failing the one named fail with err.
+func recorder(calls *[]string, fail string, err error) *mockClient {
+	record := func(op string) error {
+		*calls = append(*calls, op)
+		if op == fail {
+			return err
+		}
+		return nil
+	}
+	return &mockClient{
+		MockCreateExternalTable:  func(_ context.Context, _ *v1alpha1.ExternalTableParameters) error { return record("create") },
+		MockRefreshExternalTable: func(_ context.Context, _ *v1alpha1.ExternalTableParameters) error { return record("refresh") },
+	}
+}
+
+func withObservation(obs v1alpha1.ExternalTableObservation, err error) *mockClient {
+	return &mockClient{MockFetchExternalTable: func(_ context.Context, _ *v1alpha1.ExternalTableParameters) (v1alpha1.ExternalTableObservation, error) {
+		return obs, err
+	}}
+}
+
+type externalTableModifier func(*v1alpha1.ExternalTable)
+
+func withRefresh(v string) externalTableModifier {
+	return func(cr *v1alpha1.ExternalTable) {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRefresh: v})
+	}
+}
+
+func withLastRefresh(v string) externalTableModifier {
+	return func(cr *v1alpha1.ExternalTable) {
+		meta.AddAnnotations(cr, map[string]string{refresh.AnnotationKeyLastRefresh: v})
+	}
+}
+
+func externalTable(m ...externalTableModifier) *v1alpha1.ExternalTable {
+	cr := &v1alpha1.ExternalTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "events"},
+		Spec: v1alpha1.ExternalTableSpec{ForProvider: v1alpha1.ExternalTableParameters{
+			Name:       "events",
+			Stage:      "RAW.PUBLIC.EVENTS_STAGE",
+			FileFormat: v1alpha1.ExternalTableFileFormat{Type: ptr.To("JSON")},
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ExternalTableClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "An external table that does not exist should be reported as such.",
+			client: withObservation(v1alpha1.ExternalTableObservation{}, snowflake.ErrNotFound),
+			args:   args{ctx: context.Background(), mg: externalTable()},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"NotificationChannel": {
+			reason: "The notification channel of an auto-refresh external table should be published as a connection detail.",
+			client: withObservation(v1alpha1.ExternalTableObservation{Location: location, NotificationChannel: channel}, nil),
+			args:   args{ctx: context.Background(), mg: externalTable()},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{v1alpha1.ConnectionDetailNotificationChannel: []byte(channel)},
+			}},
+		},
+		"RefreshRequested": {
+			reason: "A new refresh annotation value should need an update.",
+			client: withObservation(v1alpha1.ExternalTableObservation{Location: location}, nil),
+			args:   args{ctx: context.Background(), mg: externalTable(withRefresh("2024-05-01T10:00:00Z"), withLastRefresh("2024-04-01T10:00:00Z"))},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+		"RefreshHandled": {
+			reason: "A refresh annotation value that was already handled should not need an update.",
+			client: withObservation(v1alpha1.ExternalTableObservation{Location: location}, nil),
+			args:   args{ctx: context.Background(), mg: externalTable(withRefresh("2024-05-01T10:00:00Z"), withLastRefresh("2024-05-01T10:00:00Z"))},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls       []string
+		lastRefresh string
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.ExternalTable
+		fail   string
+		want   want
+	}{
+		"Refresh": {
+			reason: "A requested refresh should be recorded as done without refreshing the new external table.",
+			mg:     externalTable(withRefresh("1")),
+			want:   want{calls: []string{"create"}, lastRefresh: "1"},
+		},
+		"CreateError": {
+			reason: "Errors creating the external table should be returned.",
+			mg:     externalTable(withRefresh("1")),
+			fail:   "create",
+			want:   want{calls: []string{"create"}, err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: recorder(&calls, tc.fail, errBoom)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want calls, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.lastRefresh, refresh.Last(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want last refresh, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls       []string
+		lastRefresh string
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.ExternalTable
+		fail   string
+		want   want
+	}{
+		"Refresh": {
+			reason: "An external table should be refreshed for a new value of the refresh annotation.",
+			mg:     externalTable(withRefresh("2"), withLastRefresh("1")),
+			want:   want{calls: []string{"refresh"}, lastRefresh: "2"},
+		},
+		"RefreshError": {
+			reason: "A failed refresh should be returned and retried.",
+			mg:     externalTable(withRefresh("2"), withLastRefresh("1")),
+			fail:   "refresh",
+			want: want{
+				calls:       []string{"refresh"},
+				lastRefresh: "1",
+				err:         errors.Wrap(errBoom, errRefreshFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: recorder(&calls, tc.fail, errBoom)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.lastRefresh, refresh.Last(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want last refresh, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
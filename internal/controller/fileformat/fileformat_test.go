@@ -0,0 +1,211 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fileformat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/fileformat/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.FileFormatClient
+
+	MockFetchFileFormat  func(ctx context.Context, p *v1alpha1.FileFormatParameters) (snowflake.FileFormatInfo, error)
+	MockUpdateFileFormat func(ctx context.Context, p *v1alpha1.FileFormatParameters, nonDefault []string) error
+}
+
+func (m *mockClient) FetchFileFormat(ctx context.Context, p *v1alpha1.FileFormatParameters) (snowflake.FileFormatInfo, error) {
+	return m.MockFetchFileFormat(ctx, p)
+}
+
+func (m *mockClient) UpdateFileFormat(ctx context.Context, p *v1alpha1.FileFormatParameters, nonDefault []string) error {
+	return m.MockUpdateFileFormat(ctx, p, nonDefault)
+}
+
+func withInfo(info snowflake.FileFormatInfo, err error) *mockClient {
+	return &mockClient{MockFetchFileFormat: func(_ context.Context, _ *v1alpha1.FileFormatParameters) (snowflake.FileFormatInfo, error) {
+		return info, err
+	}}
+}
+
+func csvFormat(o *v1alpha1.CSVOptions) *v1alpha1.FileFormat {
+	return &v1alpha1.FileFormat{Spec: v1alpha1.FileFormatSpec{ForProvider: v1alpha1.FileFormatParameters{
+		Name: "csv_format",
+		Type: "CSV",
+		CSV:  o,
+	}}}
+}
+
+func withNonDefault(ff *v1alpha1.FileFormat, names ...string) *v1alpha1.FileFormat {
+	ff.Status.AtProvider.NonDefaultOptions = names
+	return ff
+}
+
+// csvDefaults is format_options as reported by SHOW FILE FORMATS.
+func csvDefaults() map[string]interface{} {
+	return map[string]interface{}{
+		"TYPE":                         "CSV",
+		"RECORD_DELIMITER":             "\n",
+		"FIELD_DELIMITER":              ",",
+		"SKIP_HEADER":                  float64(0),
+		"COMPRESSION":                  "AUTO",
+		"FIELD_OPTIONALLY_ENCLOSED_BY": "NONE",
+		"NULL_IF":                      []interface{}{`\N`},
+		"TRIM_SPACE":                   false,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.FileFormatClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A file format that does not exist should be reported as such.",
+			client: withInfo(snowflake.FileFormatInfo{}, snowflake.ErrNotFound),
+			args:   args{ctx: context.Background(), mg: csvFormat(nil)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the file format should be returned.",
+			client: withInfo(snowflake.FileFormatInfo{}, errBoom),
+			args:   args{ctx: context.Background(), mg: csvFormat(nil)},
+			want:   want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"DefaultsIgnored": {
+			reason: "Options left unset should not be compared against Snowflake defaults.",
+			client: withInfo(snowflake.FileFormatInfo{Type: "CSV", Options: csvDefaults()}, nil),
+			args:   args{ctx: context.Background(), mg: csvFormat(&v1alpha1.CSVOptions{FieldDelimiter: ptr.To(",")})},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"KeywordsNormalized": {
+			reason: "Keyword values should match regardless of case.",
+			client: withInfo(snowflake.FileFormatInfo{Type: "CSV", Options: csvDefaults()}, nil),
+			args: args{ctx: context.Background(), mg: csvFormat(&v1alpha1.CSVOptions{
+				Compression:               ptr.To("auto"),
+				FieldOptionallyEnclosedBy: ptr.To("none"),
+				NullIf:                    []string{`\N`},
+				TrimSpace:                 ptr.To(false),
+			})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"OptionChanged": {
+			reason: "An option differing from Snowflake should need an update.",
+			client: withInfo(snowflake.FileFormatInfo{Type: "CSV", Options: csvDefaults()}, nil),
+			args:   args{ctx: context.Background(), mg: csvFormat(&v1alpha1.CSVOptions{FieldDelimiter: ptr.To("|")})},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"OptionRemoved": {
+			reason: "An option that differs from its default but is no longer set in the spec should need an update to be reset.",
+			client: withInfo(snowflake.FileFormatInfo{Type: "CSV", Options: func() map[string]interface{} {
+				o := csvDefaults()
+				o["FIELD_DELIMITER"] = "|"
+				return o
+			}()}, nil),
+			args: args{ctx: context.Background(), mg: csvFormat(nil)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		mg     *v1alpha1.FileFormat
+		want   error
+	}{
+		"OptionRemoved": {
+			reason: "The observed options that differ from their defaults should be passed on to be reset.",
+			mg: withNonDefault(
+				csvFormat(&v1alpha1.CSVOptions{SkipHeader: ptr.To(1), TrimSpace: ptr.To(true)}),
+				"FIELD_DELIMITER", "SKIP_HEADER",
+			),
+		},
+		"UpdateError": {
+			reason: "Errors updating the file format should be returned.",
+			err:    errBoom,
+			mg:     withNonDefault(csvFormat(nil), "FIELD_DELIMITER"),
+			want:   errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var passed []string
+			e := external{client: &mockClient{MockUpdateFileFormat: func(_ context.Context, _ *v1alpha1.FileFormatParameters, nonDefault []string) error {
+				passed = nonDefault
+				return tc.err
+			}}}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.mg.Status.AtProvider.NonDefaultOptions, passed); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want non-default options passed, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
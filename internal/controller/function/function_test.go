@@ -0,0 +1,348 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/function/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.FunctionClient
+
+	MockFetchFunction   func(ctx context.Context, identifier string) (v1alpha1.FunctionObservation, error)
+	MockCreateFunction  func(ctx context.Context, p *v1alpha1.FunctionParameters) error
+	MockReplaceFunction func(ctx context.Context, p *v1alpha1.FunctionParameters, previous string) error
+	MockDeleteFunction  func(ctx context.Context, identifier string) error
+}
+
+func (m *mockClient) FetchFunction(ctx context.Context, identifier string) (v1alpha1.FunctionObservation, error) {
+	return m.MockFetchFunction(ctx, identifier)
+}
+
+func (m *mockClient) CreateFunction(ctx context.Context, p *v1alpha1.FunctionParameters) error {
+	return m.MockCreateFunction(ctx, p)
+}
+
+func (m *mockClient) ReplaceFunction(ctx context.Context, p *v1alpha1.FunctionParameters, previous string) error {
+	return m.MockReplaceFunction(ctx, p, previous)
+}
+
+func (m *mockClient) DeleteFunction(ctx context.Context, identifier string) error {
+	return m.MockDeleteFunction(ctx, identifier)
+}
+
+func fn(p v1alpha1.FunctionParameters, externalName string) *v1alpha1.Function {
+	cr := &v1alpha1.Function{Spec: v1alpha1.FunctionSpec{ForProvider: p}}
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.FunctionParameters{
+		Name:       "normalize",
+		Database:   "util",
+		Schema:     "public",
+		Arguments:  []v1alpha1.FunctionArgument{{Name: "s", Type: "varchar"}},
+		ReturnType: "varchar",
+		Language:   "SQL",
+		Body:       ptr.To("lower(trim(s))"),
+	}
+	id := "util.public.normalize(varchar)"
+	previous := "util.public.normalize(varchar, number)"
+
+	observed := v1alpha1.FunctionObservation{
+		Signature:  "(S VARCHAR)",
+		ReturnType: "VARCHAR(16777216)",
+		Language:   "SQL",
+		Body:       "lower(trim(s))",
+	}
+
+	// found returns obs when the function with the given identifier is fetched
+	found := func(identifier string, obs v1alpha1.FunctionObservation) func(context.Context, string) (v1alpha1.FunctionObservation, error) {
+		return func(_ context.Context, id string) (v1alpha1.FunctionObservation, error) {
+			if id != identifier {
+				return v1alpha1.FunctionObservation{}, snowflake.ErrNotFound
+			}
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.FunctionClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A function that does not exist should be reported as such.",
+			client: &mockClient{MockFetchFunction: func(_ context.Context, _ string) (v1alpha1.FunctionObservation, error) {
+				return v1alpha1.FunctionObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: fn(params, "")},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the function should be returned.",
+			client: &mockClient{MockFetchFunction: func(_ context.Context, _ string) (v1alpha1.FunctionObservation, error) {
+				return v1alpha1.FunctionObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: fn(params, id)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"Adopted": {
+			reason: "An existing function with the signature of the spec should be adopted, persisting its external name.",
+			client: &mockClient{MockFetchFunction: found(id, observed)},
+			args:   args{ctx: context.Background(), mg: fn(params, "")},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"UpToDate": {
+			reason: "A function matching the spec should be up to date.",
+			client: &mockClient{MockFetchFunction: found(id, observed)},
+			args:   args{ctx: context.Background(), mg: fn(params, id)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"SignatureChanged": {
+			reason: "A function created with other argument types should be replaced.",
+			client: &mockClient{MockFetchFunction: found(previous, observed)},
+			args:   args{ctx: context.Background(), mg: fn(params, previous)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"BodyChanged": {
+			reason: "A function whose body differs from the spec should be replaced.",
+			client: &mockClient{MockFetchFunction: found(id, v1alpha1.FunctionObservation{
+				Signature:  "(S VARCHAR)",
+				ReturnType: "VARCHAR(16777216)",
+				Language:   "SQL",
+				Body:       "lower(s)",
+			})},
+			args: args{ctx: context.Background(), mg: fn(params, id)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.FunctionParameters{
+		Name:       "normalize",
+		Database:   "util",
+		Schema:     "public",
+		Arguments:  []v1alpha1.FunctionArgument{{Name: "s", Type: "varchar"}},
+		ReturnType: "varchar",
+		Body:       ptr.To("lower(trim(s))"),
+	}
+
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.FunctionClient
+		want   want
+	}{
+		"Created": {
+			reason: "The signature of a created function should be its external name.",
+			client: &mockClient{MockCreateFunction: func(_ context.Context, _ *v1alpha1.FunctionParameters) error { return nil }},
+			want:   want{externalName: "util.public.normalize(varchar)"},
+		},
+		"CreateError": {
+			reason: "Errors creating the function should be returned.",
+			client: &mockClient{MockCreateFunction: func(_ context.Context, _ *v1alpha1.FunctionParameters) error { return errBoom }},
+			want:   want{err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := fn(params, "")
+			e := external{client: tc.client}
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.FunctionParameters{
+		Name:       "normalize",
+		Database:   "util",
+		Schema:     "public",
+		Arguments:  []v1alpha1.FunctionArgument{{Name: "s", Type: "varchar"}},
+		ReturnType: "varchar",
+		Body:       ptr.To("lower(trim(s))"),
+	}
+	id := "util.public.normalize(varchar)"
+	previous := "util.public.normalize(varchar, number)"
+
+	// replaced expects the function to be replaced from the given identifier
+	replaced := func(identifier string) func(context.Context, *v1alpha1.FunctionParameters, string) error {
+		return func(_ context.Context, _ *v1alpha1.FunctionParameters, previous string) error {
+			if previous != identifier {
+				return errors.Errorf("replaced %q", previous)
+			}
+			return nil
+		}
+	}
+
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.FunctionClient
+		kube   *test.MockClient
+		mg     *v1alpha1.Function
+		want   want
+	}{
+		"Replaced": {
+			reason: "A function whose signature is unchanged should be replaced in place.",
+			client: &mockClient{MockReplaceFunction: replaced(id)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errors.New("external name updated"))},
+			mg:     fn(params, id),
+			want:   want{externalName: id},
+		},
+		"SignatureChanged": {
+			reason: "A function whose signature changed should be replaced from the previous signature, persisting the new one as its external name.",
+			client: &mockClient{MockReplaceFunction: replaced(previous)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     fn(params, previous),
+			want:   want{externalName: id},
+		},
+		"ReplaceError": {
+			reason: "Errors replacing the function should be returned.",
+			client: &mockClient{MockReplaceFunction: func(_ context.Context, _ *v1alpha1.FunctionParameters, _ string) error { return errBoom }},
+			mg:     fn(params, previous),
+			want:   want{externalName: previous, err: errors.Wrap(errBoom, errUpdateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want external name, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// dropped expects the function with the given identifier to be dropped
+	dropped := func(identifier string) func(context.Context, string) error {
+		return func(_ context.Context, id string) error {
+			if id != identifier {
+				return errors.Errorf("dropped %q", id)
+			}
+			return nil
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.FunctionClient
+		mg     *v1alpha1.Function
+		want   error
+	}{
+		"ExternalName": {
+			reason: "The function should be dropped by the signature it was created with.",
+			client: &mockClient{MockDeleteFunction: dropped("util.public.normalize(varchar, number)")},
+			mg:     fn(v1alpha1.FunctionParameters{Name: "normalize", Database: "util", Schema: "public"}, "util.public.normalize(varchar, number)"),
+		},
+		"DeleteError": {
+			reason: "Errors dropping the function should be returned.",
+			client: &mockClient{MockDeleteFunction: func(_ context.Context, _ string) error { return errBoom }},
+			mg:     fn(v1alpha1.FunctionParameters{Name: "normalize", Database: "util", Schema: "public"}, "util.public.normalize(varchar)"),
+			want:   errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
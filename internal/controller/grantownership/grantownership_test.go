@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grantownership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/grant/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.GrantOwnershipClient
+
+	MockFetchGrantOwnership  func(ctx context.Context, p *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error)
+	MockDeleteGrantOwnership func(ctx context.Context, p *v1alpha1.GrantOwnershipParameters) error
+}
+
+func (m *mockClient) FetchGrantOwnership(ctx context.Context, p *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error) {
+	return m.MockFetchGrantOwnership(ctx, p)
+}
+
+func (m *mockClient) DeleteGrantOwnership(ctx context.Context, p *v1alpha1.GrantOwnershipParameters) error {
+	return m.MockDeleteGrantOwnership(ctx, p)
+}
+
+func ownership(p v1alpha1.GrantOwnershipParameters, deleted bool) *v1alpha1.GrantOwnership {
+	cr := &v1alpha1.GrantOwnership{Spec: v1alpha1.GrantOwnershipSpec{ForProvider: p}}
+	if deleted {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.GrantOwnershipParameters{
+		ObjectType: "TABLE",
+		Objects:    ptr.To(v1alpha1.GrantOwnershipObjectsAll),
+		InSchema:   ptr.To("sales.public"),
+		ToRole:     ptr.To("sales_owner"),
+	}
+
+	reverting := params
+	reverting.RevertToRole = ptr.To("sysadmin")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.GrantOwnershipClient
+		args   args
+		want   want
+	}{
+		"NotOwned": {
+			reason: "Objects the role does not own should be reported as missing.",
+			client: &mockClient{MockFetchGrantOwnership: func(_ context.Context, _ *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error) {
+				return v1alpha1.GrantOwnershipObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: ownership(params, false)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the ownership should be returned.",
+			client: &mockClient{MockFetchGrantOwnership: func(_ context.Context, _ *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error) {
+				return v1alpha1.GrantOwnershipObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: ownership(params, false)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"Owned": {
+			reason: "Objects owned by the role should be up to date.",
+			client: &mockClient{MockFetchGrantOwnership: func(_ context.Context, _ *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error) {
+				return v1alpha1.GrantOwnershipObservation{Owner: "SALES_OWNER"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: ownership(params, false)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"DeletedKeepingOwnership": {
+			reason: "A deleted resource keeping ownership with the role should be reported as gone without looking it up.",
+			client: &mockClient{MockFetchGrantOwnership: func(_ context.Context, _ *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error) {
+				return v1alpha1.GrantOwnershipObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: ownership(params, true)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"DeletedReturningOwnership": {
+			reason: "A deleted resource returning ownership should exist until the role no longer owns the objects.",
+			client: &mockClient{MockFetchGrantOwnership: func(_ context.Context, _ *v1alpha1.GrantOwnershipParameters) (v1alpha1.GrantOwnershipObservation, error) {
+				return v1alpha1.GrantOwnershipObservation{Owner: "SALES_OWNER"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: ownership(reverting, true)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	reverted := v1alpha1.GrantOwnershipParameters{
+		ObjectType:   "TABLE",
+		ObjectName:   ptr.To("sales.public.orders"),
+		ToRole:       ptr.To("loader"),
+		RevertToRole: ptr.To("sysadmin"),
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.GrantOwnershipClient
+		mg     resource.Managed
+		want   error
+	}{
+		"Reverted": {
+			reason: "Ownership should be returned to the role to revert to.",
+			client: &mockClient{MockDeleteGrantOwnership: func(_ context.Context, p *v1alpha1.GrantOwnershipParameters) error {
+				if p.RevertToRole == nil || *p.RevertToRole != "sysadmin" {
+					return errors.New("ownership not reverted to sysadmin")
+				}
+				return nil
+			}},
+			mg: ownership(reverted, true),
+		},
+		"DeleteError": {
+			reason: "Errors returning ownership should be returned.",
+			client: &mockClient{MockDeleteGrantOwnership: func(_ context.Context, _ *v1alpha1.GrantOwnershipParameters) error {
+				return errBoom
+			}},
+			mg:   ownership(reverted, true),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package icebergtable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/table/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/refresh"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.IcebergTableClient
+
+	MockFetchIcebergTable func(ctx context.Context, p *v1alpha1.IcebergTableParameters) (v1alpha1.IcebergTableObservation, error)
+}
+
+func (m *mockClient) FetchIcebergTable(ctx context.Context, p *v1alpha1.IcebergTableParameters) (v1alpha1.IcebergTableObservation, error) {
+	return m.MockFetchIcebergTable(ctx, p)
+}
+
+func withObservation(obs v1alpha1.IcebergTableObservation, err error) *mockClient {
+	return &mockClient{MockFetchIcebergTable: func(_ context.Context, _ *v1alpha1.IcebergTableParameters) (v1alpha1.IcebergTableObservation, error) {
+		return obs, err
+	}}
+}
+
+type icebergTableModifier func(*v1alpha1.IcebergTable)
+
+func withRefresh(v string) icebergTableModifier {
+	return func(cr *v1alpha1.IcebergTable) {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRefresh: v})
+	}
+}
+
+func withLastRefresh(v string) icebergTableModifier {
+	return func(cr *v1alpha1.IcebergTable) {
+		meta.AddAnnotations(cr, map[string]string{refresh.AnnotationKeyLastRefresh: v})
+	}
+}
+
+func icebergTable(m ...icebergTableModifier) *v1alpha1.IcebergTable {
+	cr := &v1alpha1.IcebergTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders"},
+		Spec: v1alpha1.IcebergTableSpec{ForProvider: v1alpha1.IcebergTableParameters{
+			Name:               "orders",
+			ExternalVolume:     "LAKE_VOLUME",
+			CatalogIntegration: ptr.To("GLUE_CATALOG"),
+			CatalogTableName:   ptr.To("orders"),
+			AutoRefresh:        ptr.To(true),
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.IcebergTableClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "An Iceberg table that does not exist should be reported as such.",
+			client: withObservation(v1alpha1.IcebergTableObservation{}, snowflake.ErrNotFound),
+			args:   args{ctx: context.Background(), mg: icebergTable()},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"Exists": {
+			reason: "An existing Iceberg table should be up to date whatever the state of its refresh.",
+			client: withObservation(v1alpha1.IcebergTableObservation{Catalog: "GLUE_CATALOG", TableType: "UNMANAGED", RefreshState: "STALLED"}, nil),
+			args:   args{ctx: context.Background(), mg: icebergTable()},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"RefreshRequested": {
+			reason: "A new refresh annotation value should need an update.",
+			client: withObservation(v1alpha1.IcebergTableObservation{Catalog: "GLUE_CATALOG"}, nil),
+			args:   args{ctx: context.Background(), mg: icebergTable(withRefresh("2024-05-01T10:00:00Z"), withLastRefresh("2024-04-01T10:00:00Z"))},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"RefreshHandled": {
+			reason: "A refresh annotation value that was already handled should not need an update.",
+			client: withObservation(v1alpha1.IcebergTableObservation{Catalog: "GLUE_CATALOG"}, nil),
+			args:   args{ctx: context.Background(), mg: icebergTable(withRefresh("2024-05-01T10:00:00Z"), withLastRefresh("2024-05-01T10:00:00Z"))},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+type mockClient struct {
+	snowflake.NetworkPolicyClient
+
+	MockFetchNetworkPolicy func(ctx context.Context, p *v1alpha1.NetworkPolicyParameters) (v1alpha1.NetworkPolicyObservation, error)
+}
+
+func (m *mockClient) FetchNetworkPolicy(ctx context.Context, p *v1alpha1.NetworkPolicyParameters) (v1alpha1.NetworkPolicyObservation, error) {
+	return m.MockFetchNetworkPolicy(ctx, p)
+}
+
+func policy(p v1alpha1.NetworkPolicyParameters) *v1alpha1.NetworkPolicy {
+	return &v1alpha1.NetworkPolicy{Spec: v1alpha1.NetworkPolicySpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.NetworkPolicyParameters{
+		Name:                   "corporate",
+		AllowedIPList:          []string{"192.168.1.0/24"},
+		AllowedNetworkRuleList: []string{"security.public.vpce"},
+		Comment:                ptr.To("corporate network"),
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.NetworkPolicyClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A network policy that does not exist should be reported as such.",
+			client: &mockClient{MockFetchNetworkPolicy: func(_ context.Context, _ *v1alpha1.NetworkPolicyParameters) (v1alpha1.NetworkPolicyObservation, error) {
+				return v1alpha1.NetworkPolicyObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the network policy should be returned.",
+			client: &mockClient{MockFetchNetworkPolicy: func(_ context.Context, _ *v1alpha1.NetworkPolicyParameters) (v1alpha1.NetworkPolicyObservation, error) {
+				return v1alpha1.NetworkPolicyObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A network policy referencing the same rules by quoted names should be up to date.",
+			client: &mockClient{MockFetchNetworkPolicy: func(_ context.Context, _ *v1alpha1.NetworkPolicyParameters) (v1alpha1.NetworkPolicyObservation, error) {
+				return v1alpha1.NetworkPolicyObservation{
+					AllowedIPList:          []string{"192.168.1.0/24"},
+					AllowedNetworkRuleList: []string{`"SECURITY"."PUBLIC"."VPCE"`},
+					Comment:                "corporate network",
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"BlockedIPAdded": {
+			reason: "A network policy blocking addresses not in the spec should need an update.",
+			client: &mockClient{MockFetchNetworkPolicy: func(_ context.Context, _ *v1alpha1.NetworkPolicyParameters) (v1alpha1.NetworkPolicyObservation, error) {
+				return v1alpha1.NetworkPolicyObservation{
+					AllowedIPList:          []string{"192.168.1.0/24"},
+					BlockedIPList:          []string{"192.168.1.99"},
+					AllowedNetworkRuleList: []string{"SECURITY.PUBLIC.VPCE"},
+					Comment:                "corporate network",
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
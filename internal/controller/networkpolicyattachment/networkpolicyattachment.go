@@ -0,0 +1,254 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicyattachment
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotNetworkPolicyAttachment = "managed resource is not a NetworkPolicyAttachment custom resource"
+	errTrackPCUsage               = "cannot track ProviderConfig usage"
+	errGetPC                      = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errAttachFailed = "cannot set network policy"
+	errDetachFailed = "cannot unset network policy"
+	errGetFailed    = "cannot retrieve network policy attachment"
+
+	errUpdateAnnotations = "cannot record whether the account policy was set"
+)
+
+// Setup adds a controller that reconciles NetworkPolicyAttachment managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.NetworkPolicyAttachmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NetworkPolicyAttachmentGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.NetworkPolicyAttachment{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPolicyAttachment)
+	if !ok {
+		return nil, errors.New(errNotNetworkPolicyAttachment)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.NetworkPolicyAttachmentClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPolicyAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNetworkPolicyAttachment)
+	}
+
+	p := &cr.Spec.ForProvider
+
+	// users removed from the spec are only known from the last observation
+	users := append(append([]string{}, p.Users...), cr.Status.AtProvider.Users...)
+
+	obs, err := e.client.FetchNetworkPolicyAttachment(ctx, p, uniqueNames(users))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// the account policy is left alone unless this attachment asks for it or
+	// set it earlier
+	obs.AccountSetByAttachment = accountSetByAttachment(cr)
+	account := obs.Account && (setForAccount(p) || obs.AccountSetByAttachment)
+	if !account && len(obs.Users) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: account == setForAccount(p) && snowflake.SameNames(p.Users, obs.Users),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPolicyAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNetworkPolicyAttachment)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := &cr.Spec.ForProvider
+	if err := e.client.SetNetworkPolicy(ctx, p.NetworkPolicy, setForAccount(p), p.Users); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAttachFailed)
+	}
+	// the status set here does not survive the update of the critical
+	// annotations that follows a creation, but the annotation does
+	if setForAccount(p) {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyAccountPolicySet: "true"})
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPolicyAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNetworkPolicyAttachment)
+	}
+
+	p := &cr.Spec.ForProvider
+	obs := &cr.Status.AtProvider
+	added, removed := snowflake.NameDiff(p.Users, obs.Users)
+
+	setAccount := setForAccount(p) && !obs.Account
+	if err := e.client.SetNetworkPolicy(ctx, p.NetworkPolicy, setAccount, added); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAttachFailed)
+	}
+
+	// the account policy is only unset if this attachment set it, never as a
+	// side effect of a change of its users
+	unsetAccount := !setForAccount(p) && obs.Account && accountSetByAttachment(cr)
+	if err := e.client.UnsetNetworkPolicy(ctx, unsetAccount, removed); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDetachFailed)
+	}
+
+	switch {
+	case setAccount:
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyAccountPolicySet: "true"})
+	case unsetAccount:
+		meta.RemoveAnnotations(cr, v1alpha1.AnnotationKeyAccountPolicySet)
+	default:
+		return managed.ExternalUpdate{}, nil
+	}
+	obs.AccountSetByAttachment = setAccount
+
+	// the managed reconciler only persists the status after an update
+	err := managed.NewRetryingCriticalAnnotationUpdater(e.kube).UpdateCriticalAnnotations(ctx, cr)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAnnotations)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NetworkPolicyAttachment)
+	if !ok {
+		return errors.New(errNotNetworkPolicyAttachment)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	obs := cr.Status.AtProvider
+	return errors.Wrap(e.client.UnsetNetworkPolicy(ctx, obs.Account && accountSetByAttachment(cr), obs.Users), errDetachFailed)
+}
+
+// accountSetByAttachment reports whether the attachment set the network policy
+// of the account.
+func accountSetByAttachment(cr *v1alpha1.NetworkPolicyAttachment) bool {
+	return cr.GetAnnotations()[v1alpha1.AnnotationKeyAccountPolicySet] == "true"
+}
+
+func setForAccount(p *v1alpha1.NetworkPolicyAttachmentParameters) bool {
+	return p.SetForAccount != nil && *p.SetForAccount
+}
+
+// uniqueNames drops case-insensitive duplicates from names.
+func uniqueNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	unique := make([]string, 0, len(names))
+	for _, n := range names {
+		k := strings.ToUpper(n)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		unique = append(unique, n)
+	}
+	return unique
+}
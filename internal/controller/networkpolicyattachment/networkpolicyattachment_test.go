@@ -0,0 +1,331 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicyattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+type mockClient struct {
+	snowflake.NetworkPolicyAttachmentClient
+
+	MockFetchNetworkPolicyAttachment func(ctx context.Context, p *v1alpha1.NetworkPolicyAttachmentParameters, users []string) (v1alpha1.NetworkPolicyAttachmentObservation, error)
+	MockSetNetworkPolicy             func(ctx context.Context, policy string, account bool, users []string) error
+	MockUnsetNetworkPolicy           func(ctx context.Context, account bool, users []string) error
+}
+
+func (m *mockClient) FetchNetworkPolicyAttachment(ctx context.Context, p *v1alpha1.NetworkPolicyAttachmentParameters, users []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+	return m.MockFetchNetworkPolicyAttachment(ctx, p, users)
+}
+
+func (m *mockClient) SetNetworkPolicy(ctx context.Context, policy string, account bool, users []string) error {
+	return m.MockSetNetworkPolicy(ctx, policy, account, users)
+}
+
+func (m *mockClient) UnsetNetworkPolicy(ctx context.Context, account bool, users []string) error {
+	return m.MockUnsetNetworkPolicy(ctx, account, users)
+}
+
+func attachment(p v1alpha1.NetworkPolicyAttachmentParameters, observed ...string) *v1alpha1.NetworkPolicyAttachment {
+	cr := &v1alpha1.NetworkPolicyAttachment{Spec: v1alpha1.NetworkPolicyAttachmentSpec{ForProvider: p}}
+	cr.Status.AtProvider.Users = observed
+	return cr
+}
+
+func withAccountSet(cr *v1alpha1.NetworkPolicyAttachment) *v1alpha1.NetworkPolicyAttachment {
+	cr.Status.AtProvider.Account = true
+	meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyAccountPolicySet: "true"})
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.NetworkPolicyAttachmentParameters{
+		NetworkPolicy: "CORPORATE",
+		Users:         []string{"ALICE"},
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.NetworkPolicyAttachmentClient
+		args   args
+		want   want
+	}{
+		"NotAttached": {
+			reason: "A policy that is not set for anyone should not exist.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{}, nil
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the attachment should be returned.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UnmanagedAccountPolicy": {
+			reason: "An account policy should not count as attached unless setForAccount is set.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{Account: true}, nil
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"UpToDate": {
+			reason: "A policy set for exactly the desired users should be up to date.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{Users: []string{"ALICE"}}, nil
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"UserRemoved": {
+			reason: "A policy still set for a user removed from the spec should need an update.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, users []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{Users: users}, nil
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params, "ALICE", "BOB")},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"AccountMissing": {
+			reason: "A policy that should be set for the account but is not should need an update.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{Users: []string{"ALICE"}}, nil
+			}},
+			args: args{ctx: context.Background(), mg: attachment(v1alpha1.NetworkPolicyAttachmentParameters{
+				NetworkPolicy: "CORPORATE",
+				SetForAccount: ptr.To(true),
+				Users:         []string{"ALICE"},
+			})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"AccountCleared": {
+			reason: "An account policy set by the attachment should need an update once setForAccount is cleared.",
+			client: &mockClient{MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+				return v1alpha1.NetworkPolicyAttachmentObservation{Account: true}, nil
+			}},
+			args: args{ctx: context.Background(), mg: withAccountSet(attachment(v1alpha1.NetworkPolicyAttachmentParameters{NetworkPolicy: "CORPORATE"}))},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type call struct {
+		account bool
+		users   []string
+	}
+
+	type want struct {
+		set, unset call
+		setByUs    bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.NetworkPolicyAttachment
+		want   want
+	}{
+		"UsersChanged": {
+			reason: "Changing the users of a users-only attachment should leave the account policy alone.",
+			mg: func() *v1alpha1.NetworkPolicyAttachment {
+				cr := attachment(v1alpha1.NetworkPolicyAttachmentParameters{NetworkPolicy: "CORPORATE", Users: []string{"ALICE"}}, "BOB")
+				cr.Status.AtProvider.Account = true
+				return cr
+			}(),
+			want: want{set: call{users: []string{"ALICE"}}, unset: call{users: []string{"BOB"}}},
+		},
+		"AccountSet": {
+			reason: "Setting setForAccount should set the account policy and record that the attachment set it.",
+			mg: attachment(v1alpha1.NetworkPolicyAttachmentParameters{
+				NetworkPolicy: "CORPORATE",
+				SetForAccount: ptr.To(true),
+				Users:         []string{"ALICE"},
+			}, "ALICE"),
+			want: want{set: call{account: true}, setByUs: true},
+		},
+		"AccountCleared": {
+			reason: "Clearing setForAccount should unset an account policy the attachment set.",
+			mg: withAccountSet(attachment(v1alpha1.NetworkPolicyAttachmentParameters{
+				NetworkPolicy: "CORPORATE",
+				Users:         []string{"ALICE"},
+			}, "ALICE")),
+			want: want{unset: call{account: true}},
+		},
+		"AccountSetElsewhere": {
+			reason: "Clearing setForAccount should not unset an account policy the attachment did not set.",
+			mg: func() *v1alpha1.NetworkPolicyAttachment {
+				cr := attachment(v1alpha1.NetworkPolicyAttachmentParameters{NetworkPolicy: "CORPORATE", Users: []string{"ALICE"}}, "ALICE")
+				cr.Status.AtProvider.Account = true
+				return cr
+			}(),
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set, unset call
+			e := external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: &mockClient{
+				MockSetNetworkPolicy: func(_ context.Context, _ string, account bool, users []string) error {
+					set = call{account: account, users: users}
+					return nil
+				},
+				MockUnsetNetworkPolicy: func(_ context.Context, account bool, users []string) error {
+					unset = call{account: account, users: users}
+					return nil
+				},
+			}}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.set, set, cmp.AllowUnexported(call{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want set, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.unset, unset, cmp.AllowUnexported(call{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want unset, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.setByUs, accountSetByAttachment(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want accountSetByAttachment, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.NetworkPolicyAttachment
+		account bool
+	}{
+		"SetByAttachment": {
+			reason:  "Deleting the attachment should unset the account policy it set.",
+			mg:      withAccountSet(attachment(v1alpha1.NetworkPolicyAttachmentParameters{NetworkPolicy: "CORPORATE", SetForAccount: ptr.To(true)})),
+			account: true,
+		},
+		"SetElsewhere": {
+			reason: "Deleting the attachment should leave an account policy it did not set alone.",
+			mg: func() *v1alpha1.NetworkPolicyAttachment {
+				cr := attachment(v1alpha1.NetworkPolicyAttachmentParameters{NetworkPolicy: "CORPORATE", SetForAccount: ptr.To(true)})
+				cr.Status.AtProvider.Account = true
+				return cr
+			}(),
+			account: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var account bool
+			e := external{client: &mockClient{MockUnsetNetworkPolicy: func(_ context.Context, a bool, _ []string) error {
+				account = a
+				return nil
+			}}}
+			if err := e.Delete(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Delete(...): %v\n", tc.reason, err)
+			}
+			if account != tc.account {
+				t.Errorf("\n%s\ne.Delete(...): unset account policy %t, want %t\n", tc.reason, account, tc.account)
+			}
+		})
+	}
+}
+
+func TestCreateObserveDelete(t *testing.T) {
+	var account bool
+	e := external{client: &mockClient{
+		MockSetNetworkPolicy: func(_ context.Context, _ string, a bool, _ []string) error {
+			account = account || a
+			return nil
+		},
+		MockFetchNetworkPolicyAttachment: func(_ context.Context, _ *v1alpha1.NetworkPolicyAttachmentParameters, _ []string) (v1alpha1.NetworkPolicyAttachmentObservation, error) {
+			return v1alpha1.NetworkPolicyAttachmentObservation{Account: account}, nil
+		},
+		MockUnsetNetworkPolicy: func(_ context.Context, a bool, _ []string) error {
+			account = account && !a
+			return nil
+		},
+	}}
+
+	cr := attachment(v1alpha1.NetworkPolicyAttachmentParameters{NetworkPolicy: "CORPORATE", SetForAccount: ptr.To(true)})
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+
+	// the managed reconciler overwrites the status set by Create when it
+	// updates the critical annotations
+	cr.Status = v1alpha1.NetworkPolicyAttachmentStatus{}
+
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	if account {
+		t.Errorf("e.Delete(...): account policy set by the attachment is still set")
+	}
+}
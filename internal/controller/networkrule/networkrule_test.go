@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/network/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+type mockClient struct {
+	snowflake.NetworkRuleClient
+
+	MockFetchNetworkRule func(ctx context.Context, p *v1alpha1.NetworkRuleParameters) (v1alpha1.NetworkRuleObservation, error)
+}
+
+func (m *mockClient) FetchNetworkRule(ctx context.Context, p *v1alpha1.NetworkRuleParameters) (v1alpha1.NetworkRuleObservation, error) {
+	return m.MockFetchNetworkRule(ctx, p)
+}
+
+func rule(p v1alpha1.NetworkRuleParameters) *v1alpha1.NetworkRule {
+	return &v1alpha1.NetworkRule{Spec: v1alpha1.NetworkRuleSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.NetworkRuleParameters{
+		Name:      "office",
+		Type:      "IPV4",
+		ValueList: []string{"192.168.1.0/24", "10.0.0.1"},
+		Comment:   ptr.To("office ranges"),
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.NetworkRuleClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A network rule that does not exist should be reported as such.",
+			client: &mockClient{MockFetchNetworkRule: func(_ context.Context, _ *v1alpha1.NetworkRuleParameters) (v1alpha1.NetworkRuleObservation, error) {
+				return v1alpha1.NetworkRuleObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: rule(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the network rule should be returned.",
+			client: &mockClient{MockFetchNetworkRule: func(_ context.Context, _ *v1alpha1.NetworkRuleParameters) (v1alpha1.NetworkRuleObservation, error) {
+				return v1alpha1.NetworkRuleObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: rule(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A network rule with the same values in any order should be up to date.",
+			client: &mockClient{MockFetchNetworkRule: func(_ context.Context, _ *v1alpha1.NetworkRuleParameters) (v1alpha1.NetworkRuleObservation, error) {
+				return v1alpha1.NetworkRuleObservation{ValueList: []string{"10.0.0.1", "192.168.1.0/24"}, Comment: "office ranges"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: rule(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"ValueRemoved": {
+			reason: "A network rule missing one of its values should need an update.",
+			client: &mockClient{MockFetchNetworkRule: func(_ context.Context, _ *v1alpha1.NetworkRuleParameters) (v1alpha1.NetworkRuleObservation, error) {
+				return v1alpha1.NetworkRuleObservation{ValueList: []string{"10.0.0.1"}, Comment: "office ranges"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: rule(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
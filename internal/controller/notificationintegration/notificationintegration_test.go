@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationintegration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.NotificationIntegrationClient
+
+	MockFetchNotificationIntegration func(ctx context.Context, p *v1alpha1.NotificationIntegrationParameters) (v1alpha1.NotificationIntegrationObservation, error)
+}
+
+func (m *mockClient) FetchNotificationIntegration(ctx context.Context, p *v1alpha1.NotificationIntegrationParameters) (v1alpha1.NotificationIntegrationObservation, error) {
+	return m.MockFetchNotificationIntegration(ctx, p)
+}
+
+func integration(p v1alpha1.NotificationIntegrationParameters) *v1alpha1.NotificationIntegration {
+	return &v1alpha1.NotificationIntegration{Spec: v1alpha1.NotificationIntegrationSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.NotificationIntegrationParameters{
+		Name:                 "task_errors",
+		Type:                 "QUEUE",
+		Direction:            ptr.To("OUTBOUND"),
+		NotificationProvider: ptr.To("AWS_SNS"),
+		AWSSNSTopicARN:       ptr.To("arn:aws:sns:us-west-2:123456789012:task-errors"),
+		AWSSNSRoleARN:        ptr.To("arn:aws:iam::123456789012:role/snowflake-sns"),
+		Enabled:              ptr.To(true),
+	}
+
+	observed := v1alpha1.NotificationIntegrationObservation{
+		Enabled:              true,
+		Type:                 "QUEUE - AWS_SNS",
+		Direction:            "OUTBOUND",
+		NotificationProvider: "AWS_SNS",
+		SFAWSIAMUserARN:      "arn:aws:iam::999999999999:user/abc",
+		SFAWSExternalID:      "ACCOUNT_SFCRole=2_abc",
+		Properties: map[string]string{
+			"AWS_SNS_TOPIC_ARN": "arn:aws:sns:us-west-2:123456789012:task-errors",
+			"AWS_SNS_ROLE_ARN":  "arn:aws:iam::123456789012:role/snowflake-sns",
+		},
+	}
+
+	identities := managed.ConnectionDetails{
+		v1alpha1.ConnectionDetailSFAWSIAMUserARN: []byte("arn:aws:iam::999999999999:user/abc"),
+		v1alpha1.ConnectionDetailSFAWSExternalID: []byte("ACCOUNT_SFCRole=2_abc"),
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.NotificationIntegrationClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A notification integration that does not exist should be reported as such.",
+			client: &mockClient{MockFetchNotificationIntegration: func(_ context.Context, _ *v1alpha1.NotificationIntegrationParameters) (v1alpha1.NotificationIntegrationObservation, error) {
+				return v1alpha1.NotificationIntegrationObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the notification integration should be returned.",
+			client: &mockClient{MockFetchNotificationIntegration: func(_ context.Context, _ *v1alpha1.NotificationIntegrationParameters) (v1alpha1.NotificationIntegrationObservation, error) {
+				return v1alpha1.NotificationIntegrationObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "An up to date SNS integration should publish the IAM user ARN and external ID.",
+			client: &mockClient{MockFetchNotificationIntegration: func(_ context.Context, _ *v1alpha1.NotificationIntegrationParameters) (v1alpha1.NotificationIntegrationObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: identities}},
+		},
+		"TopicChanged": {
+			reason: "An integration publishing to another topic should need an update.",
+			client: &mockClient{MockFetchNotificationIntegration: func(_ context.Context, _ *v1alpha1.NotificationIntegrationParameters) (v1alpha1.NotificationIntegrationObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(func() v1alpha1.NotificationIntegrationParameters {
+				p := params
+				p.AWSSNSTopicARN = ptr.To("arn:aws:sns:us-west-2:123456789012:alerts")
+				return p
+			}())},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: identities}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectparameter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/parameter/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ObjectParameterClient
+
+	MockFetchObjectParameter func(ctx context.Context, p *v1alpha1.ObjectParameterParameters) (v1alpha1.ObjectParameterObservation, error)
+}
+
+func (m *mockClient) FetchObjectParameter(ctx context.Context, p *v1alpha1.ObjectParameterParameters) (v1alpha1.ObjectParameterObservation, error) {
+	return m.MockFetchObjectParameter(ctx, p)
+}
+
+func objectParameter(p v1alpha1.ObjectParameterParameters) *v1alpha1.ObjectParameter {
+	return &v1alpha1.ObjectParameter{Spec: v1alpha1.ObjectParameterSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ObjectParameterParameters{
+		ObjectType: "USER",
+		ObjectName: "loader",
+		Key:        "ABORT_DETACHED_QUERY",
+		Value:      "true",
+	}
+
+	found := func(value string) func(context.Context, *v1alpha1.ObjectParameterParameters) (v1alpha1.ObjectParameterObservation, error) {
+		return func(_ context.Context, p *v1alpha1.ObjectParameterParameters) (v1alpha1.ObjectParameterObservation, error) {
+			return v1alpha1.ObjectParameterObservation{Value: value, Level: p.ObjectType}, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ObjectParameterClient
+		args   args
+		want   want
+	}{
+		"NotSet": {
+			reason: "A parameter not set at the managed level should be reported as not existing.",
+			client: &mockClient{MockFetchObjectParameter: func(_ context.Context, _ *v1alpha1.ObjectParameterParameters) (v1alpha1.ObjectParameterObservation, error) {
+				return v1alpha1.ObjectParameterObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: objectParameter(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the parameter should be returned.",
+			client: &mockClient{MockFetchObjectParameter: func(_ context.Context, _ *v1alpha1.ObjectParameterParameters) (v1alpha1.ObjectParameterObservation, error) {
+				return v1alpha1.ObjectParameterObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: objectParameter(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A parameter set to the desired value should be up to date.",
+			client: &mockClient{MockFetchObjectParameter: found("TRUE")},
+			args:   args{ctx: context.Background(), mg: objectParameter(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Drifted": {
+			reason: "A parameter set to another value at the managed level should need an update.",
+			client: &mockClient{MockFetchObjectParameter: found("FALSE")},
+			args:   args{ctx: context.Background(), mg: objectParameter(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/pipe/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+	"github.com/allenkallz/provider-snowflake/internal/refresh"
+)
+
+const (
+	errNotPipe      = "managed resource is not a Pipe custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed  = "cannot create pipe"
+	errUpdateFailed  = "cannot update pipe"
+	errReplaceFailed = "cannot replace pipe"
+	errRecordRefresh = "cannot record the handled refresh"
+	errRefreshFailed = "cannot refresh pipe"
+	errDeleteFailed  = "cannot delete pipe"
+	errGetFailed     = "cannot retrieve pipe"
+)
+
+// Setup adds a controller that reconciles Pipe managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PipeGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PipeGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Pipe{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Pipe)
+	if !ok {
+		return nil, errors.New(errNotPipe)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.PipeClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Pipe)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPipe)
+	}
+
+	obs, err := e.client.FetchPipe(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// the last handled refresh is only known to us, not to Snowflake
+	obs.LastRefresh = refresh.Last(cr)
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	cd := managed.ConnectionDetails{}
+	if obs.NotificationChannel != "" {
+		cd[v1alpha1.ConnectionDetailNotificationChannel] = []byte(obs.NotificationChannel)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(cr.Spec.ForProvider, obs) && !refresh.Requested(cr, v1alpha1.AnnotationKeyRefresh),
+		ConnectionDetails: cd,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Pipe)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPipe)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	if err := e.client.CreatePipe(ctx, &cr.Spec.ForProvider, false); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	// a new pipe picks up the staged files anyway, so there is nothing left
+	// to refresh
+	refresh.Handled(cr, v1alpha1.AnnotationKeyRefresh)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Pipe)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPipe)
+	}
+
+	p := &cr.Spec.ForProvider
+	if snowflake.NormalizeSQL(p.CopyStatement) != snowflake.NormalizeSQL(cr.Status.AtProvider.Definition) {
+		if err := e.client.CreatePipe(ctx, p, true); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errReplaceFailed)
+		}
+	} else if err := e.client.UpdatePipe(ctx, p); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	if !refresh.Requested(cr, v1alpha1.AnnotationKeyRefresh) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if err := e.client.RefreshPipe(ctx, p); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRefreshFailed)
+	}
+	err := refresh.Record(ctx, e.kube, cr, v1alpha1.AnnotationKeyRefresh)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errRecordRefresh)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Pipe)
+	if !ok {
+		return errors.New(errNotPipe)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return errors.Wrap(e.client.DeletePipe(ctx, &cr.Spec.ForProvider), errDeleteFailed)
+}
+
+func isUpToDate(p v1alpha1.PipeParameters, obs v1alpha1.PipeObservation) bool {
+	if snowflake.NormalizeSQL(p.CopyStatement) != snowflake.NormalizeSQL(obs.Definition) {
+		return false
+	}
+	if p.ErrorIntegration != nil && !strings.EqualFold(*p.ErrorIntegration, obs.ErrorIntegration) {
+		return false
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		return false
+	}
+	return true
+}
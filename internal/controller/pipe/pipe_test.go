@@ -0,0 +1,306 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/pipe/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/refresh"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	copyStatement = "COPY INTO raw.events FROM @raw.events_stage FILE_FORMAT = (TYPE = JSON)"
+	channel       = "arn:aws:sqs:eu-west-1:123456789012:sf-snowpipe-AIDA-abc"
+)
+
+type mockClient struct {
+	snowflake.PipeClient
+
+	MockFetchPipe   func(ctx context.Context, p *v1alpha1.PipeParameters) (v1alpha1.PipeObservation, error)
+	MockCreatePipe  func(ctx context.Context, p *v1alpha1.PipeParameters, replace bool) error
+	MockUpdatePipe  func(ctx context.Context, p *v1alpha1.PipeParameters) error
+	MockRefreshPipe func(ctx context.Context, p *v1alpha1.PipeParameters) error
+}
+
+func (m *mockClient) FetchPipe(ctx context.Context, p *v1alpha1.PipeParameters) (v1alpha1.PipeObservation, error) {
+	return m.MockFetchPipe(ctx, p)
+}
+
+func (m *mockClient) CreatePipe(ctx context.Context, p *v1alpha1.PipeParameters, replace bool) error {
+	return m.MockCreatePipe(ctx, p, replace)
+}
+
+func (m *mockClient) UpdatePipe(ctx context.Context, p *v1alpha1.PipeParameters) error {
+	return m.MockUpdatePipe(ctx, p)
+}
+
+func (m *mockClient) RefreshPipe(ctx context.Context, p *v1alpha1.PipeParameters) error {
+	return m.MockRefreshPipe(ctx, p)
+}
+
+// recorder returns a client that records the operations it is called with,
+// failing the one named fail with err.
+func recorder(calls *[]string, fail string, err error) *mockClient {
+	record := func(op string) error {
+		*calls = append(*calls, op)
+		if op == fail {
+			return err
+		}
+		return nil
+	}
+	return &mockClient{
+		MockCreatePipe: func(_ context.Context, _ *v1alpha1.PipeParameters, replace bool) error {
+			if replace {
+				return record("replace")
+			}
+			return record("create")
+		},
+		MockUpdatePipe:  func(_ context.Context, _ *v1alpha1.PipeParameters) error { return record("update") },
+		MockRefreshPipe: func(_ context.Context, _ *v1alpha1.PipeParameters) error { return record("refresh") },
+	}
+}
+
+func withObservation(obs v1alpha1.PipeObservation, err error) *mockClient {
+	return &mockClient{MockFetchPipe: func(_ context.Context, _ *v1alpha1.PipeParameters) (v1alpha1.PipeObservation, error) {
+		return obs, err
+	}}
+}
+
+type pipeModifier func(*v1alpha1.Pipe)
+
+func withRefresh(v string) pipeModifier {
+	return func(cr *v1alpha1.Pipe) {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyRefresh: v})
+	}
+}
+
+func withLastRefresh(v string) pipeModifier {
+	return func(cr *v1alpha1.Pipe) {
+		meta.AddAnnotations(cr, map[string]string{refresh.AnnotationKeyLastRefresh: v})
+	}
+}
+
+func withDefinition(v string) pipeModifier {
+	return func(cr *v1alpha1.Pipe) { cr.Status.AtProvider.Definition = v }
+}
+
+func pipe(m ...pipeModifier) *v1alpha1.Pipe {
+	cr := &v1alpha1.Pipe{
+		ObjectMeta: metav1.ObjectMeta{Name: "events"},
+		Spec: v1alpha1.PipeSpec{ForProvider: v1alpha1.PipeParameters{
+			Name:          "events_pipe",
+			CopyStatement: copyStatement + ";",
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.PipeClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A pipe that does not exist should be reported as such.",
+			client: withObservation(v1alpha1.PipeObservation{}, snowflake.ErrNotFound),
+			args:   args{ctx: context.Background(), mg: pipe()},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"NotificationChannel": {
+			reason: "The notification channel of an auto-ingest pipe should be published as a connection detail.",
+			client: withObservation(v1alpha1.PipeObservation{Definition: copyStatement, NotificationChannel: channel}, nil),
+			args:   args{ctx: context.Background(), mg: pipe()},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{v1alpha1.ConnectionDetailNotificationChannel: []byte(channel)},
+			}},
+		},
+		"DefinitionChanged": {
+			reason: "A pipe with another COPY statement should need an update.",
+			client: withObservation(v1alpha1.PipeObservation{Definition: "COPY INTO raw.other FROM @raw.events_stage"}, nil),
+			args:   args{ctx: context.Background(), mg: pipe()},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+		"RefreshRequested": {
+			reason: "A new refresh annotation value should need an update.",
+			client: withObservation(v1alpha1.PipeObservation{Definition: copyStatement}, nil),
+			args:   args{ctx: context.Background(), mg: pipe(withRefresh("2024-05-01T10:00:00Z"), withLastRefresh("2024-04-01T10:00:00Z"))},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+		"RefreshHandled": {
+			reason: "A refresh annotation value that was already handled should not need an update.",
+			client: withObservation(v1alpha1.PipeObservation{Definition: copyStatement}, nil),
+			args:   args{ctx: context.Background(), mg: pipe(withRefresh("2024-05-01T10:00:00Z"), withLastRefresh("2024-05-01T10:00:00Z"))},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls       []string
+		lastRefresh string
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.Pipe
+		fail   string
+		want   want
+	}{
+		"Refresh": {
+			reason: "A requested refresh should be recorded as done without refreshing the new pipe.",
+			mg:     pipe(withRefresh("1")),
+			want:   want{calls: []string{"create"}, lastRefresh: "1"},
+		},
+		"CreateError": {
+			reason: "Errors creating the pipe should be returned.",
+			mg:     pipe(withRefresh("1")),
+			fail:   "create",
+			want:   want{calls: []string{"create"}, err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: recorder(&calls, tc.fail, errBoom)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want calls, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.lastRefresh, refresh.Last(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want last refresh, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls       []string
+		lastRefresh string
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.Pipe
+		fail   string
+		want   want
+	}{
+		"Refresh": {
+			reason: "A pipe should be refreshed for a new value of the refresh annotation.",
+			mg:     pipe(withDefinition(copyStatement), withRefresh("2"), withLastRefresh("1")),
+			want:   want{calls: []string{"update", "refresh"}, lastRefresh: "2"},
+		},
+		"AlreadyRefreshed": {
+			reason: "A pipe should not be refreshed again for the same value of the refresh annotation.",
+			mg:     pipe(withDefinition(copyStatement), withRefresh("1"), withLastRefresh("1")),
+			want:   want{calls: []string{"update"}, lastRefresh: "1"},
+		},
+		"Replaced": {
+			reason: "A pipe whose copy statement changed should be replaced.",
+			mg:     pipe(withDefinition("COPY INTO raw.events FROM @raw.old_stage")),
+			want:   want{calls: []string{"replace"}},
+		},
+		"RefreshError": {
+			reason: "A failed refresh should be returned and retried.",
+			mg:     pipe(withDefinition(copyStatement), withRefresh("2"), withLastRefresh("1")),
+			fail:   "refresh",
+			want: want{
+				calls:       []string{"update", "refresh"},
+				lastRefresh: "1",
+				err:         errors.Wrap(errBoom, errRefreshFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: recorder(&calls, tc.fail, errBoom)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.lastRefresh, refresh.Last(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want last refresh, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/policy/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.PolicyAttachmentClient
+
+	MockFetchPolicyAttachment func(ctx context.Context, p *v1alpha1.PolicyAttachmentParameters) (v1alpha1.PolicyAttachmentObservation, error)
+}
+
+func (m *mockClient) FetchPolicyAttachment(ctx context.Context, p *v1alpha1.PolicyAttachmentParameters) (v1alpha1.PolicyAttachmentObservation, error) {
+	return m.MockFetchPolicyAttachment(ctx, p)
+}
+
+func attachment(p v1alpha1.PolicyAttachmentParameters) *v1alpha1.PolicyAttachment {
+	return &v1alpha1.PolicyAttachment{Spec: v1alpha1.PolicyAttachmentSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.PolicyAttachmentParameters{
+		MaskingPolicy: "governance.PUBLIC.ssn_mask",
+		Object:        "analytics.PUBLIC.customers",
+		Column:        ptr.To("ssn"),
+	}
+
+	found := func(obs v1alpha1.PolicyAttachmentObservation) func(context.Context, *v1alpha1.PolicyAttachmentParameters) (v1alpha1.PolicyAttachmentObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.PolicyAttachmentParameters) (v1alpha1.PolicyAttachmentObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.PolicyAttachmentClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A policy attachment that does not exist should be reported as such.",
+			client: &mockClient{MockFetchPolicyAttachment: func(_ context.Context, _ *v1alpha1.PolicyAttachmentParameters) (v1alpha1.PolicyAttachmentObservation, error) {
+				return v1alpha1.PolicyAttachmentObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the policy attachment should be returned.",
+			client: &mockClient{MockFetchPolicyAttachment: func(_ context.Context, _ *v1alpha1.PolicyAttachmentParameters) (v1alpha1.PolicyAttachmentObservation, error) {
+				return v1alpha1.PolicyAttachmentObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "The masking policy set on the column should be up to date.",
+			client: &mockClient{MockFetchPolicyAttachment: found(v1alpha1.PolicyAttachmentObservation{
+				Policy: "GOVERNANCE.PUBLIC.SSN_MASK",
+				Status: "ACTIVE",
+			})},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"OtherPolicy": {
+			reason: "A column masked by another policy should need an update.",
+			client: &mockClient{MockFetchPolicyAttachment: found(v1alpha1.PolicyAttachmentObservation{
+				Policy: "GOVERNANCE.PUBLIC.EMAIL_MASK",
+			})},
+			args: args{ctx: context.Background(), mg: attachment(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"QuotedPolicy": {
+			reason: "A policy whose name needs quotes should be up to date when attached.",
+			client: &mockClient{MockFetchPolicyAttachment: found(v1alpha1.PolicyAttachmentObservation{
+				Policy:  `GOVERNANCE.PUBLIC."region.filter"`,
+				Columns: []string{"REGION"},
+			})},
+			args: args{ctx: context.Background(), mg: attachment(v1alpha1.PolicyAttachmentParameters{
+				RowAccessPolicy: `governance.PUBLIC."region.filter"`,
+				Object:          "analytics.PUBLIC.customers",
+				Columns:         []string{"region"},
+			})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"RowAccessColumnsChanged": {
+			reason: "A row access policy added on other columns should need an update.",
+			client: &mockClient{MockFetchPolicyAttachment: found(v1alpha1.PolicyAttachmentObservation{
+				Policy:  "GOVERNANCE.PUBLIC.REGION_FILTER",
+				Columns: []string{"COUNTRY"},
+			})},
+			args: args{ctx: context.Background(), mg: attachment(v1alpha1.PolicyAttachmentParameters{
+				RowAccessPolicy: "governance.PUBLIC.region_filter",
+				Object:          "analytics.PUBLIC.customers",
+				Columns:         []string{"region"},
+			})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
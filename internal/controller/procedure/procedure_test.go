@@ -0,0 +1,362 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package procedure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/function/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ProcedureClient
+
+	MockFetchProcedure   func(ctx context.Context, identifier string) (v1alpha1.ProcedureObservation, error)
+	MockCreateProcedure  func(ctx context.Context, p *v1alpha1.ProcedureParameters) error
+	MockReplaceProcedure func(ctx context.Context, p *v1alpha1.ProcedureParameters, previous string) error
+	MockDeleteProcedure  func(ctx context.Context, identifier string) error
+}
+
+func (m *mockClient) FetchProcedure(ctx context.Context, identifier string) (v1alpha1.ProcedureObservation, error) {
+	return m.MockFetchProcedure(ctx, identifier)
+}
+
+func (m *mockClient) CreateProcedure(ctx context.Context, p *v1alpha1.ProcedureParameters) error {
+	return m.MockCreateProcedure(ctx, p)
+}
+
+func (m *mockClient) ReplaceProcedure(ctx context.Context, p *v1alpha1.ProcedureParameters, previous string) error {
+	return m.MockReplaceProcedure(ctx, p, previous)
+}
+
+func (m *mockClient) DeleteProcedure(ctx context.Context, identifier string) error {
+	return m.MockDeleteProcedure(ctx, identifier)
+}
+
+func procedure(p v1alpha1.ProcedureParameters, externalName string) *v1alpha1.Procedure {
+	cr := &v1alpha1.Procedure{Spec: v1alpha1.ProcedureSpec{ForProvider: p}}
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ProcedureParameters{
+		Name:           "load_orders",
+		Database:       "sales",
+		Schema:         "public",
+		Arguments:      []v1alpha1.FunctionArgument{{Name: "day", Type: "date"}},
+		ReturnType:     "string",
+		Language:       "PYTHON",
+		RuntimeVersion: ptr.To("3.11"),
+		Packages:       []string{"snowflake-snowpark-python"},
+		Imports:        []string{"@libs/orders.py"},
+		Handler:        ptr.To("orders.load"),
+		ExecuteAs:      "CALLER",
+	}
+	id := "sales.public.load_orders(date)"
+	previous := "sales.public.load_orders()"
+
+	observed := v1alpha1.ProcedureObservation{
+		Signature:      "(DAY DATE)",
+		ReturnType:     "VARCHAR(16777216)",
+		Language:       "PYTHON",
+		RuntimeVersion: "3.11",
+		Packages:       []string{"snowflake-snowpark-python"},
+		Imports:        []string{"@libs/orders.py"},
+		Handler:        "orders.load",
+		ExecuteAs:      "CALLER",
+	}
+
+	// found returns obs when the procedure with the given identifier is fetched
+	found := func(identifier string, obs v1alpha1.ProcedureObservation) func(context.Context, string) (v1alpha1.ProcedureObservation, error) {
+		return func(_ context.Context, id string) (v1alpha1.ProcedureObservation, error) {
+			if id != identifier {
+				return v1alpha1.ProcedureObservation{}, snowflake.ErrNotFound
+			}
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ProcedureClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A procedure that does not exist should be reported as such.",
+			client: &mockClient{MockFetchProcedure: func(_ context.Context, _ string) (v1alpha1.ProcedureObservation, error) {
+				return v1alpha1.ProcedureObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: procedure(params, "")},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the procedure should be returned.",
+			client: &mockClient{MockFetchProcedure: func(_ context.Context, _ string) (v1alpha1.ProcedureObservation, error) {
+				return v1alpha1.ProcedureObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: procedure(params, id)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"Adopted": {
+			reason: "An existing procedure with the signature of the spec should be adopted, persisting its external name.",
+			client: &mockClient{MockFetchProcedure: found(id, observed)},
+			args:   args{ctx: context.Background(), mg: procedure(params, "")},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"UpToDate": {
+			reason: "A procedure matching the spec should be up to date.",
+			client: &mockClient{MockFetchProcedure: found(id, observed)},
+			args:   args{ctx: context.Background(), mg: procedure(params, id)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"SignatureChanged": {
+			reason: "A procedure created with other argument types should be replaced.",
+			client: &mockClient{MockFetchProcedure: found(previous, observed)},
+			args:   args{ctx: context.Background(), mg: procedure(params, previous)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"RunsAsOwner": {
+			reason: "A procedure running with the privileges of another role than the spec should be replaced.",
+			client: &mockClient{MockFetchProcedure: found(id, v1alpha1.ProcedureObservation{
+				Signature:      "(DAY DATE)",
+				ReturnType:     "VARCHAR(16777216)",
+				Language:       "PYTHON",
+				RuntimeVersion: "3.11",
+				Packages:       []string{"snowflake-snowpark-python"},
+				Imports:        []string{"@libs/orders.py"},
+				Handler:        "orders.load",
+				ExecuteAs:      "OWNER",
+			})},
+			args: args{ctx: context.Background(), mg: procedure(params, id)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ProcedureParameters{
+		Name:       "load_orders",
+		Database:   "sales",
+		Schema:     "public",
+		Arguments:  []v1alpha1.FunctionArgument{{Name: "day", Type: "date"}},
+		ReturnType: "string",
+		Language:   "SQL",
+		Body:       ptr.To("BEGIN RETURN 'ok'; END"),
+	}
+
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ProcedureClient
+		want   want
+	}{
+		"Created": {
+			reason: "The signature of a created procedure should be its external name.",
+			client: &mockClient{MockCreateProcedure: func(_ context.Context, _ *v1alpha1.ProcedureParameters) error { return nil }},
+			want:   want{externalName: "sales.public.load_orders(date)"},
+		},
+		"CreateError": {
+			reason: "Errors creating the procedure should be returned.",
+			client: &mockClient{MockCreateProcedure: func(_ context.Context, _ *v1alpha1.ProcedureParameters) error { return errBoom }},
+			want:   want{err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := procedure(params, "")
+			e := external{client: tc.client}
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ProcedureParameters{
+		Name:       "load_orders",
+		Database:   "sales",
+		Schema:     "public",
+		Arguments:  []v1alpha1.FunctionArgument{{Name: "day", Type: "date"}},
+		ReturnType: "string",
+		Language:   "SQL",
+		Body:       ptr.To("BEGIN RETURN 'ok'; END"),
+	}
+	id := "sales.public.load_orders(date)"
+	previous := "sales.public.load_orders()"
+
+	// replaced expects the procedure to be replaced from the given identifier
+	replaced := func(identifier string) func(context.Context, *v1alpha1.ProcedureParameters, string) error {
+		return func(_ context.Context, _ *v1alpha1.ProcedureParameters, previous string) error {
+			if previous != identifier {
+				return errors.Errorf("replaced %q", previous)
+			}
+			return nil
+		}
+	}
+
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ProcedureClient
+		kube   *test.MockClient
+		mg     *v1alpha1.Procedure
+		want   want
+	}{
+		"Replaced": {
+			reason: "A procedure whose signature is unchanged should be replaced in place.",
+			client: &mockClient{MockReplaceProcedure: replaced(id)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errors.New("external name updated"))},
+			mg:     procedure(params, id),
+			want:   want{externalName: id},
+		},
+		"SignatureChanged": {
+			reason: "A procedure whose signature changed should be replaced from the previous signature, persisting the new one as its external name.",
+			client: &mockClient{MockReplaceProcedure: replaced(previous)},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     procedure(params, previous),
+			want:   want{externalName: id},
+		},
+		"ReplaceError": {
+			reason: "Errors replacing the procedure should be returned.",
+			client: &mockClient{MockReplaceProcedure: func(_ context.Context, _ *v1alpha1.ProcedureParameters, _ string) error { return errBoom }},
+			mg:     procedure(params, previous),
+			want:   want{externalName: previous, err: errors.Wrap(errBoom, errUpdateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want external name, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// dropped expects the procedure with the given identifier to be dropped
+	dropped := func(identifier string) func(context.Context, string) error {
+		return func(_ context.Context, id string) error {
+			if id != identifier {
+				return errors.Errorf("dropped %q", id)
+			}
+			return nil
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ProcedureClient
+		mg     *v1alpha1.Procedure
+		want   error
+	}{
+		"ExternalName": {
+			reason: "The procedure should be dropped by the signature it was created with.",
+			client: &mockClient{MockDeleteProcedure: dropped("sales.public.load_orders()")},
+			mg:     procedure(v1alpha1.ProcedureParameters{Name: "load_orders", Database: "sales", Schema: "public"}, "sales.public.load_orders()"),
+		},
+		"DeleteError": {
+			reason: "Errors dropping the procedure should be returned.",
+			client: &mockClient{MockDeleteProcedure: func(_ context.Context, _ string) error { return errBoom }},
+			mg:     procedure(v1alpha1.ProcedureParameters{Name: "load_orders", Database: "sales", Schema: "public"}, "sales.public.load_orders(date)"),
+			want:   errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
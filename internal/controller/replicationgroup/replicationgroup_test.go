@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicationgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/replication/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ReplicationGroupClient
+
+	MockFetchReplicationGroup func(ctx context.Context, p *v1alpha1.ReplicationGroupParameters) (v1alpha1.ReplicationGroupObservation, error)
+}
+
+func (m *mockClient) FetchReplicationGroup(ctx context.Context, p *v1alpha1.ReplicationGroupParameters) (v1alpha1.ReplicationGroupObservation, error) {
+	return m.MockFetchReplicationGroup(ctx, p)
+}
+
+func replicationGroup(p v1alpha1.ReplicationGroupParameters) *v1alpha1.ReplicationGroup {
+	return &v1alpha1.ReplicationGroup{Spec: v1alpha1.ReplicationGroupSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ReplicationGroupParameters{
+		Name:                "core",
+		ObjectTypes:         []string{"DATABASES", "ROLES"},
+		Databases:           []string{"sales", "finance"},
+		AllowedAccounts:     []string{"myorg.dr"},
+		ReplicationSchedule: ptr.To("10 minute"),
+	}
+
+	found := func(obs v1alpha1.ReplicationGroupObservation) func(context.Context, *v1alpha1.ReplicationGroupParameters) (v1alpha1.ReplicationGroupObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.ReplicationGroupParameters) (v1alpha1.ReplicationGroupObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ReplicationGroupClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A group that does not exist should be reported as such.",
+			client: &mockClient{MockFetchReplicationGroup: func(_ context.Context, _ *v1alpha1.ReplicationGroupParameters) (v1alpha1.ReplicationGroupObservation, error) {
+				return v1alpha1.ReplicationGroupObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: replicationGroup(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the group should be returned.",
+			client: &mockClient{MockFetchReplicationGroup: func(_ context.Context, _ *v1alpha1.ReplicationGroupParameters) (v1alpha1.ReplicationGroupObservation, error) {
+				return v1alpha1.ReplicationGroupObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: replicationGroup(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A group replicating the desired objects to the desired accounts should be up to date.",
+			client: &mockClient{MockFetchReplicationGroup: found(v1alpha1.ReplicationGroupObservation{
+				ObjectTypes:         []string{"ROLES", "DATABASES"},
+				Databases:           []string{"FINANCE", "SALES"},
+				AllowedAccounts:     []string{"MYORG.DR"},
+				ReplicationSchedule: "10 MINUTE",
+			})},
+			args: args{ctx: context.Background(), mg: replicationGroup(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"DatabaseNotReplicated": {
+			reason: "A group missing a desired database should need an update.",
+			client: &mockClient{MockFetchReplicationGroup: found(v1alpha1.ReplicationGroupObservation{
+				ObjectTypes:         []string{"DATABASES", "ROLES"},
+				Databases:           []string{"SALES"},
+				AllowedAccounts:     []string{"MYORG.DR"},
+				ReplicationSchedule: "10 MINUTE",
+			})},
+			args: args{ctx: context.Background(), mg: replicationGroup(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"ScheduleChanged": {
+			reason: "A group refreshed on another schedule should need an update.",
+			client: &mockClient{MockFetchReplicationGroup: found(v1alpha1.ReplicationGroupObservation{
+				ObjectTypes:         []string{"DATABASES", "ROLES"},
+				Databases:           []string{"SALES", "FINANCE"},
+				AllowedAccounts:     []string{"MYORG.DR"},
+				ReplicationSchedule: "60 MINUTE",
+			})},
+			args: args{ctx: context.Background(), mg: replicationGroup(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
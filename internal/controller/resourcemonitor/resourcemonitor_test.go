@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemonitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/resourcemonitor/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ResourceMonitorClient
+
+	MockFetchResourceMonitor func(ctx context.Context, p *v1alpha1.ResourceMonitorParameters, known []string) (v1alpha1.ResourceMonitorObservation, error)
+}
+
+func (m *mockClient) FetchResourceMonitor(ctx context.Context, p *v1alpha1.ResourceMonitorParameters, known []string) (v1alpha1.ResourceMonitorObservation, error) {
+	return m.MockFetchResourceMonitor(ctx, p, known)
+}
+
+func monitor(p v1alpha1.ResourceMonitorParameters) *v1alpha1.ResourceMonitor {
+	return &v1alpha1.ResourceMonitor{Spec: v1alpha1.ResourceMonitorSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	quota := 100
+	params := v1alpha1.ResourceMonitorParameters{
+		Name:        "limiter",
+		CreditQuota: &quota,
+		Triggers: []v1alpha1.ResourceMonitorTrigger{
+			{Threshold: 75, Action: "NOTIFY"},
+			{Threshold: 50, Action: "NOTIFY"},
+			{Threshold: 100, Action: "SUSPEND"},
+		},
+		Warehouses: []string{"transform_wh"},
+	}
+
+	observed := v1alpha1.ResourceMonitorObservation{
+		CreditQuota: "100.00",
+		UsedCredits: "12.50",
+		NotifyAt:    "50%,75%",
+		SuspendAt:   "100%",
+		Warehouses:  []string{"TRANSFORM_WH"},
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ResourceMonitorClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A resource monitor that does not exist should be reported as such.",
+			client: &mockClient{MockFetchResourceMonitor: func(_ context.Context, _ *v1alpha1.ResourceMonitorParameters, _ []string) (v1alpha1.ResourceMonitorObservation, error) {
+				return v1alpha1.ResourceMonitorObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: monitor(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the resource monitor should be returned.",
+			client: &mockClient{MockFetchResourceMonitor: func(_ context.Context, _ *v1alpha1.ResourceMonitorParameters, _ []string) (v1alpha1.ResourceMonitorObservation, error) {
+				return v1alpha1.ResourceMonitorObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: monitor(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A resource monitor matching the desired state should be up to date.",
+			client: &mockClient{MockFetchResourceMonitor: func(_ context.Context, _ *v1alpha1.ResourceMonitorParameters, _ []string) (v1alpha1.ResourceMonitorObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: monitor(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"TriggerChanged": {
+			reason: "A resource monitor suspending at another threshold should need an update.",
+			client: &mockClient{MockFetchResourceMonitor: func(_ context.Context, _ *v1alpha1.ResourceMonitorParameters, _ []string) (v1alpha1.ResourceMonitorObservation, error) {
+				o := observed
+				o.SuspendAt = "90%"
+				return o, nil
+			}},
+			args: args{ctx: context.Background(), mg: monitor(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"WarehouseUnassigned": {
+			reason: "A resource monitor missing one of its warehouses should need an update.",
+			client: &mockClient{MockFetchResourceMonitor: func(_ context.Context, _ *v1alpha1.ResourceMonitorParameters, _ []string) (v1alpha1.ResourceMonitorObservation, error) {
+				o := observed
+				o.Warehouses = nil
+				return o, nil
+			}},
+			args: args{ctx: context.Background(), mg: monitor(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rowaccesspolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/policy/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.RowAccessPolicyClient
+
+	MockFetchRowAccessPolicy func(ctx context.Context, p *v1alpha1.RowAccessPolicyParameters) (v1alpha1.RowAccessPolicyObservation, error)
+}
+
+func (m *mockClient) FetchRowAccessPolicy(ctx context.Context, p *v1alpha1.RowAccessPolicyParameters) (v1alpha1.RowAccessPolicyObservation, error) {
+	return m.MockFetchRowAccessPolicy(ctx, p)
+}
+
+func policy(p v1alpha1.RowAccessPolicyParameters) *v1alpha1.RowAccessPolicy {
+	return &v1alpha1.RowAccessPolicy{Spec: v1alpha1.RowAccessPolicySpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.RowAccessPolicyParameters{
+		Name:      "region_filter",
+		Database:  "governance",
+		Schema:    "PUBLIC",
+		Signature: []v1alpha1.PolicyArgument{{Name: "region", Type: "VARCHAR"}},
+		Body:      "region = CURRENT_ROLE();",
+		Comment:   ptr.To("rows of the region of the role"),
+	}
+
+	found := func(obs v1alpha1.RowAccessPolicyObservation) func(context.Context, *v1alpha1.RowAccessPolicyParameters) (v1alpha1.RowAccessPolicyObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.RowAccessPolicyParameters) (v1alpha1.RowAccessPolicyObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.RowAccessPolicyClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A row access policy that does not exist should be reported as such.",
+			client: &mockClient{MockFetchRowAccessPolicy: func(_ context.Context, _ *v1alpha1.RowAccessPolicyParameters) (v1alpha1.RowAccessPolicyObservation, error) {
+				return v1alpha1.RowAccessPolicyObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the row access policy should be returned.",
+			client: &mockClient{MockFetchRowAccessPolicy: func(_ context.Context, _ *v1alpha1.RowAccessPolicyParameters) (v1alpha1.RowAccessPolicyObservation, error) {
+				return v1alpha1.RowAccessPolicyObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A policy matching the desired body and comment should be up to date.",
+			client: &mockClient{MockFetchRowAccessPolicy: found(v1alpha1.RowAccessPolicyObservation{
+				Body:    "region  =  CURRENT_ROLE()",
+				Comment: "rows of the region of the role",
+			})},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"CommentChanged": {
+			reason: "A policy with another comment should need an update.",
+			client: &mockClient{MockFetchRowAccessPolicy: found(v1alpha1.RowAccessPolicyObservation{
+				Body: "region = CURRENT_ROLE()",
+			})},
+			args: args{ctx: context.Background(), mg: policy(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
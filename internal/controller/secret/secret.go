@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotSecret    = "managed resource is not a Secret custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed = "cannot create secret"
+	errUpdateFailed = "cannot update secret"
+	errDeleteFailed = "cannot delete secret"
+	errGetFailed    = "cannot retrieve secret"
+
+	errRecordValuesVersion = "cannot record the version of the secret values"
+
+	errGetSecretString = "cannot get secret string"
+	errGetPassword     = "cannot get password"
+	errGetRefreshToken = "cannot get OAuth refresh token"
+)
+
+// Setup adds a controller that reconciles Secret managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SecretGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SecretGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Secret{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Secret)
+	if !ok {
+		return nil, errors.New(errNotSecret)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.SecretClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Secret)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecret)
+	}
+
+	obs, err := e.client.FetchSecret(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// the values last set are only known to us, not to Snowflake
+	obs.ValuesVersion = cr.GetAnnotations()[v1alpha1.AnnotationKeyValuesVersion]
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	// the Kubernetes Secrets may already be gone while the secret is deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	_, version, err := e.values(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, obs) && version == obs.ValuesVersion,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Secret)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecret)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	v, version, err := e.values(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if err := e.client.CreateSecret(ctx, &cr.Spec.ForProvider, v); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	// the status set here does not survive the update of the critical
+	// annotations that follows a creation, but the annotation does
+	meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyValuesVersion: version})
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Secret)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecret)
+	}
+
+	v, version, err := e.values(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := e.client.UpdateSecret(ctx, &cr.Spec.ForProvider, v); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	if version == cr.GetAnnotations()[v1alpha1.AnnotationKeyValuesVersion] {
+		return managed.ExternalUpdate{}, nil
+	}
+	cr.Status.AtProvider.ValuesVersion = version
+	meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyValuesVersion: version})
+
+	// the managed reconciler only persists the status after an update
+	err = managed.NewRetryingCriticalAnnotationUpdater(e.kube).UpdateCriticalAnnotations(ctx, cr)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errRecordValuesVersion)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Secret)
+	if !ok {
+		return errors.New(errNotSecret)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return errors.Wrap(e.client.DeleteSecret(ctx, &cr.Spec.ForProvider), errDeleteFailed)
+}
+
+// values reads the secret values from the Kubernetes Secrets referenced by a
+// secret. It also returns the version recorded for the values set on the
+// secret, made of the resource versions of the Kubernetes Secrets and the
+// refresh token expiry time, which Snowflake reports in another format, so
+// that changes to either are noticed without keeping the values.
+func (e *external) values(ctx context.Context, cr *v1alpha1.Secret) (snowflake.SecretValues, string, error) {
+	p := &cr.Spec.ForProvider
+	v := snowflake.SecretValues{}
+	var versions []string
+
+	read := func(name string, ref *xpv1.SecretKeySelector, value *string, errMsg string) error {
+		if ref == nil {
+			return nil
+		}
+		val, rv, err := snowflake.GetSecretValueVersion(ctx, e.kube, *ref)
+		if err != nil {
+			return errors.Wrap(err, errMsg)
+		}
+		*value = val
+		versions = append(versions, name+"="+ref.Namespace+"/"+ref.Name+"@"+rv)
+		return nil
+	}
+
+	if err := read("secretString", p.SecretStringSecretRef, &v.SecretString, errGetSecretString); err != nil {
+		return v, "", err
+	}
+	if err := read("password", p.PasswordSecretRef, &v.Password, errGetPassword); err != nil {
+		return v, "", err
+	}
+	if err := read("oauthRefreshToken", p.OAuthRefreshTokenSecretRef, &v.RefreshToken, errGetRefreshToken); err != nil {
+		return v, "", err
+	}
+	if t := p.OAuthRefreshTokenExpiryTime; t != nil {
+		versions = append(versions, "oauthRefreshTokenExpiryTime="+*t)
+	}
+	return v, strings.Join(versions, ","), nil
+}
+
+// isUpToDate compares the properties Snowflake reports back. Snowflake does not
+// report the values, so they are compared through their version instead.
+func isUpToDate(p v1alpha1.SecretParameters, obs v1alpha1.SecretObservation) bool {
+	if p.Username != nil && *p.Username != obs.Username {
+		return false
+	}
+	if p.OAuthScopes != nil && !snowflake.SameNames(p.OAuthScopes, obs.OAuthScopes) {
+		return false
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		return false
+	}
+	return true
+}
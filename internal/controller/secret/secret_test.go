@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/secret/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.SecretClient
+
+	MockFetchSecret  func(ctx context.Context, p *v1alpha1.SecretParameters) (v1alpha1.SecretObservation, error)
+	MockCreateSecret func(ctx context.Context, p *v1alpha1.SecretParameters, v snowflake.SecretValues) error
+	MockUpdateSecret func(ctx context.Context, p *v1alpha1.SecretParameters, v snowflake.SecretValues) error
+}
+
+func (m *mockClient) FetchSecret(ctx context.Context, p *v1alpha1.SecretParameters) (v1alpha1.SecretObservation, error) {
+	return m.MockFetchSecret(ctx, p)
+}
+
+func (m *mockClient) CreateSecret(ctx context.Context, p *v1alpha1.SecretParameters, v snowflake.SecretValues) error {
+	return m.MockCreateSecret(ctx, p, v)
+}
+
+func (m *mockClient) UpdateSecret(ctx context.Context, p *v1alpha1.SecretParameters, v snowflake.SecretValues) error {
+	return m.MockUpdateSecret(ctx, p, v)
+}
+
+func secret(p v1alpha1.SecretParameters, version string) *v1alpha1.Secret {
+	cr := &v1alpha1.Secret{Spec: v1alpha1.SecretSpec{ForProvider: p}}
+	if version != "" {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyValuesVersion: version})
+	}
+	return cr
+}
+
+// kube returns a client serving a Kubernetes Secret with the given password
+// and resource version.
+func kube(password, version string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(password)}
+			obj.SetResourceVersion(version)
+			return nil
+		},
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+}
+
+func deleted(cr *v1alpha1.Secret) *v1alpha1.Secret {
+	now := metav1.Now()
+	cr.SetDeletionTimestamp(&now)
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.SecretParameters{
+		Name:     "api_login",
+		Database: "analytics",
+		Schema:   "PUBLIC",
+		Type:     v1alpha1.SecretTypePassword,
+		Username: ptr.To("svc_api"),
+		PasswordSecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "api-login"},
+			Key:             "password",
+		},
+	}
+
+	applied := "password=crossplane-system/api-login@1"
+
+	found := func(_ context.Context, _ *v1alpha1.SecretParameters) (v1alpha1.SecretObservation, error) {
+		return v1alpha1.SecretObservation{Type: v1alpha1.SecretTypePassword, Username: "svc_api"}, nil
+	}
+
+	type fields struct {
+		client snowflake.SecretClient
+		kube   client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A secret that does not exist should be reported as such.",
+			fields: fields{client: &mockClient{MockFetchSecret: func(_ context.Context, _ *v1alpha1.SecretParameters) (v1alpha1.SecretObservation, error) {
+				return v1alpha1.SecretObservation{}, snowflake.ErrNotFound
+			}}},
+			args: args{ctx: context.Background(), mg: secret(params, applied)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the secret should be returned.",
+			fields: fields{client: &mockClient{MockFetchSecret: func(_ context.Context, _ *v1alpha1.SecretParameters) (v1alpha1.SecretObservation, error) {
+				return v1alpha1.SecretObservation{}, errBoom
+			}}},
+			args: args{ctx: context.Background(), mg: secret(params, applied)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"GetPasswordError": {
+			reason: "Errors reading the password from its Kubernetes Secret should be returned.",
+			fields: fields{
+				client: &mockClient{MockFetchSecret: found},
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			args: args{ctx: context.Background(), mg: secret(params, applied)},
+			want: want{err: errors.Wrap(errors.Wrap(errBoom, "cannot get secret"), errGetPassword)},
+		},
+		"UpToDate": {
+			reason: "A secret holding the password of its Kubernetes Secret should be up to date.",
+			fields: fields{client: &mockClient{MockFetchSecret: found}, kube: kube("s3cr3t", "1")},
+			args:   args{ctx: context.Background(), mg: secret(params, applied)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"PasswordRotated": {
+			reason: "A secret should be set again when the password in its Kubernetes Secret changes.",
+			fields: fields{client: &mockClient{MockFetchSecret: found}, kube: kube("r0t4t3d", "2")},
+			args:   args{ctx: context.Background(), mg: secret(params, applied)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"DeletedWithoutKubernetesSecret": {
+			reason: "A secret being deleted should be observed without reading its Kubernetes Secrets, which may be gone.",
+			fields: fields{
+				client: &mockClient{MockFetchSecret: found},
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(corev1.Resource("secrets"), "api-login"))},
+			},
+			args: args{ctx: context.Background(), mg: deleted(secret(params, applied))},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.fields.client, kube: tc.fields.kube}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.SecretParameters{
+		Name:     "api_login",
+		Database: "analytics",
+		Schema:   "PUBLIC",
+		Type:     v1alpha1.SecretTypePassword,
+		Username: ptr.To("svc_api"),
+		PasswordSecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "api-login"},
+			Key:             "password",
+		},
+	}
+
+	type want struct {
+		values  snowflake.SecretValues
+		version string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		err    error
+		want   want
+	}{
+		"Rotated": {
+			reason: "The rotated password should be set again and the version of its Kubernetes Secret recorded.",
+			kube:   kube("r0t4t3d", "2"),
+			want: want{
+				values:  snowflake.SecretValues{Password: "r0t4t3d"},
+				version: "password=crossplane-system/api-login@2",
+			},
+		},
+		"UpdateError": {
+			reason: "Errors updating the secret should be returned without recording the new version.",
+			kube:   kube("r0t4t3d", "2"),
+			err:    errBoom,
+			want: want{
+				values:  snowflake.SecretValues{Password: "r0t4t3d"},
+				version: "password=crossplane-system/api-login@1",
+				err:     errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got snowflake.SecretValues
+			e := external{
+				client: &mockClient{MockUpdateSecret: func(_ context.Context, _ *v1alpha1.SecretParameters, v snowflake.SecretValues) error {
+					got = v
+					return tc.err
+				}},
+				kube: tc.kube,
+			}
+			cr := secret(params, "password=crossplane-system/api-login@1")
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.values, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want values, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.version, cr.GetAnnotations()[v1alpha1.AnnotationKeyValuesVersion]); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want version, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateObserve(t *testing.T) {
+	params := v1alpha1.SecretParameters{
+		Name:     "api_login",
+		Database: "analytics",
+		Schema:   "PUBLIC",
+		Type:     v1alpha1.SecretTypePassword,
+		Username: ptr.To("svc_api"),
+		PasswordSecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "api-login"},
+			Key:             "password",
+		},
+	}
+
+	e := external{
+		client: &mockClient{
+			MockCreateSecret: func(_ context.Context, _ *v1alpha1.SecretParameters, _ snowflake.SecretValues) error {
+				return nil
+			},
+			MockFetchSecret: func(_ context.Context, _ *v1alpha1.SecretParameters) (v1alpha1.SecretObservation, error) {
+				return v1alpha1.SecretObservation{Type: v1alpha1.SecretTypePassword, Username: "svc_api"}, nil
+			},
+		},
+		kube: kube("s3cr3t", "1"),
+	}
+
+	cr := secret(params, "")
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+
+	// the managed reconciler overwrites the status set by Create when it
+	// updates the critical annotations
+	cr.Status = v1alpha1.SecretStatus{}
+
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, got); diff != "" {
+		t.Errorf("e.Observe(...): a new secret should be up to date: -want, +got:\n%s\n", diff)
+	}
+}
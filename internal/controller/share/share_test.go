@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package share
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/share/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.ShareClient
+
+	MockFetchShare func(ctx context.Context, p *v1alpha1.ShareParameters) (v1alpha1.ShareObservation, error)
+}
+
+func (m *mockClient) FetchShare(ctx context.Context, p *v1alpha1.ShareParameters) (v1alpha1.ShareObservation, error) {
+	return m.MockFetchShare(ctx, p)
+}
+
+func share(p v1alpha1.ShareParameters) *v1alpha1.Share {
+	return &v1alpha1.Share{Spec: v1alpha1.ShareSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.ShareParameters{
+		Name:     "partner_sales",
+		Database: "sales",
+		Schemas:  []string{"public"},
+		Objects:  []v1alpha1.ShareObject{{Type: "VIEW", Name: "sales.public.orders_v"}},
+		Accounts: []string{"partner_org.partner_acct"},
+	}
+
+	grants := []string{
+		"USAGE ON DATABASE SALES",
+		"USAGE ON SCHEMA SALES.PUBLIC",
+		"SELECT ON VIEW SALES.PUBLIC.ORDERS_V",
+	}
+
+	found := func(obs v1alpha1.ShareObservation) func(context.Context, *v1alpha1.ShareParameters) (v1alpha1.ShareObservation, error) {
+		return func(_ context.Context, _ *v1alpha1.ShareParameters) (v1alpha1.ShareObservation, error) {
+			return obs, nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.ShareClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A share that does not exist should be reported as such.",
+			client: &mockClient{MockFetchShare: func(_ context.Context, _ *v1alpha1.ShareParameters) (v1alpha1.ShareObservation, error) {
+				return v1alpha1.ShareObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: share(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the share should be returned.",
+			client: &mockClient{MockFetchShare: func(_ context.Context, _ *v1alpha1.ShareParameters) (v1alpha1.ShareObservation, error) {
+				return v1alpha1.ShareObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: share(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A share granted the desired privileges and shared with the desired accounts should be up to date.",
+			client: &mockClient{MockFetchShare: found(v1alpha1.ShareObservation{
+				Database: "SALES",
+				Grants:   grants,
+				Accounts: []string{"PARTNER_ORG.PARTNER_ACCT"},
+			})},
+			args: args{ctx: context.Background(), mg: share(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"ObjectNotGranted": {
+			reason: "A share missing the grant on an object should need an update.",
+			client: &mockClient{MockFetchShare: found(v1alpha1.ShareObservation{
+				Database: "SALES",
+				Grants:   grants[:2],
+				Accounts: []string{"PARTNER_ORG.PARTNER_ACCT"},
+			})},
+			args: args{ctx: context.Background(), mg: share(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"AccountNotAdded": {
+			reason: "A share not shared with a consumer account yet should need an update.",
+			client: &mockClient{MockFetchShare: found(v1alpha1.ShareObservation{Database: "SALES", Grants: grants})},
+			args:   args{ctx: context.Background(), mg: share(params)},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -20,16 +20,90 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/allenkallz/provider-snowflake/internal/controller/account"
+	"github.com/allenkallz/provider-snowflake/internal/controller/accountparameter"
+	"github.com/allenkallz/provider-snowflake/internal/controller/alert"
+	"github.com/allenkallz/provider-snowflake/internal/controller/apiintegration"
+	"github.com/allenkallz/provider-snowflake/internal/controller/catalogintegration"
 	"github.com/allenkallz/provider-snowflake/internal/controller/config"
 	"github.com/allenkallz/provider-snowflake/internal/controller/database"
+	"github.com/allenkallz/provider-snowflake/internal/controller/databaserole"
+	"github.com/allenkallz/provider-snowflake/internal/controller/dynamictable"
+	"github.com/allenkallz/provider-snowflake/internal/controller/externalaccessintegration"
+	"github.com/allenkallz/provider-snowflake/internal/controller/externaltable"
+	"github.com/allenkallz/provider-snowflake/internal/controller/externalvolume"
+	"github.com/allenkallz/provider-snowflake/internal/controller/failovergroup"
+	"github.com/allenkallz/provider-snowflake/internal/controller/fileformat"
+	"github.com/allenkallz/provider-snowflake/internal/controller/function"
+	"github.com/allenkallz/provider-snowflake/internal/controller/grantownership"
+	"github.com/allenkallz/provider-snowflake/internal/controller/icebergtable"
+	"github.com/allenkallz/provider-snowflake/internal/controller/maskingpolicy"
+	"github.com/allenkallz/provider-snowflake/internal/controller/networkpolicy"
+	"github.com/allenkallz/provider-snowflake/internal/controller/networkpolicyattachment"
+	"github.com/allenkallz/provider-snowflake/internal/controller/networkrule"
+	"github.com/allenkallz/provider-snowflake/internal/controller/notificationintegration"
+	"github.com/allenkallz/provider-snowflake/internal/controller/objectparameter"
+	"github.com/allenkallz/provider-snowflake/internal/controller/pipe"
+	"github.com/allenkallz/provider-snowflake/internal/controller/policyattachment"
+	"github.com/allenkallz/provider-snowflake/internal/controller/procedure"
+	"github.com/allenkallz/provider-snowflake/internal/controller/replicationgroup"
+	"github.com/allenkallz/provider-snowflake/internal/controller/resourcemonitor"
+	"github.com/allenkallz/provider-snowflake/internal/controller/rowaccesspolicy"
+	"github.com/allenkallz/provider-snowflake/internal/controller/secondarygroup"
+	"github.com/allenkallz/provider-snowflake/internal/controller/secret"
+	"github.com/allenkallz/provider-snowflake/internal/controller/share"
+	"github.com/allenkallz/provider-snowflake/internal/controller/stage"
+	"github.com/allenkallz/provider-snowflake/internal/controller/storageintegration"
+	"github.com/allenkallz/provider-snowflake/internal/controller/stream"
+	"github.com/allenkallz/provider-snowflake/internal/controller/tag"
+	"github.com/allenkallz/provider-snowflake/internal/controller/tagassociation"
+	"github.com/allenkallz/provider-snowflake/internal/controller/task"
+	"github.com/allenkallz/provider-snowflake/internal/controller/warehouse"
 )
 
 // Setup creates all Snowflake controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		account.Setup,
+		accountparameter.Setup,
+		alert.Setup,
+		apiintegration.Setup,
+		catalogintegration.Setup,
 		config.Setup,
 		database.Setup,
+		databaserole.Setup,
+		dynamictable.Setup,
+		externalaccessintegration.Setup,
+		externaltable.Setup,
+		externalvolume.Setup,
+		failovergroup.Setup,
+		fileformat.Setup,
+		function.Setup,
+		grantownership.Setup,
+		icebergtable.Setup,
+		maskingpolicy.Setup,
+		networkpolicy.Setup,
+		networkpolicyattachment.Setup,
+		networkrule.Setup,
+		notificationintegration.Setup,
+		objectparameter.Setup,
+		pipe.Setup,
+		policyattachment.Setup,
+		procedure.Setup,
+		replicationgroup.Setup,
+		resourcemonitor.Setup,
+		rowaccesspolicy.Setup,
+		secondarygroup.Setup,
+		secret.Setup,
+		share.Setup,
+		stage.Setup,
+		storageintegration.Setup,
+		stream.Setup,
+		tag.Setup,
+		tagassociation.Setup,
+		task.Setup,
+		warehouse.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			print(err)
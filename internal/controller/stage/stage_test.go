@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/stage/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.StageClient
+
+	MockFetchStage func(ctx context.Context, p *v1alpha1.StageParameters) (v1alpha1.StageObservation, error)
+}
+
+func (m *mockClient) FetchStage(ctx context.Context, p *v1alpha1.StageParameters) (v1alpha1.StageObservation, error) {
+	return m.MockFetchStage(ctx, p)
+}
+
+func stage(p v1alpha1.StageParameters) *v1alpha1.Stage {
+	return &v1alpha1.Stage{Spec: v1alpha1.StageSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.StageClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A stage that does not exist should be reported as such.",
+			client: &mockClient{MockFetchStage: func(_ context.Context, _ *v1alpha1.StageParameters) (v1alpha1.StageObservation, error) {
+				return v1alpha1.StageObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: stage(v1alpha1.StageParameters{Name: "raw"})},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the stage should be returned.",
+			client: &mockClient{MockFetchStage: func(_ context.Context, _ *v1alpha1.StageParameters) (v1alpha1.StageObservation, error) {
+				return v1alpha1.StageObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: stage(v1alpha1.StageParameters{Name: "raw"})},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A stage matching the desired state should be up to date.",
+			client: &mockClient{MockFetchStage: func(_ context.Context, _ *v1alpha1.StageParameters) (v1alpha1.StageObservation, error) {
+				return v1alpha1.StageObservation{URL: "s3://bucket/raw/", StorageIntegration: "S3_INT"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: stage(v1alpha1.StageParameters{
+				Name:               "raw",
+				URL:                ptr.To("s3://bucket/raw/"),
+				StorageIntegration: ptr.To("s3_int"),
+			})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"URLChanged": {
+			reason: "A stage pointing at another location should need an update.",
+			client: &mockClient{MockFetchStage: func(_ context.Context, _ *v1alpha1.StageParameters) (v1alpha1.StageObservation, error) {
+				return v1alpha1.StageObservation{URL: "s3://bucket/old/"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: stage(v1alpha1.StageParameters{Name: "raw", URL: ptr.To("s3://bucket/raw/")})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"DirectoryDisabled": {
+			reason: "A stage without its directory table should need an update.",
+			client: &mockClient{MockFetchStage: func(_ context.Context, _ *v1alpha1.StageParameters) (v1alpha1.StageObservation, error) {
+				return v1alpha1.StageObservation{Type: "INTERNAL"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: stage(v1alpha1.StageParameters{Name: "raw", Directory: &v1alpha1.StageDirectory{Enable: true}})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageintegration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/integration/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+type mockClient struct {
+	snowflake.StorageIntegrationClient
+
+	MockFetchStorageIntegration func(ctx context.Context, p *v1alpha1.StorageIntegrationParameters) (v1alpha1.StorageIntegrationObservation, error)
+}
+
+func (m *mockClient) FetchStorageIntegration(ctx context.Context, p *v1alpha1.StorageIntegrationParameters) (v1alpha1.StorageIntegrationObservation, error) {
+	return m.MockFetchStorageIntegration(ctx, p)
+}
+
+func integration(p v1alpha1.StorageIntegrationParameters) *v1alpha1.StorageIntegration {
+	return &v1alpha1.StorageIntegration{Spec: v1alpha1.StorageIntegrationSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.StorageIntegrationParameters{
+		Name:                    "s3_raw",
+		StorageProvider:         "S3",
+		Enabled:                 ptr.To(true),
+		StorageAllowedLocations: []string{"s3://raw/"},
+		StorageAWSRoleARN:       ptr.To("arn:aws:iam::123456789012:role/snowflake"),
+	}
+
+	observed := v1alpha1.StorageIntegrationObservation{
+		Enabled:                 true,
+		StorageProvider:         "S3",
+		StorageAllowedLocations: []string{"s3://raw/"},
+		StorageAWSRoleARN:       "arn:aws:iam::123456789012:role/snowflake",
+		StorageAWSIAMUserARN:    "arn:aws:iam::999999999999:user/abc",
+		StorageAWSExternalID:    "ACCOUNT_SFCRole=2_abc",
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.StorageIntegrationClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A storage integration that does not exist should be reported as such.",
+			client: &mockClient{MockFetchStorageIntegration: func(_ context.Context, _ *v1alpha1.StorageIntegrationParameters) (v1alpha1.StorageIntegrationObservation, error) {
+				return v1alpha1.StorageIntegrationObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the storage integration should be returned.",
+			client: &mockClient{MockFetchStorageIntegration: func(_ context.Context, _ *v1alpha1.StorageIntegrationParameters) (v1alpha1.StorageIntegrationObservation, error) {
+				return v1alpha1.StorageIntegrationObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "An up to date S3 integration should publish the IAM user ARN and external ID.",
+			client: &mockClient{MockFetchStorageIntegration: func(_ context.Context, _ *v1alpha1.StorageIntegrationParameters) (v1alpha1.StorageIntegrationObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(params)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionDetailStorageAWSIAMUserARN: []byte("arn:aws:iam::999999999999:user/abc"),
+					v1alpha1.ConnectionDetailStorageAWSExternalID: []byte("ACCOUNT_SFCRole=2_abc"),
+				},
+			}},
+		},
+		"LocationAdded": {
+			reason: "An integration missing an allowed location should need an update.",
+			client: &mockClient{MockFetchStorageIntegration: func(_ context.Context, _ *v1alpha1.StorageIntegrationParameters) (v1alpha1.StorageIntegrationObservation, error) {
+				return v1alpha1.StorageIntegrationObservation{
+					Enabled:                  true,
+					StorageProvider:          "GCS",
+					StorageGCPServiceAccount: "abc@gcpuscentral1.iam.gserviceaccount.com",
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: integration(v1alpha1.StorageIntegrationParameters{
+				Name:                    "gcs_raw",
+				StorageProvider:         "GCS",
+				StorageAllowedLocations: []string{"gcs://raw/"},
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+				ConnectionDetails: managed.ConnectionDetails{
+					v1alpha1.ConnectionDetailStorageGCPServiceAccount: []byte("abc@gcpuscentral1.iam.gserviceaccount.com"),
+				},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/stream/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.StreamClient
+
+	MockFetchStream func(ctx context.Context, p *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error)
+}
+
+func (m *mockClient) FetchStream(ctx context.Context, p *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error) {
+	return m.MockFetchStream(ctx, p)
+}
+
+func stream(p v1alpha1.StreamParameters) *v1alpha1.Stream {
+	return &v1alpha1.Stream{Spec: v1alpha1.StreamSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o     managed.ExternalObservation
+		ready xpv1.Condition
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.StreamClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A stream that does not exist should be reported as such.",
+			client: &mockClient{MockFetchStream: func(_ context.Context, _ *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error) {
+				return v1alpha1.StreamObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: stream(v1alpha1.StreamParameters{Name: "orders"})},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the stream should be returned.",
+			client: &mockClient{MockFetchStream: func(_ context.Context, _ *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error) {
+				return v1alpha1.StreamObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: stream(v1alpha1.StreamParameters{Name: "orders"})},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A stream with the desired comment should be up to date and available.",
+			client: &mockClient{MockFetchStream: func(_ context.Context, _ *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error) {
+				return v1alpha1.StreamObservation{Mode: "APPEND_ONLY", Comment: "orders"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: stream(v1alpha1.StreamParameters{Name: "orders", Comment: ptr.To("orders")})},
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				ready: xpv1.Available(),
+			},
+		},
+		"CommentChanged": {
+			reason: "A stream with another comment should need an update.",
+			client: &mockClient{MockFetchStream: func(_ context.Context, _ *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error) {
+				return v1alpha1.StreamObservation{Comment: "old"}, nil
+			}},
+			args: args{ctx: context.Background(), mg: stream(v1alpha1.StreamParameters{Name: "orders", Comment: ptr.To("orders")})},
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				ready: xpv1.Available(),
+			},
+		},
+		"Stale": {
+			reason: "A stale stream should exist but not be ready.",
+			client: &mockClient{MockFetchStream: func(_ context.Context, _ *v1alpha1.StreamParameters) (v1alpha1.StreamObservation, error) {
+				return v1alpha1.StreamObservation{Stale: true}, nil
+			}},
+			args: args{ctx: context.Background(), mg: stream(v1alpha1.StreamParameters{Name: "orders"})},
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				ready: xpv1.Unavailable().WithMessage(msgStale),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.ready.Type == "" {
+				return
+			}
+			gotReady := tc.args.mg.GetCondition(xpv1.TypeReady)
+			if diff := cmp.Diff(tc.want.ready, gotReady, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want ready condition, +got ready condition:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
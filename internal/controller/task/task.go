@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/allenkallz/provider-snowflake/apis/task/v1alpha1"
+	apisv1alpha1 "github.com/allenkallz/provider-snowflake/apis/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+	"github.com/allenkallz/provider-snowflake/internal/features"
+)
+
+const (
+	errNotTask      = "managed resource is not a Task custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+
+	errNewClient = "cannot create new Service"
+
+	errCreateFailed  = "cannot create task"
+	errUpdateFailed  = "cannot update task"
+	errDeleteFailed  = "cannot delete task"
+	errGetFailed     = "cannot retrieve task"
+	errRootTasks     = "cannot find root tasks"
+	errSuspendFailed = "cannot suspend task"
+	errResumeFailed  = "cannot resume task"
+)
+
+// Setup adds a controller that reconciles Task managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TaskGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TaskGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger: o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Task{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube   client.Client
+	usage  resource.Tracker
+	logger logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return nil, errors.New(errNotTask)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	svc, err := snowflake.GetClientInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client snowflake.TaskClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTask)
+	}
+
+	obs, err := e.client.FetchTask(ctx, &cr.Spec.ForProvider)
+
+	// handle 404 not found issue
+	if errors.Is(err, snowflake.ErrNotFound) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// handle other error
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = obs
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(&cr.Spec.ForProvider, obs),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTask)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	p := &cr.Spec.ForProvider
+	err := e.alterDAG(ctx, p, p.After, started(p), func() error {
+		return errors.Wrap(e.client.CreateTask(ctx, p), errCreateFailed)
+	})
+	return managed.ExternalCreation{}, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTask)
+	}
+
+	p := &cr.Spec.ForProvider
+	obs := cr.Status.AtProvider
+
+	// both the DAG the task leaves and the one it joins are modified
+	preds := append(append([]string{}, obs.Predecessors...), p.After...)
+
+	err := e.alterDAG(ctx, p, preds, started(p), func() error {
+		if strings.EqualFold(obs.State, "started") {
+			if err := e.client.SuspendTask(ctx, snowflake.TaskName(p)); err != nil {
+				return errors.Wrap(err, errSuspendFailed)
+			}
+		}
+		return errors.Wrap(e.client.UpdateTask(ctx, p, obs), errUpdateFailed)
+	})
+	return managed.ExternalUpdate{}, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Task)
+	if !ok {
+		return errors.New(errNotTask)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	p := &cr.Spec.ForProvider
+	return e.alterDAG(ctx, p, cr.Status.AtProvider.Predecessors, false, func() error {
+		return errors.Wrap(e.client.DeleteTask(ctx, p), errDeleteFailed)
+	})
+}
+
+// alterDAG runs fn with the started root tasks of the given predecessors
+// suspended, as Snowflake requires while a DAG is modified. Afterwards the task
+// itself is resumed if resume is set, and then the roots, because child tasks
+// must be resumed before their root. The roots are resumed even if fn fails so
+// that a failed change does not leave the whole DAG suspended.
+func (e *external) alterDAG(ctx context.Context, p *v1alpha1.TaskParameters, predecessors []string, resume bool, fn func() error) error {
+	roots, err := e.client.RootTasks(ctx, p, predecessors)
+	if err != nil {
+		return errors.Wrap(err, errRootTasks)
+	}
+
+	var suspended []string
+	for _, r := range roots {
+		if !r.Started {
+			continue
+		}
+		if err := e.client.SuspendTask(ctx, r.Name); err != nil {
+			return errors.Wrap(err, errSuspendFailed)
+		}
+		suspended = append(suspended, r.Name)
+	}
+
+	err = fn()
+	if err == nil && resume {
+		err = errors.Wrap(e.client.ResumeTask(ctx, snowflake.TaskName(p)), errResumeFailed)
+	}
+
+	for _, name := range suspended {
+		if rerr := e.client.ResumeTask(ctx, name); rerr != nil && err == nil {
+			err = errors.Wrap(rerr, errResumeFailed)
+		}
+	}
+	return err
+}
+
+// started reports whether the task should be resumed.
+func started(p *v1alpha1.TaskParameters) bool {
+	return p.State != v1alpha1.TaskStateSuspended
+}
+
+func isUpToDate(p *v1alpha1.TaskParameters, obs v1alpha1.TaskObservation) bool { //nolint:gocyclo // flat list of comparisons
+	if started(p) != strings.EqualFold(obs.State, "started") {
+		return false
+	}
+	if snowflake.NormalizeSQL(p.SQL) != snowflake.NormalizeSQL(obs.Definition) {
+		return false
+	}
+	if snowflake.TaskSchedule(p.Schedule) != strings.Join(strings.Fields(obs.Schedule), " ") {
+		return false
+	}
+	if p.Warehouse == nil && obs.Warehouse != "" || p.Warehouse != nil && !strings.EqualFold(*p.Warehouse, obs.Warehouse) {
+		return false
+	}
+	if p.When == nil && obs.Condition != "" || p.When != nil && snowflake.NormalizeSQL(*p.When) != snowflake.NormalizeSQL(obs.Condition) {
+		return false
+	}
+	if !snowflake.TaskPredecessorsUpToDate(p, obs.Predecessors) || !snowflake.TaskParametersUpToDate(p, obs.Parameters) {
+		return false
+	}
+	if p.AllowOverlappingExecution != nil && *p.AllowOverlappingExecution != obs.AllowOverlappingExecution {
+		return false
+	}
+	if p.ErrorIntegration != nil && !strings.EqualFold(*p.ErrorIntegration, obs.ErrorIntegration) {
+		return false
+	}
+	if p.Comment != nil && *p.Comment != obs.Comment {
+		return false
+	}
+	return true
+}
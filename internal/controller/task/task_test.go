@@ -0,0 +1,259 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/task/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.TaskClient
+
+	MockFetchTask func(ctx context.Context, p *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error)
+	MockRootTasks func(ctx context.Context, p *v1alpha1.TaskParameters, predecessors []string) ([]snowflake.RootTask, error)
+
+	// calls records the statements issued by the DAG related methods
+	calls []string
+}
+
+func (m *mockClient) FetchTask(ctx context.Context, p *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error) {
+	return m.MockFetchTask(ctx, p)
+}
+
+func (m *mockClient) RootTasks(ctx context.Context, p *v1alpha1.TaskParameters, predecessors []string) ([]snowflake.RootTask, error) {
+	return m.MockRootTasks(ctx, p, predecessors)
+}
+
+func (m *mockClient) SuspendTask(_ context.Context, name string) error {
+	m.calls = append(m.calls, "suspend "+name)
+	return nil
+}
+
+func (m *mockClient) ResumeTask(_ context.Context, name string) error {
+	m.calls = append(m.calls, "resume "+name)
+	return nil
+}
+
+func (m *mockClient) UpdateTask(_ context.Context, p *v1alpha1.TaskParameters, _ v1alpha1.TaskObservation) error {
+	m.calls = append(m.calls, "update "+snowflake.TaskName(p))
+	return nil
+}
+
+func task(p v1alpha1.TaskParameters) *v1alpha1.Task {
+	return &v1alpha1.Task{Spec: v1alpha1.TaskSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	params := v1alpha1.TaskParameters{
+		Name:     "load",
+		Database: "DB",
+		Schema:   "PUBLIC",
+		SQL:      "CALL load();",
+		Schedule: &v1alpha1.TaskSchedule{Cron: ptr.To("0 9 * * * UTC")},
+		SessionParameters: map[string]string{
+			"timezone": "UTC",
+		},
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.TaskClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A task that does not exist should be reported as such.",
+			client: &mockClient{MockFetchTask: func(_ context.Context, _ *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error) {
+				return v1alpha1.TaskObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: task(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the task should be returned.",
+			client: &mockClient{MockFetchTask: func(_ context.Context, _ *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error) {
+				return v1alpha1.TaskObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: task(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A started task matching the desired state should be up to date.",
+			client: &mockClient{MockFetchTask: func(_ context.Context, _ *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error) {
+				return v1alpha1.TaskObservation{
+					State:      "started",
+					Schedule:   "USING CRON 0 9 * * * UTC",
+					Definition: "CALL load()",
+					Parameters: map[string]string{"TIMEZONE": "UTC"},
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: task(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Suspended": {
+			reason: "A suspended task that should be started should need an update.",
+			client: &mockClient{MockFetchTask: func(_ context.Context, _ *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error) {
+				return v1alpha1.TaskObservation{
+					State:      "suspended",
+					Schedule:   "USING CRON 0 9 * * * UTC",
+					Definition: "CALL load()",
+					Parameters: map[string]string{"TIMEZONE": "UTC"},
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: task(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"PredecessorAdded": {
+			reason: "A task missing one of its predecessors should need an update.",
+			client: &mockClient{MockFetchTask: func(_ context.Context, _ *v1alpha1.TaskParameters) (v1alpha1.TaskObservation, error) {
+				return v1alpha1.TaskObservation{
+					State:        "started",
+					Definition:   "CALL load()",
+					Predecessors: []string{`"DB"."PUBLIC"."EXTRACT"`},
+				}, nil
+			}},
+			args: args{ctx: context.Background(), mg: task(v1alpha1.TaskParameters{
+				Name:     "load",
+				Database: "DB",
+				Schema:   "PUBLIC",
+				SQL:      "CALL load()",
+				After:    []string{"extract", "DB.PUBLIC.CLEANUP"},
+			})},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	child := v1alpha1.TaskParameters{
+		Name:     "load",
+		Database: "DB",
+		Schema:   "PUBLIC",
+		SQL:      "CALL load()",
+		After:    []string{"DB.PUBLIC.EXTRACT"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		roots  []snowflake.RootTask
+		state  string
+		mg     *v1alpha1.Task
+		want   []string
+	}{
+		"StartedRoot": {
+			reason: "The started root should be suspended first and resumed after the task.",
+			roots:  []snowflake.RootTask{{Name: "DB.PUBLIC.EXTRACT", Started: true}},
+			state:  "started",
+			mg:     task(child),
+			want: []string{
+				"suspend DB.PUBLIC.EXTRACT",
+				"suspend DB.PUBLIC.load",
+				"update DB.PUBLIC.load",
+				"resume DB.PUBLIC.load",
+				"resume DB.PUBLIC.EXTRACT",
+			},
+		},
+		"SuspendedRoot": {
+			reason: "A suspended root should be left suspended.",
+			roots:  []snowflake.RootTask{{Name: "DB.PUBLIC.EXTRACT"}},
+			state:  "suspended",
+			mg:     task(child),
+			want: []string{
+				"update DB.PUBLIC.load",
+				"resume DB.PUBLIC.load",
+			},
+		},
+		"SuspendTask": {
+			reason: "A task that should be suspended should not be resumed.",
+			roots:  []snowflake.RootTask{{Name: "DB.PUBLIC.EXTRACT", Started: true}},
+			state:  "started",
+			mg: func() *v1alpha1.Task {
+				p := child
+				p.State = v1alpha1.TaskStateSuspended
+				return task(p)
+			}(),
+			want: []string{
+				"suspend DB.PUBLIC.EXTRACT",
+				"suspend DB.PUBLIC.load",
+				"update DB.PUBLIC.load",
+				"resume DB.PUBLIC.EXTRACT",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockClient{MockRootTasks: func(_ context.Context, _ *v1alpha1.TaskParameters, _ []string) ([]snowflake.RootTask, error) {
+				return tc.roots, nil
+			}}
+			tc.mg.Status.AtProvider.State = tc.state
+
+			e := external{client: m}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, m.calls); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warehouse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/allenkallz/provider-snowflake/apis/warehouse/v1alpha1"
+	"github.com/allenkallz/provider-snowflake/internal/clients/snowflake"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type mockClient struct {
+	snowflake.WarehouseClient
+
+	MockFetchWarehouse func(ctx context.Context, p *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error)
+}
+
+func (m *mockClient) FetchWarehouse(ctx context.Context, p *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error) {
+	return m.MockFetchWarehouse(ctx, p)
+}
+
+func warehouse(p v1alpha1.WarehouseParameters) *v1alpha1.Warehouse {
+	return &v1alpha1.Warehouse{Spec: v1alpha1.WarehouseSpec{ForProvider: p}}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	size := "XXLARGE"
+	suspend := 0
+	resume := true
+	suspended := true
+	params := v1alpha1.WarehouseParameters{
+		Name:               "transform_wh",
+		WarehouseSize:      &size,
+		AutoSuspend:        &suspend,
+		AutoResume:         &resume,
+		InitiallySuspended: &suspended,
+	}
+
+	observed := v1alpha1.WarehouseObservation{
+		State:           "STARTED",
+		Type:            "STANDARD",
+		Size:            "2X-Large",
+		AutoResume:      "true",
+		ResourceMonitor: "LIMITER",
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client snowflake.WarehouseClient
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "A warehouse that does not exist should be reported as such.",
+			client: &mockClient{MockFetchWarehouse: func(_ context.Context, _ *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error) {
+				return v1alpha1.WarehouseObservation{}, snowflake.ErrNotFound
+			}},
+			args: args{ctx: context.Background(), mg: warehouse(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"FetchError": {
+			reason: "Errors fetching the warehouse should be returned.",
+			client: &mockClient{MockFetchWarehouse: func(_ context.Context, _ *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error) {
+				return v1alpha1.WarehouseObservation{}, errBoom
+			}},
+			args: args{ctx: context.Background(), mg: warehouse(params)},
+			want: want{err: errors.Wrap(errBoom, errGetFailed)},
+		},
+		"UpToDate": {
+			reason: "A warehouse matching the desired state should be up to date, regardless of its state and resource monitor.",
+			client: &mockClient{MockFetchWarehouse: func(_ context.Context, _ *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error) {
+				return observed, nil
+			}},
+			args: args{ctx: context.Background(), mg: warehouse(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"SizeChanged": {
+			reason: "A warehouse of another size should need an update.",
+			client: &mockClient{MockFetchWarehouse: func(_ context.Context, _ *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error) {
+				o := observed
+				o.Size = "X-Small"
+				return o, nil
+			}},
+			args: args{ctx: context.Background(), mg: warehouse(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"AutoSuspendEnabled": {
+			reason: "A warehouse suspending itself when the suspension should be disabled should need an update.",
+			client: &mockClient{MockFetchWarehouse: func(_ context.Context, _ *v1alpha1.WarehouseParameters) (v1alpha1.WarehouseObservation, error) {
+				o := observed
+				o.AutoSuspend = "600"
+				return o, nil
+			}},
+			args: args{ctx: context.Background(), mg: warehouse(params)},
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package refresh tracks refreshes that are requested by changing the value
+// of an annotation, e.g. to the current timestamp.
+package refresh
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyLastRefresh records the value of the refresh annotation that
+// was last handled. It is kept in an annotation because the status set by
+// Create does not survive the update of the critical annotations that
+// follows it.
+const AnnotationKeyLastRefresh = "snowflake.crossplane.io/last-refresh"
+
+// Last returns the value of the refresh annotation that was last handled.
+func Last(o resource.Object) string {
+	return o.GetAnnotations()[AnnotationKeyLastRefresh]
+}
+
+// Requested reports whether the annotation key carries a value that was not
+// handled yet.
+func Requested(o resource.Object, key string) bool {
+	v, ok := o.GetAnnotations()[key]
+	return ok && v != Last(o)
+}
+
+// Handled records the value of the annotation key as handled. The managed
+// reconciler persists the annotations after Create.
+func Handled(o resource.Object, key string) {
+	if v, ok := o.GetAnnotations()[key]; ok {
+		meta.AddAnnotations(o, map[string]string{AnnotationKeyLastRefresh: v})
+	}
+}
+
+// Record records the value of the annotation key as handled and persists it,
+// as the managed reconciler only persists the status after Update.
+func Record(ctx context.Context, kube client.Client, o resource.Object, key string) error {
+	if !Requested(o, key) {
+		return nil
+	}
+	Handled(o, key)
+	return managed.NewRetryingCriticalAnnotationUpdater(kube).UpdateCriticalAnnotations(ctx, o)
+}
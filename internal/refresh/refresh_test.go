@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package refresh
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const annotationKeyRefresh = "snowflake.crossplane.io/refresh"
+
+func object(annotations map[string]string) *fake.Managed {
+	return &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+}
+
+func TestRequested(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      *fake.Managed
+		want   bool
+	}{
+		"NoAnnotation": {
+			reason: "An object without the refresh annotation should not request a refresh.",
+			o:      object(nil),
+			want:   false,
+		},
+		"NotHandled": {
+			reason: "A refresh annotation value that was not handled yet should request a refresh.",
+			o:      object(map[string]string{annotationKeyRefresh: "2", AnnotationKeyLastRefresh: "1"}),
+			want:   true,
+		},
+		"Handled": {
+			reason: "A refresh annotation value that was handled should not request a refresh.",
+			o:      object(map[string]string{annotationKeyRefresh: "2", AnnotationKeyLastRefresh: "2"}),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := Requested(tc.o, annotationKeyRefresh); got != tc.want {
+				t.Errorf("\n%s\nRequested(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		last    string
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      *fake.Managed
+		err    error
+		want   want
+	}{
+		"Requested": {
+			reason: "A requested refresh should be recorded and persisted.",
+			o:      object(map[string]string{annotationKeyRefresh: "2", AnnotationKeyLastRefresh: "1"}),
+			want:   want{last: "2", updated: true},
+		},
+		"NotRequested": {
+			reason: "Nothing should be persisted when no refresh was requested.",
+			o:      object(map[string]string{annotationKeyRefresh: "2", AnnotationKeyLastRefresh: "2"}),
+			want:   want{last: "2"},
+		},
+		"UpdateError": {
+			reason: "Errors persisting the handled refresh should be returned.",
+			o:      object(map[string]string{annotationKeyRefresh: "2"}),
+			err:    errBoom,
+			want:   want{last: "2", updated: true, err: fmt.Errorf("cannot update critical annotations: %w", errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+				got.updated = true
+				return tc.err
+			}}
+			got.err = Record(context.Background(), kube, tc.o, annotationKeyRefresh)
+			got.last = Last(tc.o)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nRecord(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}